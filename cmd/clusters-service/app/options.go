@@ -6,6 +6,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/server"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/estimation"
 	gitauth "github.com/weaveworks/weave-gitops-enterprise/pkg/gitauth/server"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
@@ -62,6 +63,9 @@ type Options struct {
 	MonitoringOptions         monitoring.Options
 	ExplorerCleanerDisabled   bool
 	ExplorerEnabledFor        []string
+	ClusterProber             server.ClusterProber
+	DisableEmbeddedKubeconfig bool
+	ClusterProxyEnabled       bool
 }
 
 type Option func(*Options)
@@ -300,6 +304,27 @@ func WithExplorerEnabledFor(enabledFor []string) Option {
 	}
 }
 
+// WithClusterProber configures the prober of the cluster capabilities
+func WithClusterProber(prober server.ClusterProber) Option {
+	return func(o *Options) {
+		o.ClusterProber = prober
+	}
+}
+
+// WithDisableEmbeddedKubeconfig only allows the download of kubeconfigs authenticating with OIDC
+func WithDisableEmbeddedKubeconfig(disable bool) Option {
+	return func(o *Options) {
+		o.DisableEmbeddedKubeconfig = disable
+	}
+}
+
+// WithClusterProxyEnabled serves the proxy of the Kubernetes API of the GitopsClusters
+func WithClusterProxyEnabled(enabled bool) Option {
+	return func(o *Options) {
+		o.ClusterProxyEnabled = enabled
+	}
+}
+
 func WithRoutePrefix(routePrefix string) Option {
 	return func(o *Options) {
 		o.RoutePrefix = routePrefix
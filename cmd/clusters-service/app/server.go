@@ -53,8 +53,10 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/version"
 	"github.com/weaveworks/weave-gitops-enterprise/common/entitlement"
 	gitauth "github.com/weaveworks/weave-gitops-enterprise/pkg/api/gitauth"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/connector"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/namespaces"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/proxy"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/estimation"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
 	gitauth_server "github.com/weaveworks/weave-gitops-enterprise/pkg/gitauth/server"
@@ -88,6 +90,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -166,6 +169,10 @@ type Params struct {
 	ExplorerCleanerDisabled           bool                      `mapstructure:"explorer-cleaner-disabled"`
 	NoAuthUser                        string                    `mapstructure:"insecure-no-authentication-user"`
 	ExplorerEnabledFor                []string                  `mapstructure:"explorer-enabled-for"`
+	ClusterTokenRotationInterval      time.Duration             `mapstructure:"cluster-token-rotation-interval"`
+	ClusterProbeCacheTTL              time.Duration             `mapstructure:"cluster-probe-cache-ttl"`
+	DisableEmbeddedKubeconfig         bool                      `mapstructure:"disable-embedded-kubeconfig"`
+	ClusterProxyEnabled               bool                      `mapstructure:"cluster-proxy-enabled"`
 }
 
 type OIDCAuthenticationOptions struct {
@@ -271,6 +278,11 @@ func NewAPIServerCommand() *cobra.Command {
 	cmdFlags.Bool("explorer-cleaner-disabled", false, "Enables the Explorer object cleaner that manages retaining objects")
 	cmdFlags.StringSlice("explorer-enabled-for", []string{}, "List of components that the Explorer is enabled for")
 
+	cmdFlags.Duration("cluster-probe-cache-ttl", fetcher.DefaultProbeCacheTTL, "How long the connectivity and capabilities of the clusters are cached")
+	cmdFlags.Bool("disable-embedded-kubeconfig", false, "Only allow the download of cluster kubeconfigs that log in with the OIDC issuer, instead of the credentials of the cluster secrets")
+	cmdFlags.Bool("cluster-proxy-enabled", false, "Proxy the Kubernetes API requests of the users to the GitopsClusters, impersonating them. The kubeconfigs of the proxy point to the host of the OIDC redirect URL")
+	cmdFlags.Duration("cluster-token-rotation-interval", 5*time.Minute, "How often to check and rotate the time-bound tokens of connected clusters. Set to 0 to disable token rotation")
+
 	// Monitoring
 	cmdFlags.Bool("monitoring-enabled", false, "creates monitoring server")
 	cmdFlags.String("monitoring-bind-address", "", "monitoring server binding address")
@@ -521,6 +533,25 @@ func StartServer(ctx context.Context, p Params, logOptions flux_logger.Options)
 
 	clustersManager.Start(ctx)
 
+	if p.ClusterTokenRotationInterval > 0 {
+		clientset, err := kubernetes.NewForConfig(rest)
+		if err != nil {
+			return fmt.Errorf("could not create kubernetes client for token rotation: %w", err)
+		}
+		// the kubeconfig secrets are rotated in the namespace of the GitopsClusters, all namespaces if
+		// it is not set, as gitops connect cluster writes them in the namespace of the GitopsCluster
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("could not get hostname for token rotation: %w", err)
+		}
+		rotator := connector.NewTokenRotator(clientset, p.CAPIClustersNamespace, p.ClusterTokenRotationInterval, log)
+		go func() {
+			if err := rotator.StartWithLeaderElection(ctx, p.RuntimeNamespace, hostname+"_"+string(uuid.NewUUID())); err != nil {
+				log.Error(err, "failed to start cluster token rotation")
+			}
+		}()
+	}
+
 	var estimator estimation.Estimator
 	if featureflags.Get("WEAVE_GITOPS_FEATURE_COST_ESTIMATION") != "" {
 		log.Info("Cost estimation feature flag is enabled")
@@ -585,6 +616,9 @@ func StartServer(ctx context.Context, p Params, logOptions flux_logger.Options)
 		WithManagementCluster(p.Cluster),
 		WithTemplateCostEstimator(estimator),
 		WithUIConfig(p.UIConfig),
+		WithClusterProber(fetcher.NewClusterProber(log, mgmtCluster, p.CAPIClustersNamespace, p.ClusterProbeCacheTTL)),
+		WithDisableEmbeddedKubeconfig(p.DisableEmbeddedKubeconfig),
+		WithClusterProxyEnabled(p.ClusterProxyEnabled),
 		WithPipelineControllerAddress(p.PipelineControllerAddress),
 		WithCollectorServiceAccount(p.CollectorServiceAccountName, p.CollectorServiceAccountNamespace),
 		WithMonitoring(p.MonitoringEnabled, p.MonitoringBindAddress, p.MetricsEnabled, p.ProfilingEnabled, log),
@@ -650,6 +684,33 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 	if args.ManagementFetcher == nil {
 		args.ManagementFetcher = mgmtfetcher.NewManagementCrossNamespacesFetcher(namespacesCache, args.ClientGetter, authClientGetter)
 	}
+	// Users see RBAC changes without waiting for their namespaces cache to expire, and the caches of
+	// the active users are built again after the changes
+	if err := namespaces.RegisterRBACInvalidation(factory, args.ManagementFetcher.UsersResourcesNamespaces); err != nil {
+		return fmt.Errorf("failed to set up invalidation of the users namespaces cache: %w", err)
+	}
+	go namespaces.NewWarmer(args.Log, args.ManagementFetcher.UsersResourcesNamespaces, namespacesCache.List).Start(ctx)
+
+	var clusterProxyURL string
+	if args.ClusterProxyEnabled {
+		clusterProxyURL, err = externalURL(args.OIDC.RedirectURL, args.RoutePrefix, proxy.DefaultPathPrefix)
+		if err != nil {
+			return fmt.Errorf("failed to get the URL of the cluster proxy: %w", err)
+		}
+	}
+
+	// The data of the decommissioned clusters is purged from the Explorer, and the cluster
+	// inventory gets the installed profiles from it, if enabled
+	var explorerPurger *queryserver.ClusterPurger
+	var clusterPurger server.ClusterPurger
+	var explorerQuerier *queryserver.Querier
+	var profilesQuerier server.ExplorerQuerier
+	if featureflags.Get("WEAVE_GITOPS_FEATURE_EXPLORER") != "" {
+		explorerPurger = queryserver.NewClusterPurger()
+		clusterPurger = explorerPurger
+		explorerQuerier = queryserver.NewQuerier()
+		profilesQuerier = explorerQuerier
+	}
 
 	// Add weave-gitops enterprise handlers
 	clusterServer := server.NewClusterServer(
@@ -670,6 +731,17 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 			Cluster:               args.Cluster,
 			Estimator:             estimator,
 			UIConfig:              args.UIConfig,
+			ClusterProber:         args.ClusterProber,
+			KubeconfigOIDC: server.KubeconfigOIDCOptions{
+				IssuerURL:       args.OIDC.IssuerURL,
+				ClientID:        args.OIDC.ClientID,
+				Scopes:          args.OIDC.CustomScopes,
+				DisableEmbedded: args.DisableEmbeddedKubeconfig,
+				ProxyURL:        clusterProxyURL,
+			},
+			ChartsCacheWriter: args.ChartsCache,
+			ExplorerPurger:    clusterPurger,
+			ExplorerQuerier:   profilesQuerier,
 		},
 	)
 	if err := capi_proto.RegisterClustersServiceHandlerServer(ctx, grpcMux, clusterServer); err != nil {
@@ -712,6 +784,10 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 			ServiceAccount:      args.CollectorServiceAccount,
 			EnableObjectCleaner: !args.ExplorerCleanerDisabled,
 			EnabledFor:          args.ExplorerEnabledFor,
+			ManagementFetcher:   args.ManagementFetcher,
+			Cluster:             args.Cluster,
+			ClusterPurger:       explorerPurger,
+			Querier:             explorerQuerier,
 		})
 		if err != nil {
 			return fmt.Errorf("hydrating query server: %w", err)
@@ -732,13 +808,27 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 	}
 
 	if featureflags.Get("WEAVE_GITOPS_FEATURE_TERRAFORM_UI") != "" {
-		if err := tfserver.Hydrate(ctx, grpcMux, tfserver.ServerOpts{
-			Logger:         args.Log,
-			ClientsFactory: args.ClustersManager,
-			Scheme:         args.KubernetesClient.Scheme(),
-		}); err != nil {
+		tfOpts := tfserver.ServerOpts{
+			Logger:            args.Log,
+			ClientsFactory:    args.ClustersManager,
+			Scheme:            args.KubernetesClient.Scheme(),
+			ProviderCreator:   git.NewFactory(args.Log),
+			ManagementFetcher: args.ManagementFetcher,
+			Cluster:           args.Cluster,
+		}
+		if err := tfserver.Hydrate(ctx, grpcMux, tfOpts); err != nil {
 			return fmt.Errorf("hydrating terraform server: %w", err)
 		}
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("could not get hostname for terraform plan recording: %w", err)
+		}
+		recorder := tfserver.NewPlanRecorder(tfOpts, tfserver.DefaultPlanRecordingInterval)
+		go func() {
+			if err := recorder.StartWithLeaderElection(ctx, args.KubernetesClientSet.CoordinationV1(), args.RuntimeNamespace, hostname+"_"+string(uuid.NewUUID())); err != nil {
+				args.Log.Error(err, "failed to start terraform plan recording")
+			}
+		}()
 	}
 
 	if err := preview.Hydrate(ctx, grpcMux, preview.ServerOpts{
@@ -866,6 +956,11 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 
 	mux.Handle("/v1/", commonMiddleware(grpcHttpHandler))
 
+	if args.ClusterProxyEnabled {
+		proxyHandler := proxy.NewHandler(args.Log, args.ClustersManager, args.Cluster, userPrefixes, proxy.DefaultPathPrefix)
+		mux.Handle(proxy.DefaultPathPrefix, commonMiddleware(auth.WithAPIAuth(proxyHandler, srv, nil, args.SessionManager)))
+	}
+
 	staticAssetsWithGz := gziphandler.GzipHandler(assetHandler)
 
 	mux.Handle("/", staticAssetsWithGz)
@@ -911,6 +1006,21 @@ func RunInProcessGateway(ctx context.Context, addr string, setters ...Option) er
 	return nil
 }
 
+// externalURL returns the URL of a path of clusters-service for the clients outside the cluster,
+// on the host of the OIDC redirect URL.
+func externalURL(redirectURL, routePrefix, path string) (string, error) {
+	if redirectURL == "" {
+		return "", ErrNoRedirectURL
+	}
+
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid redirect URL: %w", err)
+	}
+
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host}).JoinPath(routePrefix, path).String() + "/", nil
+}
+
 func TLSConfig(hosts []string) (*tls.Config, error) {
 	certPEMBlock, keyPEMBlock, err := generateKeyPair(hosts)
 	if err != nil {
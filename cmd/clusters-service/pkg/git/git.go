@@ -29,6 +29,8 @@ type Provider interface {
 	GetRepository(ctx context.Context, gp GitProvider, url string) (*git.Repository, error)
 	GetTreeList(ctx context.Context, gp GitProvider, repoUrl string, sha string, path string, recursive bool) ([]*git.TreeEntry, error)
 	ListPullRequests(ctx context.Context, gp GitProvider, url string) ([]*git.PullRequest, error)
+	ListCommits(ctx context.Context, gp GitProvider, url, branch string, perPage, page int) ([]*git.CommitInfo, error)
+	GetFileContents(ctx context.Context, gp GitProvider, url, path, ref string) (string, error)
 }
 
 type GitProviderService struct {
@@ -139,6 +141,26 @@ func (s *GitProviderService) ListPullRequests(ctx context.Context, gp GitProvide
 	return provider.ListPullRequests(ctx, repoURL)
 }
 
+// ListCommits returns a page of the commits of a branch, newest first.
+func (s *GitProviderService) ListCommits(ctx context.Context, gp GitProvider, repoURL, branch string, perPage, page int) ([]*git.CommitInfo, error) {
+	provider, err := getGitProviderClient(s.log, gp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get a git provider client for %q: %w", gp.Type, err)
+	}
+
+	return provider.ListCommits(ctx, repoURL, branch, perPage, page)
+}
+
+// GetFileContents returns the contents of a file at a branch or commit.
+func (s *GitProviderService) GetFileContents(ctx context.Context, gp GitProvider, repoURL, path, ref string) (string, error) {
+	provider, err := getGitProviderClient(s.log, gp)
+	if err != nil {
+		return "", fmt.Errorf("unable to get a git provider client for %q: %w", gp.Type, err)
+	}
+
+	return provider.GetFileContents(ctx, repoURL, path, ref)
+}
+
 type Commit struct {
 	CommitMessage string
 	Files         []gitprovider.CommitFile
@@ -2,6 +2,7 @@ package gitfakes
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -27,6 +28,12 @@ type FakeGitProvider struct {
 	CommittedFiles []git.CommitFile
 	OriginalFiles  []string
 	pullRequests   []*git.PullRequest
+	// Commits are returned by ListCommits, newest first.
+	Commits []*git.CommitInfo
+	// Files are returned by GetFileContents, keyed by path.
+	Files map[string]string
+	// FileContentsErr is returned by GetFileContents when set.
+	FileContentsErr error
 }
 
 func (p *FakeGitProvider) WriteFilesToBranchAndCreatePullRequest(ctx context.Context, req csgit.WriteFilesToBranchAndCreatePullRequestRequest) (*csgit.WriteFilesToBranchAndCreatePullRequestResponse, error) {
@@ -86,6 +93,40 @@ func (p *FakeGitProvider) ListPullRequests(ctx context.Context, gp csgit.GitProv
 	return p.pullRequests, nil
 }
 
+func (p *FakeGitProvider) ListCommits(ctx context.Context, gp csgit.GitProvider, url, branch string, perPage, page int) ([]*git.CommitInfo, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(p.Commits) {
+		return []*git.CommitInfo{}, nil
+	}
+
+	end := start + perPage
+	if end > len(p.Commits) {
+		end = len(p.Commits)
+	}
+
+	return p.Commits[start:end], nil
+}
+
+func (p *FakeGitProvider) GetFileContents(ctx context.Context, gp csgit.GitProvider, url, path, ref string) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	if p.FileContentsErr != nil {
+		return "", p.FileContentsErr
+	}
+
+	content, ok := p.Files[path]
+	if !ok {
+		return "", fmt.Errorf("file %q not found", path)
+	}
+
+	return content, nil
+}
+
 func NewPullRequest(id int, title string, description string, url string, merged bool, sourceBranch string) *git.PullRequest {
 	return &git.PullRequest{
 		Title:       title,
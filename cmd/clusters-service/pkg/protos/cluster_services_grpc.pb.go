@@ -62,8 +62,32 @@ type ClustersServiceClient interface {
 	ListGitopsClusters(ctx context.Context, in *ListGitopsClustersRequest, opts ...grpc.CallOption) (*ListGitopsClustersResponse, error)
 	// Get the kubeconfig for a GitOpsCluster
 	//
-	// Provide the name and namespace of a `GitOpsCluster` to retrieve its kubeconfig
+	// Provide the name and namespace of a `GitOpsCluster` to retrieve its kubeconfig.
+	// Set the mode to `oidc` to retrieve a kubeconfig without credentials that
+	// authenticates with the OIDC issuer of Weave GitOps.
 	GetKubeconfig(ctx context.Context, in *GetKubeconfigRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// List the connectivity, Kubernetes version and capabilities of the clusters
+	//
+	// Probes are cached, set refresh to run discovery against the clusters again.
+	ListClusterCapabilities(ctx context.Context, in *ListClusterCapabilitiesRequest, opts ...grpc.CallOption) (*ListClusterCapabilitiesResponse, error)
+	// Probe a cluster with its stored kubeconfig to get its connectivity, Kubernetes version and capabilities
+	ProbeCluster(ctx context.Context, in *ProbeClusterRequest, opts ...grpc.CallOption) (*ProbeClusterResponse, error)
+	// Decommission a GitOpsCluster
+	//
+	// Checks that no Terraform objects of the cluster still hold resources,
+	// suspends its Flux Kustomizations and HelmReleases, creates a pull request
+	// removing the cluster with its profiles and automations, and purges its
+	// Explorer and Helm charts cache data.
+	// Creates a job. Its progress can be retrieved via /v1/clusters/decommission/jobs/{job_id}
+	DecommissionCluster(ctx context.Context, in *DecommissionClusterRequest, opts ...grpc.CallOption) (*DecommissionClusterResponse, error)
+	// Retrieve the progress of a /v1/namespaces/{namespace}/clusters/{name}/decommission job
+	GetDecommissionClusterJob(ctx context.Context, in *GetDecommissionClusterJobRequest, opts ...grpc.CallOption) (*GetDecommissionClusterJobResponse, error)
+	// Export the inventory of the clusters
+	//
+	// Combines the GitopsClusters with their CAPI provider, Kubernetes version and
+	// node pools, the profiles installed on them as collected by the Explorer,
+	// their owners and the template they were created from.
+	ExportClusterInventory(ctx context.Context, in *ExportClusterInventoryRequest, opts ...grpc.CallOption) (*ExportClusterInventoryResponse, error)
 	// Get the Weave GitOps Enterprise version
 	GetEnterpriseVersion(ctx context.Context, in *GetEnterpriseVersionRequest, opts ...grpc.CallOption) (*GetEnterpriseVersionResponse, error)
 	// Get the Weave GitOps Enterprise configuration
@@ -233,6 +257,51 @@ func (c *clustersServiceClient) GetKubeconfig(ctx context.Context, in *GetKubeco
 	return out, nil
 }
 
+func (c *clustersServiceClient) ListClusterCapabilities(ctx context.Context, in *ListClusterCapabilitiesRequest, opts ...grpc.CallOption) (*ListClusterCapabilitiesResponse, error) {
+	out := new(ListClusterCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/ListClusterCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clustersServiceClient) ProbeCluster(ctx context.Context, in *ProbeClusterRequest, opts ...grpc.CallOption) (*ProbeClusterResponse, error) {
+	out := new(ProbeClusterResponse)
+	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/ProbeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clustersServiceClient) DecommissionCluster(ctx context.Context, in *DecommissionClusterRequest, opts ...grpc.CallOption) (*DecommissionClusterResponse, error) {
+	out := new(DecommissionClusterResponse)
+	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/DecommissionCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clustersServiceClient) GetDecommissionClusterJob(ctx context.Context, in *GetDecommissionClusterJobRequest, opts ...grpc.CallOption) (*GetDecommissionClusterJobResponse, error) {
+	out := new(GetDecommissionClusterJobResponse)
+	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/GetDecommissionClusterJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clustersServiceClient) ExportClusterInventory(ctx context.Context, in *ExportClusterInventoryRequest, opts ...grpc.CallOption) (*ExportClusterInventoryResponse, error) {
+	out := new(ExportClusterInventoryResponse)
+	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/ExportClusterInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clustersServiceClient) GetEnterpriseVersion(ctx context.Context, in *GetEnterpriseVersionRequest, opts ...grpc.CallOption) (*GetEnterpriseVersionResponse, error) {
 	out := new(GetEnterpriseVersionResponse)
 	err := c.cc.Invoke(ctx, "/cluster_services.v1.ClustersService/GetEnterpriseVersion", in, out, opts...)
@@ -460,8 +529,32 @@ type ClustersServiceServer interface {
 	ListGitopsClusters(context.Context, *ListGitopsClustersRequest) (*ListGitopsClustersResponse, error)
 	// Get the kubeconfig for a GitOpsCluster
 	//
-	// Provide the name and namespace of a `GitOpsCluster` to retrieve its kubeconfig
+	// Provide the name and namespace of a `GitOpsCluster` to retrieve its kubeconfig.
+	// Set the mode to `oidc` to retrieve a kubeconfig without credentials that
+	// authenticates with the OIDC issuer of Weave GitOps.
 	GetKubeconfig(context.Context, *GetKubeconfigRequest) (*httpbody.HttpBody, error)
+	// List the connectivity, Kubernetes version and capabilities of the clusters
+	//
+	// Probes are cached, set refresh to run discovery against the clusters again.
+	ListClusterCapabilities(context.Context, *ListClusterCapabilitiesRequest) (*ListClusterCapabilitiesResponse, error)
+	// Probe a cluster with its stored kubeconfig to get its connectivity, Kubernetes version and capabilities
+	ProbeCluster(context.Context, *ProbeClusterRequest) (*ProbeClusterResponse, error)
+	// Decommission a GitOpsCluster
+	//
+	// Checks that no Terraform objects of the cluster still hold resources,
+	// suspends its Flux Kustomizations and HelmReleases, creates a pull request
+	// removing the cluster with its profiles and automations, and purges its
+	// Explorer and Helm charts cache data.
+	// Creates a job. Its progress can be retrieved via /v1/clusters/decommission/jobs/{job_id}
+	DecommissionCluster(context.Context, *DecommissionClusterRequest) (*DecommissionClusterResponse, error)
+	// Retrieve the progress of a /v1/namespaces/{namespace}/clusters/{name}/decommission job
+	GetDecommissionClusterJob(context.Context, *GetDecommissionClusterJobRequest) (*GetDecommissionClusterJobResponse, error)
+	// Export the inventory of the clusters
+	//
+	// Combines the GitopsClusters with their CAPI provider, Kubernetes version and
+	// node pools, the profiles installed on them as collected by the Explorer,
+	// their owners and the template they were created from.
+	ExportClusterInventory(context.Context, *ExportClusterInventoryRequest) (*ExportClusterInventoryResponse, error)
 	// Get the Weave GitOps Enterprise version
 	GetEnterpriseVersion(context.Context, *GetEnterpriseVersionRequest) (*GetEnterpriseVersionResponse, error)
 	// Get the Weave GitOps Enterprise configuration
@@ -550,6 +643,21 @@ func (UnimplementedClustersServiceServer) ListGitopsClusters(context.Context, *L
 func (UnimplementedClustersServiceServer) GetKubeconfig(context.Context, *GetKubeconfigRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKubeconfig not implemented")
 }
+func (UnimplementedClustersServiceServer) ListClusterCapabilities(context.Context, *ListClusterCapabilitiesRequest) (*ListClusterCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterCapabilities not implemented")
+}
+func (UnimplementedClustersServiceServer) ProbeCluster(context.Context, *ProbeClusterRequest) (*ProbeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbeCluster not implemented")
+}
+func (UnimplementedClustersServiceServer) DecommissionCluster(context.Context, *DecommissionClusterRequest) (*DecommissionClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionCluster not implemented")
+}
+func (UnimplementedClustersServiceServer) GetDecommissionClusterJob(context.Context, *GetDecommissionClusterJobRequest) (*GetDecommissionClusterJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDecommissionClusterJob not implemented")
+}
+func (UnimplementedClustersServiceServer) ExportClusterInventory(context.Context, *ExportClusterInventoryRequest) (*ExportClusterInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportClusterInventory not implemented")
+}
 func (UnimplementedClustersServiceServer) GetEnterpriseVersion(context.Context, *GetEnterpriseVersionRequest) (*GetEnterpriseVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnterpriseVersion not implemented")
 }
@@ -857,6 +965,96 @@ func _ClustersService_GetKubeconfig_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClustersService_ListClusterCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClusterCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServiceServer).ListClusterCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster_services.v1.ClustersService/ListClusterCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServiceServer).ListClusterCapabilities(ctx, req.(*ListClusterCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClustersService_ProbeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServiceServer).ProbeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster_services.v1.ClustersService/ProbeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServiceServer).ProbeCluster(ctx, req.(*ProbeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClustersService_DecommissionCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServiceServer).DecommissionCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster_services.v1.ClustersService/DecommissionCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServiceServer).DecommissionCluster(ctx, req.(*DecommissionClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClustersService_GetDecommissionClusterJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDecommissionClusterJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServiceServer).GetDecommissionClusterJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster_services.v1.ClustersService/GetDecommissionClusterJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServiceServer).GetDecommissionClusterJob(ctx, req.(*GetDecommissionClusterJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClustersService_ExportClusterInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportClusterInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClustersServiceServer).ExportClusterInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster_services.v1.ClustersService/ExportClusterInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClustersServiceServer).ExportClusterInventory(ctx, req.(*ExportClusterInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClustersService_GetEnterpriseVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEnterpriseVersionRequest)
 	if err := dec(in); err != nil {
@@ -1276,6 +1474,26 @@ var ClustersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKubeconfig",
 			Handler:    _ClustersService_GetKubeconfig_Handler,
 		},
+		{
+			MethodName: "ListClusterCapabilities",
+			Handler:    _ClustersService_ListClusterCapabilities_Handler,
+		},
+		{
+			MethodName: "ProbeCluster",
+			Handler:    _ClustersService_ProbeCluster_Handler,
+		},
+		{
+			MethodName: "DecommissionCluster",
+			Handler:    _ClustersService_DecommissionCluster_Handler,
+		},
+		{
+			MethodName: "GetDecommissionClusterJob",
+			Handler:    _ClustersService_GetDecommissionClusterJob_Handler,
+		},
+		{
+			MethodName: "ExportClusterInventory",
+			Handler:    _ClustersService_ExportClusterInventory_Handler,
+		},
 		{
 			MethodName: "GetEnterpriseVersion",
 			Handler:    _ClustersService_GetEnterpriseVersion_Handler,
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+)
+
+// ClusterProber runs discovery against the clusters to report their connectivity, Kubernetes version
+// and capabilities.
+type ClusterProber interface {
+	ProbeAll(ctx context.Context, refresh bool, visible func(types.NamespacedName) bool) ([]fetcher.ClusterProbe, error)
+	Probe(ctx context.Context, cluster types.NamespacedName) (fetcher.ClusterProbe, error)
+}
+
+var errProbingDisabled = grpcStatus.Error(codes.Unavailable, "cluster probing is not enabled")
+
+func (s *server) ListClusterCapabilities(ctx context.Context, msg *capiv1_proto.ListClusterCapabilitiesRequest) (*capiv1_proto.ListClusterCapabilitiesResponse, error) {
+	if s.clusterProber == nil {
+		return nil, errProbingDisabled
+	}
+
+	// Only the probes of the GitopsClusters that the user can list are returned
+	namespacedLists, err := s.managementFetcher.Fetch(ctx, "GitopsCluster", func() client.ObjectList {
+		return &gitopsv1alpha1.GitopsClusterList{}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query clusters: %w", err)
+	}
+
+	visible := map[types.NamespacedName]bool{{Name: s.cluster}: true}
+	listErrors := []*capiv1_proto.ListError{}
+	for _, namespacedList := range namespacedLists {
+		if namespacedList.Error != nil {
+			listErrors = append(listErrors, &capiv1_proto.ListError{
+				Namespace: namespacedList.Namespace,
+				Message:   namespacedList.Error.Error(),
+			})
+		}
+		clustersList := namespacedList.List.(*gitopsv1alpha1.GitopsClusterList)
+		for _, c := range clustersList.Items {
+			visible[types.NamespacedName{Name: c.Name, Namespace: c.Namespace}] = true
+		}
+	}
+
+	probes, err := s.clusterProber.ProbeAll(ctx, msg.Refresh, func(cluster types.NamespacedName) bool {
+		return visible[cluster]
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe clusters: %w", err)
+	}
+
+	clusters := []*capiv1_proto.ClusterCapabilities{}
+	for _, probe := range probes {
+		clusters = append(clusters, toClusterCapabilitiesResponse(probe))
+	}
+
+	return &capiv1_proto.ListClusterCapabilitiesResponse{
+		Clusters: clusters,
+		Errors:   listErrors,
+	}, nil
+}
+
+func (s *server) ProbeCluster(ctx context.Context, msg *capiv1_proto.ProbeClusterRequest) (*capiv1_proto.ProbeClusterResponse, error) {
+	if s.clusterProber == nil {
+		return nil, errProbingDisabled
+	}
+	if msg.Name == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "cluster name is required")
+	}
+
+	cluster := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+	if !fetcher.IsManagementCluster(s.cluster, cluster) {
+		// Check the user can get the GitopsCluster, the probe uses the kubeconfig of its secret
+		cl, err := s.clientGetter.Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := cl.Get(ctx, cluster, &gitopsv1alpha1.GitopsCluster{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, grpcStatus.Errorf(codes.NotFound, "cluster %s not found", cluster)
+			}
+			return nil, fmt.Errorf("failed to get GitopsCluster %s: %w", cluster, err)
+		}
+	}
+
+	probe, err := s.clusterProber.Probe(ctx, cluster)
+	if err != nil {
+		if errors.Is(err, fetcher.ErrClusterNotFound) {
+			return nil, grpcStatus.Error(codes.NotFound, err.Error())
+		}
+		return nil, fmt.Errorf("failed to probe cluster %s: %w", cluster, err)
+	}
+
+	return &capiv1_proto.ProbeClusterResponse{
+		Cluster: toClusterCapabilitiesResponse(probe),
+	}, nil
+}
+
+func toClusterCapabilitiesResponse(probe fetcher.ClusterProbe) *capiv1_proto.ClusterCapabilities {
+	capabilities := []*capiv1_proto.ClusterCapability{}
+	for _, c := range probe.Capabilities {
+		capabilities = append(capabilities, &capiv1_proto.ClusterCapability{
+			Name:        c.Name,
+			Installed:   c.Installed,
+			ApiVersions: c.APIVersions,
+		})
+	}
+
+	return &capiv1_proto.ClusterCapabilities{
+		ClusterName:       fetcher.ToClusterName(probe.Cluster),
+		Reachable:         probe.Reachable,
+		Error:             probe.Error,
+		KubernetesVersion: probe.KubernetesVersion,
+		Capabilities:      capabilities,
+		ProbedAt:          probe.ProbedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	capiv1_protos "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+)
+
+type fakeClusterProber struct {
+	probes  []fetcher.ClusterProbe
+	refresh bool
+	probed  []types.NamespacedName
+}
+
+func (f *fakeClusterProber) ProbeAll(ctx context.Context, refresh bool, visible func(types.NamespacedName) bool) ([]fetcher.ClusterProbe, error) {
+	f.refresh = refresh
+	probes := []fetcher.ClusterProbe{}
+	for _, probe := range f.probes {
+		if visible == nil || visible(probe.Cluster) {
+			f.probed = append(f.probed, probe.Cluster)
+			probes = append(probes, probe)
+		}
+	}
+	return probes, nil
+}
+
+func (f *fakeClusterProber) Probe(ctx context.Context, cluster types.NamespacedName) (fetcher.ClusterProbe, error) {
+	for _, probe := range f.probes {
+		if probe.Cluster == cluster {
+			return probe, nil
+		}
+	}
+	return fetcher.ClusterProbe{}, fetcher.ErrClusterNotFound
+}
+
+func TestListClusterCapabilities(t *testing.T) {
+	probedAt := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	prober := &fakeClusterProber{
+		probes: []fetcher.ClusterProbe{
+			{
+				Cluster:           types.NamespacedName{Name: "dev", Namespace: "default"},
+				Reachable:         true,
+				KubernetesVersion: "v1.28.2",
+				Capabilities: []fetcher.Capability{
+					{Name: fetcher.CapabilityFlux, Installed: true, APIVersions: []string{"source.toolkit.fluxcd.io/v1"}},
+					{Name: fetcher.CapabilityTerraform},
+				},
+				ProbedAt: probedAt,
+			},
+			{
+				Cluster:  types.NamespacedName{Name: "hidden", Namespace: "other"},
+				ProbedAt: probedAt,
+			},
+			{
+				Cluster:  types.NamespacedName{Name: "management"},
+				Error:    "failed to get server version: timeout",
+				ProbedAt: probedAt,
+			},
+		},
+	}
+
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "dev"
+				o.ObjectMeta.Namespace = "default"
+			}),
+		},
+		cluster:       "management",
+		clusterProber: prober,
+	})
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "userID"})
+	res, err := s.ListClusterCapabilities(ctx, &capiv1_protos.ListClusterCapabilitiesRequest{Refresh: true})
+	if err != nil {
+		t.Fatalf("failed to list cluster capabilities: %s", err)
+	}
+	if !prober.refresh {
+		t.Fatal("expected the probes to be refreshed")
+	}
+	if diff := cmp.Diff([]types.NamespacedName{{Name: "dev", Namespace: "default"}, {Name: "management"}}, prober.probed); diff != "" {
+		t.Fatalf("expected only the visible clusters to be probed:\n%s", diff)
+	}
+
+	expected := []*capiv1_protos.ClusterCapabilities{
+		{
+			ClusterName:       "default/dev",
+			Reachable:         true,
+			KubernetesVersion: "v1.28.2",
+			Capabilities: []*capiv1_protos.ClusterCapability{
+				{Name: "flux", Installed: true, ApiVersions: []string{"source.toolkit.fluxcd.io/v1"}},
+				{Name: "tf-controller"},
+			},
+			ProbedAt: "2023-10-01T12:00:00Z",
+		},
+		{
+			ClusterName:  "management",
+			Error:        "failed to get server version: timeout",
+			Capabilities: []*capiv1_protos.ClusterCapability{},
+			ProbedAt:     "2023-10-01T12:00:00Z",
+		},
+	}
+	if diff := cmp.Diff(expected, res.Clusters, protocmp.Transform()); diff != "" {
+		t.Fatalf("cluster capabilities didn't match expected:\n%s", diff)
+	}
+}
+
+func TestProbeCluster(t *testing.T) {
+	prober := &fakeClusterProber{
+		probes: []fetcher.ClusterProbe{
+			{Cluster: types.NamespacedName{Name: "dev", Namespace: "default"}, Reachable: true},
+			{Cluster: types.NamespacedName{Name: "management"}, Reachable: true},
+		},
+	}
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "dev"
+				o.ObjectMeta.Namespace = "default"
+			}),
+		},
+		cluster:       "management",
+		clusterProber: prober,
+	})
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "userID"})
+
+	t.Run("probes a GitopsCluster", func(t *testing.T) {
+		res, err := s.ProbeCluster(ctx, &capiv1_protos.ProbeClusterRequest{Name: "dev", Namespace: "default"})
+		if err != nil {
+			t.Fatalf("failed to probe cluster: %s", err)
+		}
+		if res.Cluster.ClusterName != "default/dev" || !res.Cluster.Reachable {
+			t.Fatalf("unexpected probe %v", res.Cluster)
+		}
+	})
+
+	t.Run("probes the management cluster", func(t *testing.T) {
+		res, err := s.ProbeCluster(ctx, &capiv1_protos.ProbeClusterRequest{Name: "management"})
+		if err != nil {
+			t.Fatalf("failed to probe cluster: %s", err)
+		}
+		if res.Cluster.ClusterName != "management" {
+			t.Fatalf("unexpected probe %v", res.Cluster)
+		}
+	})
+
+	t.Run("fails for unknown clusters", func(t *testing.T) {
+		_, err := s.ProbeCluster(ctx, &capiv1_protos.ProbeClusterRequest{Name: "unknown", Namespace: "default"})
+		if grpcStatus.Code(err) != codes.NotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+
+		_, err = s.ProbeCluster(ctx, &capiv1_protos.ProbeClusterRequest{Name: "unknown"})
+		if grpcStatus.Code(err) != codes.NotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/viper"
 	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
 	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/services/profiles"
 	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
@@ -32,6 +33,7 @@ import (
 	grpcStatus "google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
@@ -72,6 +74,11 @@ type generateProfileFilesParams struct {
 }
 
 func (s *server) ListGitopsClusters(ctx context.Context, msg *capiv1_proto.ListGitopsClustersRequest) (*capiv1_proto.ListGitopsClustersResponse, error) {
+	selector, err := fetcher.ParseClusterSelector(msg.ClusterSelector)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
+	}
+
 	namespacedLists, err := s.managementFetcher.Fetch(ctx, "GitopsCluster", func() client.ObjectList {
 		return &gitopsv1alpha1.GitopsClusterList{}
 	})
@@ -130,6 +137,10 @@ func (s *server) ListGitopsClusters(ctx context.Context, msg *capiv1_proto.ListG
 
 	clusters = append(clusters, mgmtCluster)
 
+	if msg.ClusterSelector != "" {
+		clusters = filterClustersBySelector(clusters, selector)
+	}
+
 	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
 	return &capiv1_proto.ListGitopsClustersResponse{
 		GitopsClusters: clusters,
@@ -297,67 +308,27 @@ func (s *server) CreateDeletionPullRequest(ctx context.Context, msg *capiv1_prot
 		baseBranch = msg.BaseBranch
 	}
 
-	var filesList []git.CommitFile
+	var clusters []types.NamespacedName
 	if len(msg.ClusterNamespacedNames) > 0 {
 		for _, clusterNamespacedName := range msg.ClusterNamespacedNames {
-			// Files in manifest path
-			path := getClusterManifestPath(
-				createNamespacedName(
-					clusterNamespacedName.Name,
-					getClusterNamespace(clusterNamespacedName.Namespace)),
+			clusters = append(clusters, createNamespacedName(
+				clusterNamespacedName.Name,
+				getClusterNamespace(clusterNamespacedName.Namespace)),
 			)
-			filesList = append(filesList, git.CommitFile{
-				Path:    path,
-				Content: nil,
-			})
-
-			// Files in cluster path
-			clusterDirPath := getClusterDirPath(types.NamespacedName{
-				Name:      clusterNamespacedName.Name,
-				Namespace: getClusterNamespace(clusterNamespacedName.Namespace),
-			})
-
-			treeEntries, err := s.provider.GetTreeList(ctx, *gp, repositoryURL, baseBranch, clusterDirPath, true)
-			if err != nil {
-				return nil, fmt.Errorf("error getting list of trees in repo: %s@%s: %w", repositoryURL, baseBranch, err)
-			}
-
-			for _, treeEntry := range treeEntries {
-				filesList = append(filesList, git.CommitFile{
-					Path:    treeEntry.Path,
-					Content: nil,
-				})
-			}
 		}
 	} else {
 		for _, clusterName := range msg.ClusterNames {
-			//Files in manifest path
-			path := getClusterManifestPath(
-				createNamespacedName(clusterName, getClusterNamespace("")),
-			)
-			filesList = append(filesList, git.CommitFile{
-				Path:    path,
-				Content: nil,
-			})
-
-			// Files in cluster path
-			clusterDirPath := getClusterDirPath(types.NamespacedName{
-				Name:      clusterName,
-				Namespace: getClusterNamespace(""),
-			})
-
-			treeEntries, err := s.provider.GetTreeList(ctx, *gp, repositoryURL, baseBranch, clusterDirPath, true)
-			if err != nil {
-				return nil, fmt.Errorf("error getting list of trees in repo: %s@%s: %w", repositoryURL, baseBranch, err)
-			}
+			clusters = append(clusters, createNamespacedName(clusterName, getClusterNamespace("")))
+		}
+	}
 
-			for _, treeEntry := range treeEntries {
-				filesList = append(filesList, git.CommitFile{
-					Path:    treeEntry.Path,
-					Content: nil,
-				})
-			}
+	var filesList []git.CommitFile
+	for _, cluster := range clusters {
+		files, err := s.clusterDeletionFiles(ctx, *gp, repositoryURL, baseBranch, cluster)
+		if err != nil {
+			return nil, err
 		}
+		filesList = append(filesList, files...)
 	}
 
 	if msg.HeadBranch == "" {
@@ -398,6 +369,33 @@ func (s *server) CreateDeletionPullRequest(ctx context.Context, msg *capiv1_prot
 	}, nil
 }
 
+// clusterDeletionFiles returns the files to delete to remove a cluster from the repository: its
+// manifest and the files in its directory, such as its profiles and automations.
+func (s *server) clusterDeletionFiles(ctx context.Context, gp csgit.GitProvider, repositoryURL, baseBranch string, cluster types.NamespacedName) ([]git.CommitFile, error) {
+	// Files in manifest path
+	files := []git.CommitFile{
+		{
+			Path:    getClusterManifestPath(cluster),
+			Content: nil,
+		},
+	}
+
+	// Files in cluster path
+	treeEntries, err := s.provider.GetTreeList(ctx, gp, repositoryURL, baseBranch, getClusterDirPath(cluster), true)
+	if err != nil {
+		return nil, fmt.Errorf("error getting list of trees in repo: %s@%s: %w", repositoryURL, baseBranch, err)
+	}
+
+	for _, treeEntry := range treeEntries {
+		files = append(files, git.CommitFile{
+			Path:    treeEntry.Path,
+			Content: nil,
+		})
+	}
+
+	return files, nil
+}
+
 func (s *server) kubeConfigForCluster(ctx context.Context, cluster types.NamespacedName) ([]byte, error) {
 	cl, err := s.clientGetter.Client(ctx)
 	if err != nil {
@@ -409,6 +407,37 @@ func (s *server) kubeConfigForCluster(ctx context.Context, cluster types.Namespa
 	if err != nil {
 		return nil, fmt.Errorf("failed to get GitopsCluster %s: %w", cluster, err)
 	}
+
+	return kubeConfigFromClusterSecrets(ctx, cl, cluster, gc)
+}
+
+// oidcKubeConfigForCluster returns the kubeconfig of the cluster secret to build an OIDC kubeconfig from.
+// The user only needs to be able to get the GitopsCluster, the secret is read with the server client
+// as only its cluster server and CA are kept.
+func (s *server) oidcKubeConfigForCluster(ctx context.Context, cluster types.NamespacedName) ([]byte, error) {
+	cl, err := s.clientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gc := &gitopsv1alpha1.GitopsCluster{}
+	if err := cl.Get(ctx, cluster, gc); err != nil {
+		return nil, fmt.Errorf("failed to get GitopsCluster %s: %w", cluster, err)
+	}
+
+	sc, err := s.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting server client: %w", err)
+	}
+	scoped, err := sc.Scoped(s.cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting server client for cluster %s: %w", s.cluster, err)
+	}
+
+	return kubeConfigFromClusterSecrets(ctx, scoped, cluster, gc)
+}
+
+func kubeConfigFromClusterSecrets(ctx context.Context, cl client.Client, cluster types.NamespacedName, gc *gitopsv1alpha1.GitopsCluster) ([]byte, error) {
 	if gc.Spec.SecretRef != nil {
 		secretRefName := client.ObjectKey{
 			Namespace: cluster.Namespace,
@@ -499,11 +528,44 @@ func secretByName(ctx context.Context, cl client.Client, name types.NamespacedNa
 
 // GetKubeconfig returns the Kubeconfig for the given workload cluster
 func (s *server) GetKubeconfig(ctx context.Context, msg *capiv1_proto.GetKubeconfigRequest) (*httpbody.HttpBody, error) {
-	val, err := s.kubeConfigForCluster(ctx, types.NamespacedName{Name: msg.Name, Namespace: getClusterNamespace(msg.Namespace)})
+	mode, err := s.kubeconfigOIDC.kubeconfigMode(msg.Mode)
 	if err != nil {
 		return nil, err
 	}
 
+	cluster := types.NamespacedName{Name: msg.Name, Namespace: getClusterNamespace(msg.Namespace)}
+	var val []byte
+	switch mode {
+	case KubeconfigModeProxy:
+		// The proxy only requires the user to be able to get the GitopsCluster, not its secret
+		cl, err := s.clientGetter.Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := cl.Get(ctx, cluster, &gitopsv1alpha1.GitopsCluster{}); err != nil {
+			return nil, fmt.Errorf("failed to get GitopsCluster %s: %w", cluster, err)
+		}
+		val, err = proxyKubeconfig(cluster, s.kubeconfigOIDC)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create proxy kubeconfig for cluster %s: %w", msg.Name, err)
+		}
+	case KubeconfigModeOIDC:
+		val, err = s.oidcKubeConfigForCluster(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		// Only the cluster server and CA of the kubeconfig secret are kept, the user logs in to the OIDC issuer
+		val, err = oidcKubeconfig(val, s.kubeconfigOIDC)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OIDC kubeconfig for cluster %s: %w", msg.Name, err)
+		}
+	default:
+		val, err = s.kubeConfigForCluster(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var acceptHeader string
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		if accept, ok := md["accept"]; ok {
@@ -1011,6 +1073,36 @@ func filterClustersByLabel(cl []*capiv1_proto.GitopsCluster, label string) []*ca
 	return clusters
 }
 
+// selectClusters returns the clusters matching a cluster selector, all the clusters if empty
+func (s *server) selectClusters(ctx context.Context, selector string) (*fetcher.ClusterSelector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+
+	clusterSelector, err := fetcher.SelectClusters(ctx, s.managementFetcher, s.cluster, selector)
+	if err != nil {
+		if errors.Is(err, fetcher.ErrInvalidClusterSelector) {
+			return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return clusterSelector, nil
+}
+
+// filterClustersBySelector returns the clusters whose labels match the selector
+func filterClustersBySelector(cl []*capiv1_proto.GitopsCluster, selector k8slabels.Selector) []*capiv1_proto.GitopsCluster {
+	clusters := []*capiv1_proto.GitopsCluster{}
+
+	for _, c := range cl {
+		if selector.Matches(k8slabels.Set(c.Labels)) {
+			clusters = append(clusters, c)
+		}
+	}
+
+	return clusters
+}
+
 func filterClustersByType(cl []*capiv1_proto.GitopsCluster, refType string) ([]*capiv1_proto.GitopsCluster, error) {
 	clusters := []*capiv1_proto.GitopsCluster{}
 
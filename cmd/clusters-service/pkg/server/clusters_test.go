@@ -54,12 +54,13 @@ import (
 
 func TestListGitopsClusters(t *testing.T) {
 	testCases := []struct {
-		name         string
-		clusterState []runtime.Object
-		refType      string
-		expected     []*capiv1_protos.GitopsCluster
-		capiEnabled  bool
-		err          error
+		name            string
+		clusterState    []runtime.Object
+		refType         string
+		clusterSelector string
+		expected        []*capiv1_protos.GitopsCluster
+		capiEnabled     bool
+		err             error
 	}{
 		{
 			name: "no clusters",
@@ -203,6 +204,40 @@ func TestListGitopsClusters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "filter by cluster selector",
+			clusterState: []runtime.Object{
+				makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+					o.ObjectMeta.Name = "prod-eu"
+					o.ObjectMeta.Namespace = "default"
+					o.ObjectMeta.Labels = map[string]string{"env": "prod", "region": "eu-west"}
+				}),
+				makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+					o.ObjectMeta.Name = "prod-us"
+					o.ObjectMeta.Namespace = "default"
+					o.ObjectMeta.Labels = map[string]string{"env": "prod", "region": "us-east"}
+				}),
+				makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+					o.ObjectMeta.Name = "dev-eu"
+					o.ObjectMeta.Namespace = "default"
+					o.ObjectMeta.Labels = map[string]string{"env": "dev", "region": "eu-west"}
+				}),
+			},
+			clusterSelector: "env=prod,region in (eu-west)",
+			expected: []*capiv1_protos.GitopsCluster{
+				{
+					Name:      "prod-eu",
+					Namespace: "default",
+					Labels:    map[string]string{"env": "prod", "region": "eu-west"},
+					Type:      "GitopsCluster",
+				},
+			},
+		},
+		{
+			name:            "invalid cluster selector",
+			clusterSelector: "env in",
+			err:             errors.New(`rpc error: code = InvalidArgument desc = invalid cluster selector "env in": unable to parse requirement: found '' expected: '('`),
+		},
 		{
 			name: "invalid refType for filtering",
 			clusterState: []runtime.Object{
@@ -360,6 +395,7 @@ func TestListGitopsClusters(t *testing.T) {
 			// request
 			listGitopsClustersRequest := new(capiv1_protos.ListGitopsClustersRequest)
 			listGitopsClustersRequest.RefType = tt.refType
+			listGitopsClustersRequest.ClusterSelector = tt.clusterSelector
 			listGitopsClustersResponse, err := s.ListGitopsClusters(ctx, listGitopsClustersRequest)
 
 			// check response
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -45,6 +46,7 @@ import (
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
 	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
@@ -62,6 +64,8 @@ func newTestScheme(t *testing.T) *runtime.Scheme {
 		rbacv1.AddToScheme,
 		esv1beta1.AddToScheme,
 		kustomizev1.AddToScheme,
+		helmv2.AddToScheme,
+		tfctrl.AddToScheme,
 	}
 	err := schemeBuilder.AddToScheme(scheme)
 	if err != nil {
@@ -97,6 +101,11 @@ type serverOptions struct {
 	valuesFetcher         helm.ValuesFetcher
 	cluster               string
 	estimator             estimation.Estimator
+	clusterProber         ClusterProber
+	kubeconfigOIDC        KubeconfigOIDCOptions
+	chartsCacheWriter     helm.ChartsCacheWriter
+	explorerPurger        ClusterPurger
+	explorerQuerier       ExplorerQuerier
 }
 
 func getServer(t *testing.T, clients map[string]client.Client, namespaces map[string][]corev1.Namespace) capiv1_protos.ClustersServiceServer {
@@ -180,6 +189,11 @@ func createServer(t *testing.T, o serverOptions) capiv1_protos.ClustersServiceSe
 			ManagementFetcher:     mgmtFetcher,
 			Cluster:               o.cluster,
 			Estimator:             o.estimator,
+			ClusterProber:         o.clusterProber,
+			KubeconfigOIDC:        o.kubeconfigOIDC,
+			ChartsCacheWriter:     o.chartsCacheWriter,
+			ExplorerPurger:        o.explorerPurger,
+			ExplorerQuerier:       o.explorerQuerier,
 		},
 	)
 }
@@ -200,6 +214,7 @@ func makeTestClustersManager(t *testing.T, clusterState ...runtime.Object) *clus
 	fakeFactory := &clustersmngrfakes.FakeClustersManager{}
 	fakeFactory.GetImpersonatedClientReturns(clustersClient, nil)
 	fakeFactory.GetImpersonatedClientForClusterReturns(clustersClient, nil)
+	fakeFactory.GetServerClientReturns(clustersClient, nil)
 	fakeCluster := &clusterfakes.FakeCluster{}
 	fakeCluster.GetNameReturns("management")
 	fakeFactory.GetClustersReturns([]cluster.Cluster{fakeCluster})
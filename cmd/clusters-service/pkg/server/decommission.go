@@ -0,0 +1,552 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cheshir/ttlcache"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
+)
+
+// The steps of a cluster decommission, in the order they run.
+const (
+	decommissionStepCheckTerraform   = "check-terraform"
+	decommissionStepDrainFlux        = "drain-flux"
+	decommissionStepPullRequest      = "create-pull-request"
+	decommissionStepWaitForRemoval   = "wait-for-removal"
+	decommissionStepPurgeExplorer    = "purge-explorer"
+	decommissionStepPurgeChartsCache = "purge-charts-cache"
+)
+
+// The statuses of the steps of a cluster decommission.
+const (
+	stepStatusPending   = "pending"
+	stepStatusRunning   = "running"
+	stepStatusSucceeded = "succeeded"
+	stepStatusFailed    = "failed"
+	stepStatusSkipped   = "skipped"
+	// stepStatusRolledBack is the status of the drain of Flux when it is undone after a failure
+	stepStatusRolledBack = "rolled-back"
+)
+
+// How often and how long the decommission waits for the pull request to remove the GitopsCluster
+// before purging its data. Variables so tests can shorten them.
+var (
+	decommissionRemovalPollInterval = 30 * time.Second
+	decommissionRemovalTimeout      = 2 * time.Hour
+)
+
+// decommissionJobTTL is how long a decommission job is kept after its last update, longer than the
+// wait for the removal so that the job doesn't expire while it runs.
+var decommissionJobTTL = decommissionRemovalTimeout + helm.JobTTL
+
+var decommissionSteps = []string{
+	decommissionStepCheckTerraform,
+	decommissionStepDrainFlux,
+	decommissionStepPullRequest,
+	decommissionStepWaitForRemoval,
+	decommissionStepPurgeExplorer,
+	decommissionStepPurgeChartsCache,
+}
+
+// ClusterPurger deletes the data of a cluster, e.g. from the Explorer.
+type ClusterPurger interface {
+	PurgeCluster(ctx context.Context, cluster string) error
+}
+
+// DecommissionCluster starts a job decommissioning a GitopsCluster the user can get. The steps acting
+// on behalf of the user run with their client, the wait for the removal and the steps that follow it
+// run with the server client as they outlive the request.
+func (s *server) DecommissionCluster(ctx context.Context, msg *capiv1_proto.DecommissionClusterRequest) (*capiv1_proto.DecommissionClusterResponse, error) {
+	if msg.Name == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "cluster name must be specified")
+	}
+	cluster := createNamespacedName(msg.Name, getClusterNamespace(msg.Namespace))
+
+	gp, err := getGitProvider(ctx, msg.RepositoryUrl)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unauthenticated, "error creating pull request: %s", err.Error())
+	}
+
+	clustersClient, err := s.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	if err := clustersClient.Get(ctx, s.cluster, cluster, &gitopsv1alpha1.GitopsCluster{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, grpcStatus.Errorf(codes.NotFound, "cluster %s not found", cluster)
+		}
+		return nil, fmt.Errorf("error getting cluster %s: %w", cluster, err)
+	}
+
+	serverClient, err := s.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting server client: %w", err)
+	}
+
+	jobID, job := s.decommissionJobs.New()
+	d := &decommission{
+		server:         s,
+		job:            job,
+		msg:            msg,
+		cluster:        cluster,
+		clustersClient: clustersClient,
+		serverClient:   serverClient,
+		gitProvider:    *gp,
+	}
+	go d.run(context.Background())
+
+	return &capiv1_proto.DecommissionClusterResponse{JobId: jobID}, nil
+}
+
+// GetDecommissionClusterJob returns the progress of a cluster decommission job.
+func (s *server) GetDecommissionClusterJob(ctx context.Context, msg *capiv1_proto.GetDecommissionClusterJobRequest) (*capiv1_proto.GetDecommissionClusterJobResponse, error) {
+	// Job IDs are UUIDs, as those of the charts jobs.
+	job, found := s.decommissionJobs.Get(msg.JobId)
+	if !found {
+		return nil, grpcStatus.Errorf(codes.NotFound, "job %s not found", msg.JobId)
+	}
+
+	return job.toProto(), nil
+}
+
+// decommission runs the steps of the decommission of a cluster, stopping at the first failure.
+type decommission struct {
+	server         *server
+	job            *decommissionJob
+	msg            *capiv1_proto.DecommissionClusterRequest
+	cluster        types.NamespacedName
+	clustersClient clustersmngr.Client
+	serverClient   clustersmngr.Client
+	gitProvider    csgit.GitProvider
+	// suspended are the Flux objects suspended by the drain, resumed if the pull request is not created
+	suspended []client.Object
+}
+
+func (d *decommission) run(ctx context.Context) {
+	clusterName := fetcher.ToClusterName(d.cluster)
+	log := d.server.log.WithValues("cluster", clusterName)
+
+	steps := []struct {
+		name string
+		run  func(ctx context.Context) (string, error)
+	}{
+		{decommissionStepCheckTerraform, d.checkTerraform},
+		{decommissionStepDrainFlux, d.drainFlux},
+		{decommissionStepPullRequest, d.createPullRequest},
+		{decommissionStepWaitForRemoval, d.waitForRemoval},
+		{decommissionStepPurgeExplorer, d.purgeExplorer},
+		{decommissionStepPurgeChartsCache, d.purgeChartsCache},
+	}
+	for _, step := range steps {
+		d.job.setStep(step.name, stepStatusRunning, "")
+
+		message, err := step.run(ctx)
+		switch {
+		case errors.Is(err, errStepSkipped):
+			d.job.setStep(step.name, stepStatusSkipped, message)
+		case err != nil:
+			log.Error(err, "cluster decommission failed", "step", step.name)
+			d.job.setStep(step.name, stepStatusFailed, err.Error())
+			err = fmt.Errorf("%s: %w", step.name, err)
+			if d.job.pullRequestURL() == "" {
+				if resumeErr := d.resumeFlux(ctx); resumeErr != nil {
+					log.Error(resumeErr, "failed to resume the drained Flux objects")
+					err = fmt.Errorf("%w, %s: %s", err, decommissionStepDrainFlux, resumeErr)
+				}
+			}
+			d.job.finish(err)
+			return
+		default:
+			d.job.setStep(step.name, stepStatusSucceeded, message)
+		}
+	}
+
+	log.Info("cluster decommissioned", "pullRequest", d.job.pullRequestURL())
+	d.job.finish(nil)
+}
+
+// errStepSkipped is returned by the steps that do not apply to the cluster, with the reason as message
+var errStepSkipped = errors.New("step skipped")
+
+// connected returns true if the cluster is connected to the clusters manager
+func (d *decommission) connected() bool {
+	clusterName := fetcher.ToClusterName(d.cluster)
+	for _, c := range d.server.clustersManager.GetClusters() {
+		if c.GetName() == clusterName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkTerraform fails if Terraform objects of the cluster hold resources that would be orphaned,
+// unless forced.
+func (d *decommission) checkTerraform(ctx context.Context) (string, error) {
+	if !d.connected() {
+		return "the cluster is not connected", errStepSkipped
+	}
+
+	list := &tfctrl.TerraformList{}
+	if err := d.clustersClient.List(ctx, fetcher.ToClusterName(d.cluster), list); err != nil {
+		if meta.IsNoMatchError(err) {
+			return "tf-controller is not installed", errStepSkipped
+		}
+		return "", fmt.Errorf("failed to list Terraform objects: %w", err)
+	}
+
+	holding := terraformHoldingResources(list.Items)
+	if len(holding) == 0 {
+		return fmt.Sprintf("none of the %d Terraform objects hold resources", len(list.Items)), nil
+	}
+	message := fmt.Sprintf("Terraform objects still hold resources: %s", strings.Join(holding, ", "))
+	if !d.msg.Force {
+		return "", fmt.Errorf("%s, destroy them or force the decommission", message)
+	}
+
+	return message + ", forced", nil
+}
+
+// terraformHoldingResources returns the namespaced names of the Terraform objects that have applied
+// resources.
+func terraformHoldingResources(objs []tfctrl.Terraform) []string {
+	holding := []string{}
+	for _, obj := range objs {
+		applied := obj.Status.LastAppliedRevision != ""
+		if obj.Status.Inventory != nil && len(obj.Status.Inventory.Entries) > 0 {
+			applied = true
+		}
+		if applied {
+			holding = append(holding, types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}.String())
+		}
+	}
+	sort.Strings(holding)
+
+	return holding
+}
+
+// drainFlux suspends the Kustomizations and HelmReleases of the cluster, so that Flux stops
+// reconciling the workloads while the cluster is removed.
+func (d *decommission) drainFlux(ctx context.Context) (string, error) {
+	if !d.connected() {
+		return "the cluster is not connected", errStepSkipped
+	}
+	clusterName := fetcher.ToClusterName(d.cluster)
+
+	kustomizations := &kustomizev1.KustomizationList{}
+	if err := d.clustersClient.List(ctx, clusterName, kustomizations); err != nil {
+		return "", fmt.Errorf("failed to list Kustomizations: %w", err)
+	}
+	suspendedKustomizations := 0
+	for i := range kustomizations.Items {
+		ks := &kustomizations.Items[i]
+		if ks.Spec.Suspend {
+			continue
+		}
+		patch := client.MergeFrom(ks.DeepCopy())
+		ks.Spec.Suspend = true
+		if err := d.clustersClient.Patch(ctx, clusterName, ks, patch); err != nil {
+			return "", fmt.Errorf("failed to suspend Kustomization %s/%s: %w", ks.Namespace, ks.Name, err)
+		}
+		d.suspended = append(d.suspended, ks)
+		suspendedKustomizations++
+	}
+
+	helmReleases := &helmv2.HelmReleaseList{}
+	if err := d.clustersClient.List(ctx, clusterName, helmReleases); err != nil {
+		return "", fmt.Errorf("failed to list HelmReleases: %w", err)
+	}
+	suspendedHelmReleases := 0
+	for i := range helmReleases.Items {
+		hr := &helmReleases.Items[i]
+		if hr.Spec.Suspend {
+			continue
+		}
+		patch := client.MergeFrom(hr.DeepCopy())
+		hr.Spec.Suspend = true
+		if err := d.clustersClient.Patch(ctx, clusterName, hr, patch); err != nil {
+			return "", fmt.Errorf("failed to suspend HelmRelease %s/%s: %w", hr.Namespace, hr.Name, err)
+		}
+		d.suspended = append(d.suspended, hr)
+		suspendedHelmReleases++
+	}
+
+	return fmt.Sprintf("suspended %d Kustomizations and %d HelmReleases", suspendedKustomizations, suspendedHelmReleases), nil
+}
+
+// resumeFlux resumes the Kustomizations and HelmReleases suspended by the drain, when the decommission
+// fails before the pull request removing the cluster is created. The objects that fail to resume are
+// reported to be resumed with the flux CLI.
+func (d *decommission) resumeFlux(ctx context.Context) error {
+	if len(d.suspended) == 0 {
+		return nil
+	}
+	clusterName := fetcher.ToClusterName(d.cluster)
+
+	failed := []string{}
+	for _, obj := range d.suspended {
+		var patch client.Patch
+		var kind string
+		switch o := obj.(type) {
+		case *kustomizev1.Kustomization:
+			patch = client.MergeFrom(o.DeepCopy())
+			o.Spec.Suspend = false
+			kind = "kustomization"
+		case *helmv2.HelmRelease:
+			patch = client.MergeFrom(o.DeepCopy())
+			o.Spec.Suspend = false
+			kind = "helmrelease"
+		}
+		if err := d.serverClient.Patch(ctx, clusterName, obj, patch); err != nil {
+			failed = append(failed, fmt.Sprintf("flux resume %s %s -n %s", kind, obj.GetName(), obj.GetNamespace()))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to resume %d of the suspended Flux objects, resume them with: %s", len(failed), strings.Join(failed, "; "))
+	}
+	d.job.setStep(decommissionStepDrainFlux, stepStatusRolledBack, fmt.Sprintf("resumed the %d suspended Flux objects as the decommission failed", len(d.suspended)))
+
+	return nil
+}
+
+// createPullRequest creates a pull request removing the cluster with its profiles and automations.
+func (d *decommission) createPullRequest(ctx context.Context) (string, error) {
+	repositoryURL := viper.GetString("capi-templates-repository-url")
+	if d.msg.RepositoryUrl != "" {
+		repositoryURL = d.msg.RepositoryUrl
+	}
+	baseBranch := viper.GetString("capi-templates-repository-base-branch")
+	if d.msg.BaseBranch != "" {
+		baseBranch = d.msg.BaseBranch
+	}
+
+	files, err := d.server.clusterDeletionFiles(ctx, d.gitProvider, repositoryURL, baseBranch, d.cluster)
+	if err != nil {
+		return "", err
+	}
+
+	headBranch := d.msg.HeadBranch
+	if headBranch == "" {
+		headBranch = getHash(repositoryURL, d.cluster.String(), baseBranch)
+	}
+	title := d.msg.Title
+	if title == "" {
+		title = fmt.Sprintf("Gitops decommission cluster: %s", d.cluster)
+	}
+	description := d.msg.Description
+	if description == "" {
+		description = fmt.Sprintf("Pull request to decommission cluster %s, removing its profiles and automations", d.cluster)
+	}
+	commitMessage := d.msg.CommitMessage
+	if commitMessage == "" {
+		commitMessage = "Remove cluster files"
+	}
+
+	if _, err := d.server.provider.GetRepository(ctx, d.gitProvider, repositoryURL); err != nil {
+		return "", fmt.Errorf("failed to get repo %s: %w", repositoryURL, err)
+	}
+
+	res, err := d.server.provider.WriteFilesToBranchAndCreatePullRequest(ctx, csgit.WriteFilesToBranchAndCreatePullRequestRequest{
+		GitProvider:   d.gitProvider,
+		RepositoryURL: repositoryURL,
+		HeadBranch:    headBranch,
+		BaseBranch:    baseBranch,
+		Title:         title,
+		Description:   description,
+		CommitMessage: commitMessage,
+		Files:         files,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create pull request: %w", err)
+	}
+	d.job.setPullRequestURL(res.WebURL)
+
+	return fmt.Sprintf("created pull request %s removing %d files", res.WebURL, len(files)), nil
+}
+
+// waitForRemoval waits for the pull request to remove the GitopsCluster and for the cluster to be
+// disconnected, so that the purged data is not collected again.
+func (d *decommission) waitForRemoval(ctx context.Context) (string, error) {
+	d.job.setStep(decommissionStepWaitForRemoval, stepStatusRunning, "waiting for the pull request to be merged and the GitopsCluster to be removed")
+
+	err := wait.PollUntilContextTimeout(ctx, decommissionRemovalPollInterval, decommissionRemovalTimeout, true, func(ctx context.Context) (bool, error) {
+		err := d.serverClient.Get(ctx, d.server.cluster, d.cluster, &gitopsv1alpha1.GitopsCluster{})
+		if err == nil {
+			return false, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			d.server.log.Error(err, "failed to get the decommissioned cluster", "cluster", d.cluster)
+			return false, nil
+		}
+
+		return !d.connected(), nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("the GitopsCluster was not removed within %s, its data was not purged", decommissionRemovalTimeout)
+	}
+
+	return "the GitopsCluster was removed", nil
+}
+
+func (d *decommission) purgeExplorer(ctx context.Context) (string, error) {
+	if d.server.explorerPurger == nil {
+		return "the Explorer is not enabled", errStepSkipped
+	}
+
+	if err := d.server.explorerPurger.PurgeCluster(ctx, fetcher.ToClusterName(d.cluster)); err != nil {
+		return "", fmt.Errorf("failed to purge Explorer data: %w", err)
+	}
+
+	return "purged Explorer data", nil
+}
+
+func (d *decommission) purgeChartsCache(ctx context.Context) (string, error) {
+	if d.server.chartsCacheWriter == nil {
+		return "the Helm charts cache is not enabled", errStepSkipped
+	}
+
+	if err := d.server.chartsCacheWriter.DeleteAllChartsForCluster(ctx, d.cluster); err != nil {
+		return "", fmt.Errorf("failed to purge Helm charts cache: %w", err)
+	}
+
+	return "purged Helm charts cache", nil
+}
+
+// decommissionJob is the progress of a cluster decommission.
+type decommissionJob struct {
+	mu     sync.Mutex
+	steps  []*capiv1_proto.DecommissionStep
+	done   bool
+	webURL string
+	err    error
+	// refresh extends the expiry of the job on its updates
+	refresh func()
+}
+
+func newDecommissionJob(refresh func()) *decommissionJob {
+	job := &decommissionJob{refresh: refresh}
+	for _, name := range decommissionSteps {
+		job.steps = append(job.steps, &capiv1_proto.DecommissionStep{Name: name, Status: stepStatusPending})
+	}
+
+	return job
+}
+
+func (j *decommissionJob) setStep(name, status, message string) {
+	defer j.refresh()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for _, step := range j.steps {
+		if step.Name == name {
+			step.Status = status
+			step.Message = message
+		}
+	}
+}
+
+func (j *decommissionJob) setPullRequestURL(url string) {
+	defer j.refresh()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.webURL = url
+}
+
+func (j *decommissionJob) pullRequestURL() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return j.webURL
+}
+
+func (j *decommissionJob) finish(err error) {
+	defer j.refresh()
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.done = true
+	j.err = err
+}
+
+func (j *decommissionJob) toProto() *capiv1_proto.GetDecommissionClusterJobResponse {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	res := &capiv1_proto.GetDecommissionClusterJobResponse{
+		Done:   j.done,
+		WebUrl: j.webURL,
+	}
+	for _, step := range j.steps {
+		res.Steps = append(res.Steps, &capiv1_proto.DecommissionStep{
+			Name:    step.Name,
+			Status:  step.Status,
+			Message: step.Message,
+		})
+	}
+	if j.err != nil {
+		res.Error = j.err.Error()
+	}
+
+	return res
+}
+
+// decommissionJobs keeps the decommission jobs in memory until they expire, as the charts jobs, for
+// the decommission job TTL after their last update.
+type decommissionJobs struct {
+	jobs *ttlcache.Cache
+}
+
+func newDecommissionJobs() *decommissionJobs {
+	return &decommissionJobs{jobs: ttlcache.New(helm.JobCleanupInterval)}
+}
+
+// New creates and saves a new job and returns its id
+func (j *decommissionJobs) New() (string, *decommissionJob) {
+	id := uuid.New().String()
+	key := ttlcache.StringKey(id)
+	var job *decommissionJob
+	job = newDecommissionJob(func() {
+		j.jobs.Set(key, job, decommissionJobTTL)
+	})
+	j.jobs.Set(key, job, decommissionJobTTL)
+
+	return id, job
+}
+
+// Get returns the job and true if it exists
+func (j *decommissionJobs) Get(id string) (*decommissionJob, bool) {
+	job, found := j.jobs.Get(ttlcache.StringKey(id))
+	if !found {
+		return nil, false
+	}
+
+	return job.(*decommissionJob), true
+}
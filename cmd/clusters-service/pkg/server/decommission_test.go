@@ -0,0 +1,364 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git/gitfakes"
+	capiv1_protos "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/helm"
+)
+
+func TestDecommissionCluster(t *testing.T) {
+	setViperWithTestCleanup(t, map[string]string{
+		"capi-repository-path":          "clusters/management/clusters",
+		"capi-repository-clusters-path": "clusters/",
+	})
+
+	pollInterval := decommissionRemovalPollInterval
+	decommissionRemovalPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		decommissionRemovalPollInterval = pollInterval
+	})
+
+	leafRef := types.NamespacedName{Name: "leaf", Namespace: "ns-foo"}
+	leafObjects := []runtime.Object{
+		&kustomizev1.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "flux-system", Namespace: "flux-system"}},
+		&kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: "suspended", Namespace: "flux-system"},
+			Spec:       kustomizev1.KustomizationSpec{Suspend: true},
+		},
+		&helmv2.HelmRelease{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "default"}},
+		&tfctrl.Terraform{ObjectMeta: metav1.ObjectMeta{Name: "planned", Namespace: "flux-system"}},
+	}
+	appliedTerraform := &tfctrl.Terraform{
+		ObjectMeta: metav1.ObjectMeta{Name: "vpc", Namespace: "flux-system"},
+		Status:     tfctrl.TerraformStatus{LastAppliedRevision: "main@sha1:abc"},
+	}
+
+	testCases := []struct {
+		name        string
+		leafObjects []runtime.Object
+		disconnect  bool
+		force       bool
+		prErr       error
+		wantSteps   []*capiv1_protos.DecommissionStep
+		wantError   string
+		wantPR      bool
+		wantDrained bool
+	}{
+		{
+			name:        "decommission connected cluster",
+			leafObjects: leafObjects,
+			wantSteps: []*capiv1_protos.DecommissionStep{
+				{Name: decommissionStepCheckTerraform, Status: stepStatusSucceeded, Message: "none of the 1 Terraform objects hold resources"},
+				{Name: decommissionStepDrainFlux, Status: stepStatusSucceeded, Message: "suspended 1 Kustomizations and 1 HelmReleases"},
+				{Name: decommissionStepPullRequest, Status: stepStatusSucceeded, Message: "created pull request https://github.com/org/repo/pull/1 removing 3 files"},
+				{Name: decommissionStepWaitForRemoval, Status: stepStatusSucceeded, Message: "the GitopsCluster was removed"},
+				{Name: decommissionStepPurgeExplorer, Status: stepStatusSucceeded, Message: "purged Explorer data"},
+				{Name: decommissionStepPurgeChartsCache, Status: stepStatusSucceeded, Message: "purged Helm charts cache"},
+			},
+			wantPR:      true,
+			wantDrained: true,
+		},
+		{
+			name:        "terraform objects hold resources",
+			leafObjects: append([]runtime.Object{appliedTerraform}, leafObjects...),
+			wantSteps: []*capiv1_protos.DecommissionStep{
+				{Name: decommissionStepCheckTerraform, Status: stepStatusFailed, Message: "Terraform objects still hold resources: flux-system/vpc, destroy them or force the decommission"},
+				{Name: decommissionStepDrainFlux, Status: stepStatusPending},
+				{Name: decommissionStepPullRequest, Status: stepStatusPending},
+				{Name: decommissionStepWaitForRemoval, Status: stepStatusPending},
+				{Name: decommissionStepPurgeExplorer, Status: stepStatusPending},
+				{Name: decommissionStepPurgeChartsCache, Status: stepStatusPending},
+			},
+			wantError: "check-terraform: Terraform objects still hold resources: flux-system/vpc, destroy them or force the decommission",
+		},
+		{
+			name:        "forced while terraform objects hold resources",
+			leafObjects: append([]runtime.Object{appliedTerraform}, leafObjects...),
+			force:       true,
+			wantSteps: []*capiv1_protos.DecommissionStep{
+				{Name: decommissionStepCheckTerraform, Status: stepStatusSucceeded, Message: "Terraform objects still hold resources: flux-system/vpc, forced"},
+				{Name: decommissionStepDrainFlux, Status: stepStatusSucceeded, Message: "suspended 1 Kustomizations and 1 HelmReleases"},
+				{Name: decommissionStepPullRequest, Status: stepStatusSucceeded, Message: "created pull request https://github.com/org/repo/pull/1 removing 3 files"},
+				{Name: decommissionStepWaitForRemoval, Status: stepStatusSucceeded, Message: "the GitopsCluster was removed"},
+				{Name: decommissionStepPurgeExplorer, Status: stepStatusSucceeded, Message: "purged Explorer data"},
+				{Name: decommissionStepPurgeChartsCache, Status: stepStatusSucceeded, Message: "purged Helm charts cache"},
+			},
+			wantPR:      true,
+			wantDrained: true,
+		},
+		{
+			name:        "pull request creation fails",
+			leafObjects: leafObjects,
+			prErr:       errors.New("rate limited"),
+			wantSteps: []*capiv1_protos.DecommissionStep{
+				{Name: decommissionStepCheckTerraform, Status: stepStatusSucceeded, Message: "none of the 1 Terraform objects hold resources"},
+				{Name: decommissionStepDrainFlux, Status: stepStatusRolledBack, Message: "resumed the 2 suspended Flux objects as the decommission failed"},
+				{Name: decommissionStepPullRequest, Status: stepStatusFailed, Message: "error getting list of trees in repo: https://github.com/org/repo.git@main: rate limited"},
+				{Name: decommissionStepWaitForRemoval, Status: stepStatusPending},
+				{Name: decommissionStepPurgeExplorer, Status: stepStatusPending},
+				{Name: decommissionStepPurgeChartsCache, Status: stepStatusPending},
+			},
+			wantError: "create-pull-request: error getting list of trees in repo: https://github.com/org/repo.git@main: rate limited",
+		},
+		{
+			name:       "decommission disconnected cluster",
+			disconnect: true,
+			wantSteps: []*capiv1_protos.DecommissionStep{
+				{Name: decommissionStepCheckTerraform, Status: stepStatusSkipped, Message: "the cluster is not connected"},
+				{Name: decommissionStepDrainFlux, Status: stepStatusSkipped, Message: "the cluster is not connected"},
+				{Name: decommissionStepPullRequest, Status: stepStatusSucceeded, Message: "created pull request https://github.com/org/repo/pull/1 removing 3 files"},
+				{Name: decommissionStepWaitForRemoval, Status: stepStatusSucceeded, Message: "the GitopsCluster was removed"},
+				{Name: decommissionStepPurgeExplorer, Status: stepStatusSucceeded, Message: "purged Explorer data"},
+				{Name: decommissionStepPurgeChartsCache, Status: stepStatusSucceeded, Message: "purged Helm charts cache"},
+			},
+			wantPR: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			managementClient := createClient(t, makeTestGitopsCluster(func(c *gitopsv1alpha1.GitopsCluster) {
+				c.Name = leafRef.Name
+				c.Namespace = leafRef.Namespace
+			}))
+			leafClient := createClient(t, tt.leafObjects...)
+			clients := map[string]client.Client{"management": managementClient}
+			if !tt.disconnect {
+				clients["ns-foo/leaf"] = leafClient
+			}
+
+			provider := gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, tt.prErr, []string{
+				"clusters/management/clusters/ns-foo/leaf.yaml",
+				"clusters/ns-foo/leaf/kustomization.yaml",
+				"clusters/ns-foo/leaf/profiles.yaml",
+				"clusters/ns-foo/other/kustomization.yaml",
+			}, nil)
+			repoRef := helm.ObjectReference{Kind: "HelmRepository", Name: "bitnami-charts", Namespace: "demo"}
+			chartsCache := testNewFakeChartCache(t, leafRef, repoRef, []helm.Chart{{Name: "redis", Version: "1.0.1"}})
+			purger := &fakeClusterPurger{}
+
+			clustersManager := makeTestClustersManagerWithClients(clients)
+			s := createServer(t, serverOptions{
+				clustersManager:   clustersManager,
+				provider:          provider,
+				chartsCacheWriter: chartsCache,
+				explorerPurger:    purger,
+			})
+
+			res, err := s.DecommissionCluster(context.Background(), &capiv1_protos.DecommissionClusterRequest{
+				Name:          "leaf",
+				Namespace:     "ns-foo",
+				RepositoryUrl: "https://github.com/org/repo.git",
+				BaseBranch:    "main",
+				Force:         tt.force,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantPR {
+				// The data is purged once the pull request removes the GitopsCluster and it is disconnected
+				waitForDecommissionStep(t, s, res.JobId, decommissionStepWaitForRemoval, stepStatusRunning)
+				if purger.purged != nil {
+					t.Fatal("the cluster data was purged before the GitopsCluster was removed")
+				}
+				if err := managementClient.Delete(context.Background(), &gitopsv1alpha1.GitopsCluster{ObjectMeta: metav1.ObjectMeta{Name: leafRef.Name, Namespace: leafRef.Namespace}}); err != nil {
+					t.Fatal(err)
+				}
+				remaining := makeTestClustersManagerWithClients(map[string]client.Client{"management": managementClient})
+				clustersManager.GetClustersReturns(remaining.GetClusters())
+			}
+
+			job := waitForDecommissionJob(t, s, res.JobId)
+			if clustersManager.GetServerClientCallCount() != 1 {
+				t.Fatal("the decommission should outlive the request with the server client")
+			}
+
+			if diff := cmp.Diff(tt.wantSteps, job.Steps, protocmp.Transform()); diff != "" {
+				t.Fatalf("steps didn't match expected:\n%s", diff)
+			}
+			if job.Error != tt.wantError {
+				t.Fatalf("got error %q, want %q", job.Error, tt.wantError)
+			}
+
+			fakeGitProvider := provider.(*gitfakes.FakeGitProvider)
+			if !tt.wantPR {
+				if job.WebUrl != "" || len(fakeGitProvider.CommittedFiles) > 0 {
+					t.Fatalf("unexpected pull request %q", job.WebUrl)
+				}
+				if purger.purged != nil || len(chartsCache.Charts) == 0 {
+					t.Fatal("the cluster data was purged")
+				}
+			} else {
+				if job.WebUrl != "https://github.com/org/repo/pull/1" {
+					t.Fatalf("got pull request %q", job.WebUrl)
+				}
+				committed := []string{}
+				for _, f := range fakeGitProvider.CommittedFiles {
+					if f.Content != nil {
+						t.Fatalf("file %s is not deleted", f.Path)
+					}
+					committed = append(committed, f.Path)
+				}
+				sort.Strings(committed)
+				want := []string{
+					"clusters/management/clusters/ns-foo/leaf.yaml",
+					"clusters/ns-foo/leaf/kustomization.yaml",
+					"clusters/ns-foo/leaf/profiles.yaml",
+				}
+				if diff := cmp.Diff(want, committed); diff != "" {
+					t.Fatalf("committed files didn't match expected:\n%s", diff)
+				}
+				if diff := cmp.Diff([]string{"ns-foo/leaf"}, purger.purged); diff != "" {
+					t.Fatalf("purged clusters didn't match expected:\n%s", diff)
+				}
+				if len(chartsCache.Charts) != 0 {
+					t.Fatalf("charts cache was not purged: %v", chartsCache.Charts)
+				}
+			}
+
+			if tt.disconnect {
+				return
+			}
+			ks := &kustomizev1.Kustomization{}
+			if err := leafClient.Get(context.Background(), types.NamespacedName{Name: "flux-system", Namespace: "flux-system"}, ks); err != nil {
+				t.Fatal(err)
+			}
+			hr := &helmv2.HelmRelease{}
+			if err := leafClient.Get(context.Background(), types.NamespacedName{Name: "podinfo", Namespace: "default"}, hr); err != nil {
+				t.Fatal(err)
+			}
+			if ks.Spec.Suspend != tt.wantDrained || hr.Spec.Suspend != tt.wantDrained {
+				t.Fatalf("got suspended Kustomization %v and HelmRelease %v, want %v", ks.Spec.Suspend, hr.Spec.Suspend, tt.wantDrained)
+			}
+		})
+	}
+}
+
+func TestDecommissionCluster_NotFound(t *testing.T) {
+	s := createServer(t, serverOptions{
+		clustersManager: makeTestClustersManagerWithClients(map[string]client.Client{"management": createClient(t)}),
+		provider:        gitfakes.NewFakeGitProvider("https://github.com/org/repo/pull/1", nil, nil, nil, nil),
+	})
+
+	_, err := s.DecommissionCluster(context.Background(), &capiv1_protos.DecommissionClusterRequest{
+		Name:      "leaf",
+		Namespace: "ns-foo",
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got error %v, want NotFound", err)
+	}
+}
+
+func TestGetDecommissionClusterJob_NotFound(t *testing.T) {
+	s := createServer(t, serverOptions{})
+
+	_, err := s.GetDecommissionClusterJob(context.Background(), &capiv1_protos.GetDecommissionClusterJobRequest{JobId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got error %v, want NotFound", err)
+	}
+}
+
+func waitForDecommissionJob(t *testing.T, s capiv1_protos.ClustersServiceServer, jobID string) *capiv1_protos.GetDecommissionClusterJobResponse {
+	t.Helper()
+
+	var job *capiv1_protos.GetDecommissionClusterJobResponse
+	err := wait.PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		var err error
+		job, err = s.GetDecommissionClusterJob(ctx, &capiv1_protos.GetDecommissionClusterJobRequest{JobId: jobID})
+		if err != nil {
+			return false, err
+		}
+		return job.Done, nil
+	})
+	if err != nil {
+		t.Fatalf("error on JobPoll: %s: %v", err, job)
+	}
+
+	return job
+}
+
+// makeTestClustersManagerWithClients returns a clusters manager connected to the clusters of the
+// clients.
+func waitForDecommissionStep(t *testing.T, s capiv1_protos.ClustersServiceServer, jobID, name, status string) {
+	t.Helper()
+
+	err := wait.PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		job, err := s.GetDecommissionClusterJob(ctx, &capiv1_protos.GetDecommissionClusterJobRequest{JobId: jobID})
+		if err != nil {
+			return false, err
+		}
+		for _, step := range job.Steps {
+			if step.Name == name && step.Status == status {
+				return true, nil
+			}
+		}
+		return job.Done, nil
+	})
+	if err != nil {
+		t.Fatalf("error waiting for step %s to be %s: %s", name, status, err)
+	}
+}
+
+func makeTestClustersManagerWithClients(clients map[string]client.Client) *clustersmngrfakes.FakeClustersManager {
+	clientsPool := &clustersmngrfakes.FakeClientsPool{}
+	clientsPool.ClientsReturns(clients)
+	clientsPool.ClientStub = func(name string) (client.Client, error) {
+		if c, found := clients[name]; found && c != nil {
+			return c, nil
+		}
+		return nil, fmt.Errorf("cluster %s not found", name)
+	}
+	clustersClient := clustersmngr.NewClient(clientsPool, map[string][]corev1.Namespace{}, logr.Discard())
+
+	clusters := []cluster.Cluster{}
+	for name := range clients {
+		fakeCluster := &clusterfakes.FakeCluster{}
+		fakeCluster.GetNameReturns(name)
+		clusters = append(clusters, fakeCluster)
+	}
+
+	fakeFactory := &clustersmngrfakes.FakeClustersManager{}
+	fakeFactory.GetImpersonatedClientReturns(clustersClient, nil)
+	fakeFactory.GetImpersonatedClientForClusterReturns(clustersClient, nil)
+	fakeFactory.GetServerClientReturns(clustersClient, nil)
+	fakeFactory.GetClustersReturns(clusters)
+
+	return fakeFactory
+}
+
+type fakeClusterPurger struct {
+	purged []string
+}
+
+func (p *fakeClusterPurger) PurgeCluster(ctx context.Context, cluster string) error {
+	p.purged = append(p.purged, cluster)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	"github.com/hashicorp/go-multierror"
 	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"golang.org/x/sync/errgroup"
@@ -26,6 +27,11 @@ const (
 )
 
 func (s *server) ListExternalSecrets(ctx context.Context, m *capiv1_proto.ListExternalSecretsRequest) (*capiv1_proto.ListExternalSecretsResponse, error) {
+	clusterSelector, err := s.selectClusters(ctx, m.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	respErrors := []*capiv1_proto.ListError{}
 	clustersClient, err := s.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
 	if err != nil {
@@ -40,7 +46,7 @@ func (s *server) ListExternalSecrets(ctx context.Context, m *capiv1_proto.ListEx
 		}
 	}
 
-	externalSecrets, externalSecretsListErrors, err := s.listExternalSecrets(ctx, clustersClient)
+	externalSecrets, externalSecretsListErrors, err := s.listExternalSecrets(ctx, clustersClient, clusterSelector)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +61,7 @@ func (s *server) ListExternalSecrets(ctx context.Context, m *capiv1_proto.ListEx
 	return &response, nil
 }
 
-func (s *server) listExternalSecrets(ctx context.Context, cl clustersmngr.Client) ([]*capiv1_proto.ExternalSecretItem, []*capiv1_proto.ListError, error) {
+func (s *server) listExternalSecrets(ctx context.Context, cl clustersmngr.Client, clusterSelector *fetcher.ClusterSelector) ([]*capiv1_proto.ExternalSecretItem, []*capiv1_proto.ListError, error) {
 	clusterListErrors := []*capiv1_proto.ListError{}
 
 	list := clustersmngr.NewClusteredList(func() client.ObjectList {
@@ -65,7 +71,7 @@ func (s *server) listExternalSecrets(ctx context.Context, cl clustersmngr.Client
 	if err := cl.ClusteredList(ctx, list, true); err != nil {
 		if e, ok := err.(clustersmngr.ClusteredListError); ok {
 			for i := range e.Errors {
-				if !strings.Contains(e.Errors[i].Error(), "no matches for kind ") {
+				if !strings.Contains(e.Errors[i].Error(), "no matches for kind ") && clusterSelector.Matches(e.Errors[i].Cluster) {
 					clusterListErrors = append(clusterListErrors, &capiv1_proto.ListError{ClusterName: e.Errors[i].Cluster, Message: e.Errors[i].Error()})
 				}
 
@@ -82,6 +88,9 @@ func (s *server) listExternalSecrets(ctx context.Context, cl clustersmngr.Client
 
 	secrets := []*capiv1_proto.ExternalSecretItem{}
 	for clusterName, objs := range secretList {
+		if !clusterSelector.Matches(clusterName) {
+			continue
+		}
 		for i := range objs {
 			obj, ok := objs[i].(*esv1beta1.ExternalSecretList)
 			if !ok {
@@ -286,6 +295,9 @@ func getSecretStoreType(provider *esv1beta1.SecretStoreProvider) string {
 
 // SyncExternalSecret triggers a sync of an externalSecret from externalSecret operators
 func (s *server) SyncExternalSecrets(ctx context.Context, req *capiv1_proto.SyncExternalSecretsRequest) (*capiv1_proto.SyncExternalSecretsResponse, error) {
+	if req.ClusterSelector != "" {
+		return s.syncSelectedExternalSecrets(ctx, req)
+	}
 
 	clustersClient, err := s.clustersManager.GetImpersonatedClientForCluster(ctx, auth.Principal(ctx), req.ClusterName)
 	if err != nil {
@@ -300,17 +312,81 @@ func (s *server) SyncExternalSecrets(ctx context.Context, req *capiv1_proto.Sync
 	if err := clustersClient.Get(ctx, req.ClusterName, client.ObjectKey{Name: req.ExternalSecretName, Namespace: req.Namespace}, &externalSecret); err != nil {
 		return nil, fmt.Errorf("error getting external secret %s from cluster %s: %w", req.ExternalSecretName, req.ClusterName, err)
 	} else {
+		if err := forceSyncExternalSecret(ctx, clustersClient, req.ClusterName, &externalSecret); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
 
-		if _, ok := externalSecret.Annotations["force-sync"]; ok {
-			externalSecret.Annotations["force-sync"] = time.Now().Format(time.RFC3339)
+// syncSelectedExternalSecrets syncs the external secrets of the clusters matching the cluster selector,
+// limited to the namespace and external secret name of the request if set
+func (s *server) syncSelectedExternalSecrets(ctx context.Context, req *capiv1_proto.SyncExternalSecretsRequest) (*capiv1_proto.SyncExternalSecretsResponse, error) {
+	clusterSelector, err := s.selectClusters(ctx, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	clustersClient, err := s.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		if _, ok := err.(*multierror.Error); !ok {
+			return nil, fmt.Errorf("error getting impersonating client: %w", err)
+		}
+	}
+
+	list := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &esv1beta1.ExternalSecretList{}
+	})
+
+	respErrors := &multierror.Error{}
+	if err := clustersClient.ClusteredList(ctx, list, true); err != nil {
+		if e, ok := err.(clustersmngr.ClusteredListError); ok {
+			for i := range e.Errors {
+				if !strings.Contains(e.Errors[i].Error(), "no matches for kind ") && clusterSelector.Matches(e.Errors[i].Cluster) {
+					respErrors = multierror.Append(respErrors, fmt.Errorf("failed to list external secrets of cluster %s: %w", e.Errors[i].Cluster, e.Errors[i].Err))
+				}
+			}
 		} else {
-			externalSecret.Annotations = map[string]string{"force-sync": time.Now().Format(time.RFC3339)}
+			return nil, fmt.Errorf("failed to list external secrets, error: %w", err)
 		}
+	}
 
-		if err := clustersClient.Update(ctx, req.ClusterName, &externalSecret); err != nil {
-			return nil, fmt.Errorf("error updating external secret %s from cluster %s: %w", req.ExternalSecretName, req.ClusterName, err)
+	for clusterName, objs := range list.Lists() {
+		if !clusterSelector.Matches(clusterName) {
+			continue
+		}
+		for i := range objs {
+			obj, ok := objs[i].(*esv1beta1.ExternalSecretList)
+			if !ok {
+				continue
+			}
+			for j := range obj.Items {
+				externalSecret := &obj.Items[j]
+				if (req.Namespace != "" && externalSecret.Namespace != req.Namespace) ||
+					(req.ExternalSecretName != "" && externalSecret.Name != req.ExternalSecretName) {
+					continue
+				}
+				if err := forceSyncExternalSecret(ctx, clustersClient, clusterName, externalSecret); err != nil {
+					respErrors = multierror.Append(respErrors, err)
+				}
+			}
 		}
 	}
 
-	return nil, nil
+	return &capiv1_proto.SyncExternalSecretsResponse{}, respErrors.ErrorOrNil()
+}
+
+// forceSyncExternalSecret annotates an external secret to make the controller sync it
+func forceSyncExternalSecret(ctx context.Context, cl clustersmngr.Client, clusterName string, externalSecret *esv1beta1.ExternalSecret) error {
+	if externalSecret.Annotations == nil {
+		externalSecret.Annotations = map[string]string{}
+	}
+	externalSecret.Annotations["force-sync"] = time.Now().Format(time.RFC3339)
+
+	if err := cl.Update(ctx, clusterName, externalSecret); err != nil {
+		return fmt.Errorf("error updating external secret %s from cluster %s: %w", externalSecret.Name, clusterName, err)
+	}
+
+	return nil
 }
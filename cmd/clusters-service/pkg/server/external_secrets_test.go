@@ -2,11 +2,17 @@ package server
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
 	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
@@ -677,3 +683,78 @@ func TestSyncExternalSecret(t *testing.T) {
 	}
 
 }
+
+func TestExternalSecrets_ClusterSelector(t *testing.T) {
+	newExternalSecret := func(name string) *esv1beta1.ExternalSecret {
+		return &esv1beta1.ExternalSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "namespace-a",
+			},
+			Spec: esv1beta1.ExternalSecretSpec{
+				SecretStoreRef: esv1beta1.SecretStoreRef{
+					Name: "aws-secret-store",
+				},
+			},
+		}
+	}
+	clustersClients := map[string]client.Client{
+		"management":   createClient(t, newExternalSecret("external-secret-management")),
+		"default/prod": createClient(t, newExternalSecret("external-secret-prod")),
+		"default/dev":  createClient(t, newExternalSecret("external-secret-dev")),
+	}
+	namespaces := map[string][]v1.Namespace{}
+	for name := range clustersClients {
+		namespaces[name] = []v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "namespace-a"}}}
+	}
+
+	clientsPool := &clustersmngrfakes.FakeClientsPool{}
+	clientsPool.ClientsReturns(clustersClients)
+	clientsPool.ClientStub = func(name string) (client.Client, error) {
+		return clustersClients[name], nil
+	}
+	fakeFactory := &clustersmngrfakes.FakeClustersManager{}
+	fakeFactory.GetImpersonatedClientReturns(clustersmngr.NewClient(clientsPool, namespaces, logr.Discard()), nil)
+
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.Name = "prod"
+				o.Namespace = "default"
+				o.Labels = map[string]string{"env": "prod"}
+			}),
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.Name = "dev"
+				o.Namespace = "default"
+				o.Labels = map[string]string{"env": "dev"}
+			}),
+		},
+		clustersManager: fakeFactory,
+		cluster:         "management",
+	})
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "userID"})
+
+	t.Run("list", func(t *testing.T) {
+		res, err := s.ListExternalSecrets(ctx, &capiv1_proto.ListExternalSecretsRequest{ClusterSelector: "env=prod"})
+		assert.NoError(t, err)
+		assert.Len(t, res.Secrets, 1)
+		assert.Equal(t, "default/prod", res.Secrets[0].ClusterName)
+		assert.Equal(t, "external-secret-prod", res.Secrets[0].ExternalSecretName)
+
+		_, err = s.ListExternalSecrets(ctx, &capiv1_proto.ListExternalSecretsRequest{ClusterSelector: "env in"})
+		assert.ErrorContains(t, err, "invalid cluster selector")
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		_, err := s.SyncExternalSecrets(ctx, &capiv1_proto.SyncExternalSecretsRequest{ClusterSelector: "env!=dev"})
+		assert.NoError(t, err)
+
+		for cluster, synced := range map[string]bool{"management": true, "default/prod": true, "default/dev": false} {
+			externalSecret := &esv1beta1.ExternalSecret{}
+			name := "external-secret-" + strings.TrimPrefix(cluster, "default/")
+			assert.NoError(t, clustersClients[cluster].Get(ctx, types.NamespacedName{Name: name, Namespace: "namespace-a"}, externalSecret))
+			_, ok := externalSecret.Annotations["force-sync"]
+			assert.Equal(t, synced, ok, "external secret of cluster %s", cluster)
+		}
+	})
+}
@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	capiv1 "github.com/weaveworks/templates-controller/apis/capi/v1alpha2"
+	gapiv1 "github.com/weaveworks/templates-controller/apis/gitops/v1alpha2"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/templates"
+	querypb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+)
+
+const (
+	// OwnersAnnotation lists the owners of a GitopsCluster, comma separated, e.g. "team-a,jane@example.com".
+	OwnersAnnotation = "weave.works/owners"
+
+	templateNameLabel      = "templates.weave.works/template-name"
+	templateNamespaceLabel = "templates.weave.works/template-namespace"
+)
+
+// ExplorerQuerier queries the objects collected by the Explorer as the principal of the context.
+type ExplorerQuerier interface {
+	DoQuery(ctx context.Context, msg *querypb.DoQueryRequest) (*querypb.DoQueryResponse, error)
+}
+
+func (s *server) ExportClusterInventory(ctx context.Context, msg *capiv1_proto.ExportClusterInventoryRequest) (*capiv1_proto.ExportClusterInventoryResponse, error) {
+	clusters, err := s.ListGitopsClusters(ctx, &capiv1_proto.ListGitopsClustersRequest{ClusterSelector: msg.ClusterSelector})
+	if err != nil {
+		return nil, err
+	}
+	listErrors := clusters.Errors
+
+	cl, err := s.clientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := map[string]string{}
+	if s.clusterProber != nil {
+		// Only the clusters that the user can list are probed
+		visible := map[string]bool{}
+		for _, c := range clusters.GitopsClusters {
+			visible[fetcher.ToClusterName(types.NamespacedName{Name: c.Name, Namespace: c.Namespace})] = true
+		}
+		probes, err := s.clusterProber.ProbeAll(ctx, false, func(cluster types.NamespacedName) bool {
+			return visible[fetcher.ToClusterName(cluster)]
+		})
+		if err != nil {
+			listErrors = append(listErrors, &capiv1_proto.ListError{Message: fmt.Sprintf("failed to probe clusters: %s", err)})
+		}
+		for _, probe := range probes {
+			versions[fetcher.ToClusterName(probe.Cluster)] = probe.KubernetesVersion
+		}
+	}
+
+	profiles, err := s.installedProfiles(ctx)
+	if err != nil {
+		listErrors = append(listErrors, &capiv1_proto.ListError{Message: fmt.Sprintf("failed to get the installed profiles: %s", err)})
+	}
+
+	templateRefs := map[types.NamespacedName]*capiv1_proto.ClusterTemplateRef{}
+	inventory := []*capiv1_proto.ClusterInventory{}
+	for _, c := range clusters.GitopsClusters {
+		clusterName := fetcher.ToClusterName(types.NamespacedName{Name: c.Name, Namespace: c.Namespace})
+
+		item := &capiv1_proto.ClusterInventory{
+			ClusterName:       clusterName,
+			Name:              c.Name,
+			Namespace:         c.Namespace,
+			Labels:            c.Labels,
+			KubernetesVersion: versions[clusterName],
+			NodePools:         []*capiv1_proto.ClusterNodePool{},
+			Profiles:          profiles[clusterName],
+			Owners:            clusterOwners(c.Annotations[OwnersAnnotation]),
+			ControlPlane:      c.ControlPlane,
+		}
+		if item.Profiles == nil {
+			item.Profiles = []*capiv1_proto.InstalledProfile{}
+		}
+
+		if ref := clusterTemplateRef(c); ref != nil {
+			key := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+			if _, ok := templateRefs[key]; !ok {
+				templateRefs[key] = s.describeTemplate(ctx, ref)
+			}
+			item.Template = templateRefs[key]
+		}
+
+		if c.CapiCluster != nil {
+			if c.CapiCluster.InfrastructureRef != nil {
+				item.Provider = providers[c.CapiCluster.InfrastructureRef.Kind]
+			}
+
+			nodePools, err := clusterNodePools(ctx, cl, c.CapiCluster)
+			if err != nil {
+				listErrors = append(listErrors, &capiv1_proto.ListError{
+					ClusterName: clusterName,
+					Namespace:   c.Namespace,
+					Message:     err.Error(),
+				})
+			}
+			item.NodePools = nodePools
+		}
+
+		if item.Provider == "" && item.Template != nil {
+			item.Provider = item.Template.Provider
+		}
+
+		inventory = append(inventory, item)
+	}
+
+	return &capiv1_proto.ExportClusterInventoryResponse{
+		Clusters:   inventory,
+		Errors:     listErrors,
+		ExportedAt: time.Now().UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// installedProfiles returns the HelmReleases collected by the Explorer that the user can read, by cluster name.
+func (s *server) installedProfiles(ctx context.Context) (map[string][]*capiv1_proto.InstalledProfile, error) {
+	if s.explorerQuerier == nil {
+		return nil, fmt.Errorf("the explorer is not enabled")
+	}
+
+	res, err := s.explorerQuerier.DoQuery(ctx, &querypb.DoQueryRequest{
+		Filters: []string{"kind:" + helmv2.HelmReleaseKind},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := map[string][]*capiv1_proto.InstalledProfile{}
+	for _, o := range res.Objects {
+		profile := &capiv1_proto.InstalledProfile{
+			Name:      o.Name,
+			Namespace: o.Namespace,
+			Status:    o.Status,
+		}
+
+		var hr helmv2.HelmRelease
+		if err := json.Unmarshal([]byte(o.Unstructured), &hr); err == nil {
+			profile.Chart = hr.Spec.Chart.Spec.Chart
+			profile.Version = hr.Status.LastAppliedRevision
+			if profile.Version == "" {
+				profile.Version = hr.Spec.Chart.Spec.Version
+			}
+		}
+
+		profiles[o.Cluster] = append(profiles[o.Cluster], profile)
+	}
+
+	for _, p := range profiles {
+		sort.Slice(p, func(i, j int) bool {
+			if p[i].Namespace != p[j].Namespace {
+				return p[i].Namespace < p[j].Namespace
+			}
+			return p[i].Name < p[j].Name
+		})
+	}
+
+	return profiles, nil
+}
+
+// clusterNodePools returns the MachineDeployments of a CAPI cluster.
+func clusterNodePools(ctx context.Context, cl client.Client, capiCluster *capiv1_proto.CapiCluster) ([]*capiv1_proto.ClusterNodePool, error) {
+	var deployments clusterv1.MachineDeploymentList
+	if err := cl.List(ctx, &deployments,
+		client.InNamespace(capiCluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: capiCluster.Name},
+	); err != nil {
+		return []*capiv1_proto.ClusterNodePool{}, fmt.Errorf("failed to list node pools: %w", err)
+	}
+
+	nodePools := []*capiv1_proto.ClusterNodePool{}
+	for _, md := range deployments.Items {
+		nodePool := &capiv1_proto.ClusterNodePool{
+			Name:               md.Name,
+			ReadyReplicas:      md.Status.ReadyReplicas,
+			InfrastructureKind: md.Spec.Template.Spec.InfrastructureRef.Kind,
+		}
+		if md.Spec.Replicas != nil {
+			nodePool.Replicas = *md.Spec.Replicas
+		}
+		if md.Spec.Template.Spec.Version != nil {
+			nodePool.KubernetesVersion = *md.Spec.Template.Spec.Version
+		}
+		nodePools = append(nodePools, nodePool)
+	}
+
+	sort.Slice(nodePools, func(i, j int) bool { return nodePools[i].Name < nodePools[j].Name })
+
+	return nodePools, nil
+}
+
+// clusterTemplateRef returns the template a cluster was created from, from the labels of its rendered resources.
+func clusterTemplateRef(c *capiv1_proto.GitopsCluster) *capiv1_proto.ClusterTemplateRef {
+	name := c.Labels[templateNameLabel]
+	if name == "" {
+		return nil
+	}
+
+	return &capiv1_proto.ClusterTemplateRef{
+		Name:      name,
+		Namespace: c.Labels[templateNamespaceLabel],
+	}
+}
+
+// describeTemplate adds the kind, provider and description of the template if it still exists.
+func (s *server) describeTemplate(ctx context.Context, ref *capiv1_proto.ClusterTemplateRef) *capiv1_proto.ClusterTemplateRef {
+	if ref.Namespace == "" {
+		return ref
+	}
+
+	for _, kind := range []string{gapiv1.Kind, capiv1.Kind} {
+		t, err := s.getTemplate(ctx, ref.Name, ref.Namespace, kind)
+		if err != nil {
+			continue
+		}
+
+		annotation := templates.GitOpsTemplateNameAnnotation
+		if kind == capiv1.Kind {
+			annotation = templates.CAPIDisplayNameAnnotation
+		}
+
+		ref.TemplateKind = kind
+		ref.Provider = getProvider(t, annotation)
+		ref.Description = t.GetSpec().Description
+
+		return ref
+	}
+
+	return ref
+}
+
+func clusterOwners(annotation string) []string {
+	owners := []string{}
+	for _, owner := range strings.Split(annotation, ",") {
+		if owner = strings.TrimSpace(owner); owner != "" {
+			owners = append(owners, owner)
+		}
+	}
+
+	return owners
+}
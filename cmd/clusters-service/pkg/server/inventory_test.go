@@ -0,0 +1,243 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/google/go-cmp/cmp"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	capiv1 "github.com/weaveworks/templates-controller/apis/capi/v1alpha2"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/protobuf/testing/protocmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	capiv1_protos "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	querypb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+)
+
+type fakeExplorerQuerier struct {
+	objects []*querypb.Object
+	query   *querypb.DoQueryRequest
+}
+
+func (f *fakeExplorerQuerier) DoQuery(ctx context.Context, msg *querypb.DoQueryRequest) (*querypb.DoQueryResponse, error) {
+	f.query = msg
+	return &querypb.DoQueryResponse{Objects: f.objects}, nil
+}
+
+func TestExportClusterInventory(t *testing.T) {
+	querier := &fakeExplorerQuerier{
+		objects: []*querypb.Object{
+			{
+				Cluster:      "default/dev",
+				Kind:         "HelmRelease",
+				Name:         "podinfo",
+				Namespace:    "flux-system",
+				Status:       "Success",
+				Unstructured: `{"spec":{"chart":{"spec":{"chart":"podinfo","version":"6.x"}}},"status":{"lastAppliedRevision":"6.5.0"}}`,
+			},
+			{
+				Cluster:      "default/dev",
+				Kind:         "HelmRelease",
+				Name:         "cert-manager",
+				Namespace:    "cert-manager",
+				Status:       "Failed",
+				Unstructured: `{"spec":{"chart":{"spec":{"chart":"cert-manager","version":"1.13.1"}}}}`,
+			},
+			{
+				Cluster:      "management",
+				Kind:         "HelmRelease",
+				Name:         "weave-gitops-enterprise",
+				Namespace:    "flux-system",
+				Status:       "Success",
+				Unstructured: `{"spec":{"chart":{"spec":{"chart":"mccp"}}},"status":{"lastAppliedRevision":"0.37.0"}}`,
+			},
+		},
+	}
+	prober := &fakeClusterProber{
+		probes: []fetcher.ClusterProbe{
+			{Cluster: types.NamespacedName{Name: "dev", Namespace: "default"}, KubernetesVersion: "v1.28.2"},
+			{Cluster: types.NamespacedName{Name: "management"}, KubernetesVersion: "v1.27.3"},
+		},
+	}
+
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "dev"
+				o.ObjectMeta.Namespace = "default"
+				o.ObjectMeta.Labels = map[string]string{
+					"env":                  "dev",
+					templateNameLabel:      "cluster-template-1",
+					templateNamespaceLabel: "default",
+				}
+				o.ObjectMeta.Annotations = map[string]string{
+					OwnersAnnotation: "team-a, jane@example.com,",
+				}
+				o.Spec.CAPIClusterRef = &meta.LocalObjectReference{Name: "dev"}
+			}),
+			makeTestCluster(func(o *clusterv1.Cluster) {
+				o.ObjectMeta.Name = "dev"
+				o.ObjectMeta.Namespace = "default"
+				o.Spec.InfrastructureRef = &corev1.ObjectReference{Kind: "AWSManagedControlPlane", Name: "dev"}
+			}),
+			makeTestMachineDeployment("dev-md-1", "dev", 3, 2),
+			makeTestMachineDeployment("dev-md-0", "dev", 1, 1),
+			makeTestMachineDeployment("other-md-0", "other", 1, 1),
+			makeTemplateWithProvider(t, "AWSManagedControlPlane", func(c *capiv1.CAPITemplate) {
+				c.Spec.Description = "An EKS cluster"
+			}),
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "imported"
+				o.ObjectMeta.Namespace = "default"
+				o.ObjectMeta.Labels = map[string]string{"env": "prod"}
+				o.Spec.SecretRef = &meta.LocalObjectReference{Name: "imported-kubeconfig"}
+			}),
+		},
+		capiEnabled:     true,
+		clusterProber:   prober,
+		explorerQuerier: querier,
+	})
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "userID"})
+	res, err := s.ExportClusterInventory(ctx, &capiv1_protos.ExportClusterInventoryRequest{})
+	if err != nil {
+		t.Fatalf("failed to export the cluster inventory: %s", err)
+	}
+	if res.ExportedAt == "" {
+		t.Error("expected the export time to be set")
+	}
+	if diff := cmp.Diff([]string{"kind:HelmRelease"}, querier.query.Filters); diff != "" {
+		t.Errorf("explorer query didn't match expected:\n%s", diff)
+	}
+
+	expected := []*capiv1_protos.ClusterInventory{
+		{
+			ClusterName: "default/dev",
+			Name:        "dev",
+			Namespace:   "default",
+			Labels: map[string]string{
+				"env":                  "dev",
+				templateNameLabel:      "cluster-template-1",
+				templateNamespaceLabel: "default",
+			},
+			Provider:          "aws",
+			KubernetesVersion: "v1.28.2",
+			NodePools: []*capiv1_protos.ClusterNodePool{
+				{Name: "dev-md-0", Replicas: 1, ReadyReplicas: 1, KubernetesVersion: "v1.28.2", InfrastructureKind: "AWSMachineTemplate"},
+				{Name: "dev-md-1", Replicas: 3, ReadyReplicas: 2, KubernetesVersion: "v1.28.2", InfrastructureKind: "AWSMachineTemplate"},
+			},
+			Profiles: []*capiv1_protos.InstalledProfile{
+				{Name: "cert-manager", Namespace: "cert-manager", Chart: "cert-manager", Version: "1.13.1", Status: "Failed"},
+				{Name: "podinfo", Namespace: "flux-system", Chart: "podinfo", Version: "6.5.0", Status: "Success"},
+			},
+			Owners: []string{"team-a", "jane@example.com"},
+			Template: &capiv1_protos.ClusterTemplateRef{
+				Name:         "cluster-template-1",
+				Namespace:    "default",
+				TemplateKind: capiv1.Kind,
+				Provider:     "aws",
+				Description:  "An EKS cluster",
+			},
+		},
+		{
+			ClusterName: "default/imported",
+			Name:        "imported",
+			Namespace:   "default",
+			Labels:      map[string]string{"env": "prod"},
+			NodePools:   []*capiv1_protos.ClusterNodePool{},
+			Profiles:    []*capiv1_protos.InstalledProfile{},
+			Owners:      []string{},
+		},
+		{
+			ClusterName:       "management",
+			Name:              "management",
+			KubernetesVersion: "v1.27.3",
+			NodePools:         []*capiv1_protos.ClusterNodePool{},
+			Profiles: []*capiv1_protos.InstalledProfile{
+				{Name: "weave-gitops-enterprise", Namespace: "flux-system", Chart: "mccp", Version: "0.37.0", Status: "Success"},
+			},
+			Owners:       []string{},
+			ControlPlane: true,
+		},
+	}
+	if diff := cmp.Diff(expected, res.Clusters, protocmp.Transform()); diff != "" {
+		t.Fatalf("cluster inventory didn't match expected:\n%s", diff)
+	}
+	if len(res.Errors) != 0 {
+		t.Fatalf("expected no errors, got %v", res.Errors)
+	}
+}
+
+func TestExportClusterInventory_ClusterSelector(t *testing.T) {
+	s := createServer(t, serverOptions{
+		clusterState: []runtime.Object{
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "dev"
+				o.ObjectMeta.Namespace = "default"
+				o.ObjectMeta.Labels = map[string]string{"env": "dev"}
+			}),
+			makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "prod"
+				o.ObjectMeta.Namespace = "default"
+				o.ObjectMeta.Labels = map[string]string{"env": "prod"}
+			}),
+		},
+	})
+
+	ctx := auth.WithPrincipal(context.Background(), &auth.UserPrincipal{ID: "userID"})
+	res, err := s.ExportClusterInventory(ctx, &capiv1_protos.ExportClusterInventoryRequest{ClusterSelector: "env=prod"})
+	if err != nil {
+		t.Fatalf("failed to export the cluster inventory: %s", err)
+	}
+
+	if len(res.Clusters) != 1 || res.Clusters[0].ClusterName != "default/prod" {
+		t.Fatalf("expected only the prod cluster, got %v", res.Clusters)
+	}
+
+	// The Explorer is not enabled so the profiles are not exported
+	expectedErrors := []*capiv1_protos.ListError{
+		{Message: "failed to get the installed profiles: the explorer is not enabled"},
+	}
+	if diff := cmp.Diff(expectedErrors, res.Errors, protocmp.Transform()); diff != "" {
+		t.Fatalf("errors didn't match expected:\n%s", diff)
+	}
+
+	_, err = s.ExportClusterInventory(ctx, &capiv1_protos.ExportClusterInventoryRequest{ClusterSelector: "env in (prod"})
+	if err == nil {
+		t.Fatal("expected an invalid cluster selector to fail")
+	}
+}
+
+func makeTestMachineDeployment(name, clusterName string, replicas, readyReplicas int32) *clusterv1.MachineDeployment {
+	version := "v1.28.2"
+	return &clusterv1.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterv1.GroupVersion.String(),
+			Kind:       "MachineDeployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			ClusterName: clusterName,
+			Replicas:    &replicas,
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					ClusterName:       clusterName,
+					Version:           &version,
+					InfrastructureRef: corev1.ObjectReference{Kind: "AWSMachineTemplate", Name: name},
+				},
+			},
+		},
+		Status: clusterv1.MachineDeploymentStatus{ReadyReplicas: readyReplicas},
+	}
+}
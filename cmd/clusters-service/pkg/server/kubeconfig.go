@@ -0,0 +1,168 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Modes of the kubeconfigs returned by GetKubeconfig.
+const (
+	// KubeconfigModeEmbedded returns the kubeconfig of the cluster secret with its credentials.
+	KubeconfigModeEmbedded = "embedded"
+	// KubeconfigModeOIDC returns a kubeconfig without credentials that logs in to the OIDC issuer
+	// with the kubelogin exec plugin.
+	KubeconfigModeOIDC = "oidc"
+	// KubeconfigModeProxy returns a kubeconfig without credentials for the cluster proxy of
+	// clusters-service, that logs in to the OIDC issuer with the kubelogin exec plugin.
+	KubeconfigModeProxy = "proxy"
+)
+
+// oidcKubeconfigUser is the name of the user of the kubeconfigs in OIDC mode.
+const oidcKubeconfigUser = "oidc"
+
+// KubeconfigOIDCOptions configure the exec plugin of the kubeconfigs in OIDC and proxy modes. In OIDC
+// mode the API servers of the clusters must trust tokens issued by the same OIDC issuer and client.
+type KubeconfigOIDCOptions struct {
+	IssuerURL string
+	ClientID  string
+	// Scopes are requested in addition to openid.
+	Scopes []string
+	// DisableEmbedded rejects requests for kubeconfigs with embedded credentials, the OIDC mode is
+	// the default.
+	DisableEmbedded bool
+	// ProxyURL is the external URL of the cluster proxy, the proxy mode is disabled if empty.
+	ProxyURL string
+}
+
+// kubeconfigMode returns the mode of a GetKubeconfig request, defaulting to embedded unless disabled,
+// and to OIDC otherwise.
+func (o KubeconfigOIDCOptions) kubeconfigMode(mode string) (string, error) {
+	switch mode {
+	case "":
+		if o.DisableEmbedded {
+			return o.kubeconfigMode(KubeconfigModeOIDC)
+		}
+		return KubeconfigModeEmbedded, nil
+	case KubeconfigModeEmbedded:
+		if o.DisableEmbedded {
+			return "", grpcStatus.Error(codes.PermissionDenied, "kubeconfigs with embedded credentials are disabled, use the oidc mode")
+		}
+		return mode, nil
+	case KubeconfigModeOIDC, KubeconfigModeProxy:
+		if o.IssuerURL == "" || o.ClientID == "" {
+			return "", grpcStatus.Errorf(codes.FailedPrecondition, "OIDC is not configured, cannot create a kubeconfig in %s mode", mode)
+		}
+		if mode == KubeconfigModeProxy && o.ProxyURL == "" {
+			return "", grpcStatus.Error(codes.FailedPrecondition, "the cluster proxy is not enabled")
+		}
+		return mode, nil
+	default:
+		return "", grpcStatus.Errorf(codes.InvalidArgument, "invalid kubeconfig mode %q, must be one of %q, %q or %q", mode, KubeconfigModeEmbedded, KubeconfigModeOIDC, KubeconfigModeProxy)
+	}
+}
+
+// oidcKubeconfig returns a kubeconfig with the clusters and contexts of a kubeconfig, where all the
+// contexts authenticate with the kubelogin exec plugin instead of the credentials of the kubeconfig.
+// Only the server and certificate authority of the clusters are kept, so it carries no secret.
+func oidcKubeconfig(kubeconfig []byte, opts KubeconfigOIDCOptions) ([]byte, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the KubeConfig: %w", err)
+	}
+
+	result := clientcmdapi.NewConfig()
+	result.CurrentContext = cfg.CurrentContext
+	for name, context := range cfg.Contexts {
+		cluster, ok := cfg.Clusters[context.Cluster]
+		if !ok {
+			return nil, fmt.Errorf("cluster %q of context %q not found in the KubeConfig", context.Cluster, name)
+		}
+
+		// A CA file is a path on the clusters-service filesystem, that the user doesn't have
+		if cluster.CertificateAuthority != "" && len(cluster.CertificateAuthorityData) == 0 {
+			return nil, fmt.Errorf("cluster %q of the KubeConfig has a certificate-authority file, certificate-authority-data is required", context.Cluster)
+		}
+		result.Clusters[context.Cluster] = &clientcmdapi.Cluster{
+			Server:                   cluster.Server,
+			TLSServerName:            cluster.TLSServerName,
+			InsecureSkipTLSVerify:    cluster.InsecureSkipTLSVerify,
+			CertificateAuthorityData: cluster.CertificateAuthorityData,
+			ProxyURL:                 cluster.ProxyURL,
+		}
+		result.Contexts[name] = &clientcmdapi.Context{
+			Cluster:   context.Cluster,
+			Namespace: context.Namespace,
+			AuthInfo:  oidcKubeconfigUser,
+		}
+	}
+	if len(result.Contexts) == 0 {
+		return nil, errors.New("no contexts found in the KubeConfig")
+	}
+	result.AuthInfos[oidcKubeconfigUser] = oidcAuthInfo(opts)
+
+	return clientcmd.Write(*result)
+}
+
+// proxyKubeconfig returns a kubeconfig for the cluster proxy of a GitopsCluster, that authenticates to
+// clusters-service with the kubelogin exec plugin.
+func proxyKubeconfig(cluster types.NamespacedName, opts KubeconfigOIDCOptions) ([]byte, error) {
+	server, err := url.JoinPath(opts.ProxyURL, cluster.Namespace, cluster.Name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster proxy URL %q: %w", opts.ProxyURL, err)
+	}
+
+	name := cluster.Namespace + "-" + cluster.Name
+	result := clientcmdapi.NewConfig()
+	result.Clusters[name] = &clientcmdapi.Cluster{Server: server}
+	result.AuthInfos[oidcKubeconfigUser] = oidcAuthInfo(opts)
+	result.Contexts[name] = &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: oidcKubeconfigUser,
+	}
+	result.CurrentContext = name
+
+	return clientcmd.Write(*result)
+}
+
+func oidcAuthInfo(opts KubeconfigOIDCOptions) *clientcmdapi.AuthInfo {
+	return &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:      "client.authentication.k8s.io/v1beta1",
+			Command:         "kubectl",
+			Args:            oidcLoginArgs(opts),
+			InstallHint:     "The kubelogin plugin is required to log in with OIDC, see https://github.com/int128/kubelogin#setup",
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}
+}
+
+func oidcLoginArgs(opts KubeconfigOIDCOptions) []string {
+	args := []string{
+		"oidc-login",
+		"get-token",
+		"--oidc-issuer-url=" + opts.IssuerURL,
+		"--oidc-client-id=" + opts.ClientID,
+	}
+
+	scopes := []string{}
+	for _, scope := range opts.Scopes {
+		// kubelogin always requests the openid scope
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	sort.Strings(scopes)
+	for _, scope := range scopes {
+		args = append(args, "--oidc-extra-scope="+scope)
+	}
+
+	return args
+}
@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+
+	capiv1_protos "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+)
+
+const testKubeconfigWithToken = `apiVersion: v1
+kind: Config
+clusters:
+- cluster:
+    certificate-authority-data: Y2VydGlmaWNhdGU=
+    server: https://dev.example.com:6443
+  name: dev
+contexts:
+- context:
+    cluster: dev
+    user: dev-admin
+  name: dev-admin@dev
+current-context: dev-admin@dev
+users:
+- name: dev-admin
+  user:
+    token: long-lived-token
+`
+
+func TestGetKubeconfig_Modes(t *testing.T) {
+	oidcOptions := KubeconfigOIDCOptions{
+		IssuerURL: "https://dex.example.com",
+		ClientID:  "weave-gitops",
+		Scopes:    []string{"openid", "groups", "email"},
+		ProxyURL:  "https://wge.example.com/v1/cluster-proxy/",
+	}
+
+	testCases := []struct {
+		name           string
+		kubeconfigOIDC KubeconfigOIDCOptions
+		mode           string
+		// the user can only get the GitopsCluster, not its kubeconfig secret
+		noSecretAccess bool
+		wantToken      bool
+		wantServer     string
+		wantCode       codes.Code
+	}{
+		{
+			name:           "embedded by default",
+			kubeconfigOIDC: oidcOptions,
+			wantToken:      true,
+		},
+		{
+			name:           "oidc",
+			kubeconfigOIDC: oidcOptions,
+			mode:           KubeconfigModeOIDC,
+			wantServer:     "https://dev.example.com:6443",
+		},
+		{
+			name:           "oidc without access to the kubeconfig secret",
+			kubeconfigOIDC: oidcOptions,
+			mode:           KubeconfigModeOIDC,
+			noSecretAccess: true,
+			wantServer:     "https://dev.example.com:6443",
+		},
+		{
+			name:           "embedded without access to the kubeconfig secret",
+			kubeconfigOIDC: oidcOptions,
+			noSecretAccess: true,
+			wantCode:       codes.Unknown,
+		},
+		{
+			name: "oidc by default if embedded is disabled",
+			kubeconfigOIDC: KubeconfigOIDCOptions{
+				IssuerURL:       oidcOptions.IssuerURL,
+				ClientID:        oidcOptions.ClientID,
+				Scopes:          oidcOptions.Scopes,
+				DisableEmbedded: true,
+			},
+			wantServer: "https://dev.example.com:6443",
+		},
+		{
+			name:           "proxy",
+			kubeconfigOIDC: oidcOptions,
+			mode:           KubeconfigModeProxy,
+			wantServer:     "https://wge.example.com/v1/cluster-proxy/default/dev",
+		},
+		{
+			name: "proxy is not enabled",
+			kubeconfigOIDC: KubeconfigOIDCOptions{
+				IssuerURL: oidcOptions.IssuerURL,
+				ClientID:  oidcOptions.ClientID,
+			},
+			mode:     KubeconfigModeProxy,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:           "embedded is disabled",
+			kubeconfigOIDC: KubeconfigOIDCOptions{DisableEmbedded: true},
+			mode:           KubeconfigModeEmbedded,
+			wantCode:       codes.PermissionDenied,
+		},
+		{
+			name:     "oidc is not configured",
+			mode:     KubeconfigModeOIDC,
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:           "default mode, embedded disabled, oidc is not configured",
+			kubeconfigOIDC: KubeconfigOIDCOptions{DisableEmbedded: true},
+			wantCode:       codes.FailedPrecondition,
+		},
+		{
+			name:     "invalid mode",
+			mode:     "token",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			gc := makeTestGitopsCluster(func(o *gitopsv1alpha1.GitopsCluster) {
+				o.ObjectMeta.Name = "dev"
+				o.ObjectMeta.Namespace = "default"
+			})
+			secret := makeSecret("dev-kubeconfig", "default", "value", testKubeconfigWithToken)
+			userState := []runtime.Object{gc, secret}
+			if tt.noSecretAccess {
+				userState = []runtime.Object{gc}
+			}
+			s := createServer(t, serverOptions{
+				clusterState:    userState,
+				clustersManager: makeTestClustersManager(t, gc.DeepCopy(), secret.DeepCopy()),
+				namespace:       "default",
+				kubeconfigOIDC:  tt.kubeconfigOIDC,
+			})
+
+			res, err := s.GetKubeconfig(context.Background(), &capiv1_protos.GetKubeconfigRequest{
+				Name:      "dev",
+				Namespace: "default",
+				Mode:      tt.mode,
+			})
+			if tt.wantCode != codes.OK {
+				if code := grpcStatus.Code(err); code != tt.wantCode {
+					t.Fatalf("got error %v, want code %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get the kubeconfig: %s", err)
+			}
+
+			cfg, err := clientcmd.Load(kubeconfigFromResponse(t, res.Data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantToken {
+				if cfg.AuthInfos["dev-admin"].Token != "long-lived-token" {
+					t.Fatalf("kubeconfig does not embed the token: %v", cfg.AuthInfos)
+				}
+				return
+			}
+
+			if diff := cmp.Diff([]string{"oidc"}, mapKeys(cfg.AuthInfos)); diff != "" {
+				t.Fatalf("kubeconfig users didn't match expected:\n%s", diff)
+			}
+			exec := cfg.AuthInfos["oidc"].Exec
+			if exec == nil || cfg.AuthInfos["oidc"].Token != "" {
+				t.Fatalf("kubeconfig should only authenticate with the exec plugin: %v", cfg.AuthInfos["oidc"])
+			}
+			wantArgs := []string{
+				"oidc-login",
+				"get-token",
+				"--oidc-issuer-url=https://dex.example.com",
+				"--oidc-client-id=weave-gitops",
+				"--oidc-extra-scope=email",
+				"--oidc-extra-scope=groups",
+			}
+			if diff := cmp.Diff(wantArgs, exec.Args); diff != "" {
+				t.Fatalf("exec plugin args didn't match expected:\n%s", diff)
+			}
+			context := cfg.Contexts[cfg.CurrentContext]
+			if context == nil || context.AuthInfo != "oidc" {
+				t.Fatalf("kubeconfig current context should use the exec plugin: %v", context)
+			}
+			if server := cfg.Clusters[context.Cluster].Server; server != tt.wantServer {
+				t.Fatalf("got kubeconfig server %q, want %q", server, tt.wantServer)
+			}
+			if tt.mode != KubeconfigModeProxy && string(cfg.Clusters[context.Cluster].CertificateAuthorityData) != "certificate" {
+				t.Fatalf("kubeconfig cluster should keep the CA of the secret: %v", cfg.Clusters[context.Cluster])
+			}
+		})
+	}
+}
+
+func TestOIDCKubeconfig_CertificateAuthorityFile(t *testing.T) {
+	kubeconfig := strings.Replace(testKubeconfigWithToken,
+		"certificate-authority-data: Y2VydGlmaWNhdGU=", "certificate-authority: /etc/kubernetes/pki/ca.crt", 1)
+
+	_, err := oidcKubeconfig([]byte(kubeconfig), KubeconfigOIDCOptions{
+		IssuerURL: "https://dex.example.com",
+		ClientID:  "weave-gitops",
+	})
+	if err == nil || !strings.Contains(err.Error(), "certificate-authority-data is required") {
+		t.Fatalf("got error %v, want a certificate-authority-data is required error", err)
+	}
+}
+
+func kubeconfigFromResponse(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var body struct {
+		Kubeconfig []byte `json:"kubeconfig"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		t.Fatal(err)
+	}
+
+	return body.Kubeconfig
+}
+
+func mapKeys[V any](m map[string]V) []string {
+	keys := []string{}
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	pacv2beta2 "github.com/weaveworks/policy-agent/api/v2beta2"
 	capiv1_proto "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/protos"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	core "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -34,6 +35,11 @@ const (
 
 // ListPolicyConfigs lists the policy configs
 func (s *server) ListPolicyConfigs(ctx context.Context, req *capiv1_proto.ListPolicyConfigsRequest) (*capiv1_proto.ListPolicyConfigsResponse, error) {
+	clusterSelector, err := s.selectClusters(ctx, req.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	respErrors := []*capiv1_proto.ListError{}
 	clustersClient, err := s.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
 	if err != nil {
@@ -47,7 +53,7 @@ func (s *server) ListPolicyConfigs(ctx context.Context, req *capiv1_proto.ListPo
 			return nil, fmt.Errorf("unexpected error while getting clusters client, error: %w", err)
 		}
 	}
-	policyConfigs, policyConfigsListErrors, err := s.listPolicyConfigs(ctx, clustersClient)
+	policyConfigs, policyConfigsListErrors, err := s.listPolicyConfigs(ctx, clustersClient, clusterSelector)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +69,7 @@ func (s *server) ListPolicyConfigs(ctx context.Context, req *capiv1_proto.ListPo
 }
 
 // listPolicyConfigs helper inner function to list policy configs by using cluster manager client
-func (s *server) listPolicyConfigs(ctx context.Context, cl clustersmngr.Client) ([]*capiv1_proto.PolicyConfigListItem, []*capiv1_proto.ListError, error) {
+func (s *server) listPolicyConfigs(ctx context.Context, cl clustersmngr.Client, clusterSelector *fetcher.ClusterSelector) ([]*capiv1_proto.PolicyConfigListItem, []*capiv1_proto.ListError, error) {
 	clusterListErrors := []*capiv1_proto.ListError{}
 
 	list := clustersmngr.NewClusteredList(func() client.ObjectList {
@@ -73,7 +79,7 @@ func (s *server) listPolicyConfigs(ctx context.Context, cl clustersmngr.Client)
 	if err := cl.ClusteredList(ctx, list, false); err != nil {
 		if e, ok := err.(clustersmngr.ClusteredListError); ok {
 			for i := range e.Errors {
-				if !strings.Contains(e.Errors[i].Error(), "no matches for kind ") {
+				if !strings.Contains(e.Errors[i].Error(), "no matches for kind ") && clusterSelector.Matches(e.Errors[i].Cluster) {
 					clusterListErrors = append(clusterListErrors, &capiv1_proto.ListError{ClusterName: e.Errors[i].Cluster, Message: e.Errors[i].Error()})
 				}
 
@@ -90,6 +96,9 @@ func (s *server) listPolicyConfigs(ctx context.Context, cl clustersmngr.Client)
 
 	policyConfigs := []*capiv1_proto.PolicyConfigListItem{}
 	for clusterName, objs := range policyConfigList {
+		if !clusterSelector.Matches(clusterName) {
+			continue
+		}
 		for i := range objs {
 			obj, ok := objs[i].(*pacv2beta2.PolicyConfigList)
 			if !ok {
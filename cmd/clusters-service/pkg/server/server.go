@@ -50,6 +50,12 @@ type server struct {
 	managementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher
 	estimator         estimation.Estimator
 	uiConfig          string
+	clusterProber     ClusterProber
+	kubeconfigOIDC    KubeconfigOIDCOptions
+	decommissionJobs  *decommissionJobs
+	chartsCacheWriter helm.ChartsCacheWriter
+	explorerPurger    ClusterPurger
+	explorerQuerier   ExplorerQuerier
 }
 
 type ServerOpts struct {
@@ -69,6 +75,13 @@ type ServerOpts struct {
 	ManagementFetcher     *mgmtfetcher.ManagementCrossNamespacesFetcher
 	Estimator             estimation.Estimator
 	UIConfig              string
+	ClusterProber         ClusterProber
+	KubeconfigOIDC        KubeconfigOIDCOptions
+	// ChartsCacheWriter and ExplorerPurger purge the data of decommissioned clusters, optional
+	ChartsCacheWriter helm.ChartsCacheWriter
+	ExplorerPurger    ClusterPurger
+	// ExplorerQuerier gets the installed profiles of the cluster inventory, optional
+	ExplorerQuerier ExplorerQuerier
 }
 
 func NewClusterServer(opts ServerOpts) capiv1_proto.ClustersServiceServer {
@@ -89,5 +102,11 @@ func NewClusterServer(opts ServerOpts) capiv1_proto.ClustersServiceServer {
 		cluster:               opts.Cluster,
 		estimator:             opts.Estimator,
 		uiConfig:              opts.UIConfig,
+		clusterProber:         opts.ClusterProber,
+		kubeconfigOIDC:        opts.KubeconfigOIDC,
+		decommissionJobs:      newDecommissionJobs(),
+		chartsCacheWriter:     opts.ChartsCacheWriter,
+		explorerPurger:        opts.ExplorerPurger,
+		explorerQuerier:       opts.ExplorerQuerier,
 	}
 }
@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	. "github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap"
@@ -41,6 +42,21 @@ gitops bootstrap --silent --version=<version> --password=<admin-password> --disc
 
 # Run Weave GitOps Enterprise bootstrapping with extra components 
 gitops bootstrap --components-extra="policy-agent,tf-controller"
+
+# Run Weave GitOps Enterprise bootstrapping with cert-manager, External Secrets Operator and Cluster API
+gitops bootstrap --components-extra="cert-manager,external-secrets,capi"
+
+# Run Weave GitOps Enterprise bootstrapping from a config file. It is non-interactive and only applies what is not already in the cluster, so it can be re-run. Flags take precedence over the config file.
+gitops bootstrap --config-file=bootstrap-config.yaml
+
+# Plan Weave GitOps Enterprise bootstrapping without writing in the cluster or Git, comparing the intended actions with the current state
+gitops bootstrap --config-file=bootstrap-config.yaml --dry-run
+
+# Start WGE installation authenticating to GitHub as a GitHub App
+gitops bootstrap --repo-url=https://github.com/my-org-name/my-repo-name --github-app-id=12345 --github-app-installation-id=67890 --github-app-private-key=/path/to/app.private-key.pem
+
+# Plan Weave GitOps Enterprise bootstrapping writing the intended actions as json
+gitops bootstrap --silent --dry-run --output=json
 `
 )
 
@@ -55,6 +71,12 @@ type bootstrapFlags struct {
 	// https git auth flags
 	gitUsername string
 	gitPassword string
+	gitToken    string
+
+	// github app git auth flags
+	githubAppID             int64
+	githubAppInstallationID int64
+	githubAppPrivateKey     string
 
 	// git repo flags
 	repoURL  string
@@ -69,12 +91,17 @@ type bootstrapFlags struct {
 	// modes flags
 	silent bool
 	export bool
+	dryRun bool
+	output string
 
 	// flux flag
 	bootstrapFlux bool
 
 	// extra controllers
 	componentsExtra []string
+
+	// declarative config file
+	configFile string
 }
 
 var flags bootstrapFlags
@@ -89,11 +116,16 @@ func Command(opts *config.Options) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&flags.version, "version", "v", "", "version of Weave GitOps Enterprise (should be from the latest 3 versions)")
-	cmd.Flags().StringSliceVar(&flags.componentsExtra, "components-extra", nil, "extra components to be installed. Supported components: none, policy-agent, tf-controller")
+	cmd.Flags().StringSliceVar(&flags.componentsExtra, "components-extra", nil, fmt.Sprintf("extra components to be installed. Supported components: %s", strings.Join(steps.ComponentsExtra, ", ")))
+	cmd.Flags().StringVarP(&flags.configFile, "config-file", "f", "", "path to a bootstrap config file to reconcile the cluster to, in a non-interactive session")
 	cmd.PersistentFlags().BoolVarP(&flags.silent, "silent", "s", false, "non-interactive session: it will not ask questions but rather to use default values to complete the introduced flags")
 	cmd.PersistentFlags().BoolVarP(&flags.bootstrapFlux, "bootstrap-flux", "", false, "flags that you want to bootstrap Flux in case is not detected")
 	cmd.PersistentFlags().StringVarP(&flags.gitUsername, "git-username", "", "", "git username used in https authentication type")
 	cmd.PersistentFlags().StringVarP(&flags.gitPassword, "git-password", "", "", "git password/token used in https authentication type")
+	cmd.PersistentFlags().StringVarP(&flags.gitToken, "git-token", "", "", "git token that doesn't require a username, like a GitHub fine-grained personal access token, used in https authentication type")
+	cmd.PersistentFlags().Int64Var(&flags.githubAppID, "github-app-id", 0, "GitHub App id to authenticate to the git repository over https")
+	cmd.PersistentFlags().Int64Var(&flags.githubAppInstallationID, "github-app-installation-id", 0, "GitHub App installation id for the repository owner")
+	cmd.PersistentFlags().StringVar(&flags.githubAppPrivateKey, "github-app-private-key", "", "path to the GitHub App private key")
 	cmd.PersistentFlags().StringVarP(&flags.branch, "branch", "b", "", "git branch for your flux repository (example: main)")
 	cmd.PersistentFlags().StringVarP(&flags.repoPath, "repo-path", "r", "", "git path for your flux repository (example: clusters/my-cluster)")
 	cmd.PersistentFlags().StringVarP(&flags.repoURL, "repo-url", "", "", "git repo url for your flux repository (example: ssh://git@github.com/my-org-name/my-repo-name or https://github.com/my-org-name/my-repo-name)")
@@ -103,16 +135,25 @@ func Command(opts *config.Options) *cobra.Command {
 	cmd.PersistentFlags().StringVarP(&flags.clientID, "client-id", "i", "", "OIDC client ID")
 	cmd.PersistentFlags().StringVarP(&flags.clientSecret, "client-secret", "", "", "OIDC client secret")
 	cmd.PersistentFlags().BoolVar(&flags.export, "export", false, "write to stdout the bootstrapping manifests without writing in the cluster or Git. It requires Flux to be bootstrapped.")
+	cmd.Flags().BoolVar(&flags.dryRun, "dry-run", false, "report the actions the bootstrap would take, compared with the current state, without writing in the cluster or Git")
+	cmd.Flags().StringVarP(&flags.output, "output", "o", steps.PlanFormatText, "format of the dry-run report. Supported formats: text, json")
 	cmd.AddCommand(AuthCommand(opts))
+	cmd.AddCommand(UninstallCommand(opts))
+	cmd.AddCommand(DoctorCommand(opts))
 
 	return cmd
 }
 
 func getBootstrapCmdRun(opts *config.Options) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		cliLogger := logger.NewCLILogger(os.Stdout)
+		// keep stdout parseable when writing the dry-run report as json
+		logWriter := os.Stdout
+		if flags.dryRun && flags.output == steps.PlanFormatJSON {
+			logWriter = os.Stderr
+		}
+		cliLogger := logger.NewCLILogger(logWriter)
 		// create config from flags
-		c, err := steps.NewConfigBuilder().
+		builder := steps.NewConfigBuilder().
 			WithLogWriter(cliLogger).
 			WithKubeconfig(opts.Kubeconfig).
 			WithPassword(opts.Password).
@@ -127,15 +168,27 @@ func getBootstrapCmdRun(opts *config.Options) func(*cobra.Command, []string) err
 				flags.gitUsername,
 				flags.gitPassword,
 			).
+			WithGitToken(flags.gitToken).
+			WithGitHubApp(flags.githubAppID, flags.githubAppInstallationID, flags.githubAppPrivateKey).
 			WithOIDCConfig(flags.discoveryURL, flags.clientID, flags.clientSecret, true).
 			WithBootstrapFluxFlag(flags.bootstrapFlux).
 			WithComponentsExtra(flags.componentsExtra).
 			WithSilent(flags.silent).
 			WithExport(flags.export).
+			WithDryRun(flags.dryRun, flags.output).
 			WithInReader(cmd.InOrStdin()).
-			WithOutWriter(cmd.OutOrStdout()).
-			Build()
+			WithOutWriter(cmd.OutOrStdout())
+
+		// complete config from file
+		if flags.configFile != "" {
+			configFile, err := steps.LoadConfigFile(flags.configFile)
+			if err != nil {
+				return fmt.Errorf("cannot config bootstrap: %v", err)
+			}
+			builder = builder.WithConfigFile(configFile)
+		}
 
+		c, err := builder.Build()
 		if err != nil {
 			return fmt.Errorf("cannot config bootstrap: %v", err)
 		}
@@ -0,0 +1,78 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	. "github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/steps"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+)
+
+const (
+	doctorCmdName             = "doctor"
+	doctorCmdShortDescription = "Verifies the health of Weave GitOps Enterprise installed by bootstrap"
+	doctorCmdLongDescription  = `Runs the same health checks as the end of bootstrap:
+- Entitlement: the entitlement secret is valid and not expired.
+- Weave GitOps: the HelmRelease and the pods are ready.
+- API: the clusters-service API answers through the Kubernetes API server proxy.
+- OIDC: the discovery URL of the configured issuer is reachable.
+
+If any check fails, it writes a support bundle with the HelmRelease statuses, pod logs, events and
+sanitized values of Weave GitOps Enterprise.
+`
+	doctorCmdExamples = `
+# Verify Weave GitOps Enterprise installation
+gitops bootstrap doctor
+
+# Verify Weave GitOps Enterprise installation writing the support bundle to a given path on failure
+gitops bootstrap doctor --bundle-path=/tmp/wge-support-bundle.tar.gz
+`
+)
+
+type doctorConfigFlags struct {
+	bundlePath string
+}
+
+var doctorFlags doctorConfigFlags
+
+func DoctorCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     doctorCmdName,
+		Short:   doctorCmdShortDescription,
+		Long:    doctorCmdLongDescription,
+		Example: doctorCmdExamples,
+		RunE:    getDoctorCmdRun(opts),
+	}
+
+	cmd.Flags().StringVar(&doctorFlags.bundlePath, "bundle-path", "", "path to write the support bundle to in case of failure. Defaults to wge-support-bundle-<timestamp>.tar.gz in the current directory")
+
+	return cmd
+}
+
+func getDoctorCmdRun(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		cliLogger := logger.NewCLILogger(os.Stdout)
+
+		c, err := steps.NewConfigBuilder().
+			WithLogWriter(cliLogger).
+			WithKubeconfig(opts.Kubeconfig).
+			WithSilent(true).
+			WithSupportBundlePath(doctorFlags.bundlePath).
+			WithInReader(cmd.InOrStdin()).
+			WithOutWriter(cmd.OutOrStdout()).
+			Build()
+		if err != nil {
+			return fmt.Errorf("cannot config bootstrap doctor: %v", err)
+		}
+
+		err = Doctor(c)
+		if err != nil {
+			return fmt.Errorf("cannot verify installation: %v", err)
+		}
+
+		return nil
+	}
+}
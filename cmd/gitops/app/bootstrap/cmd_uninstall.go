@@ -0,0 +1,96 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	. "github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/steps"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+)
+
+const (
+	uninstallCmdName             = "uninstall"
+	uninstallCmdShortDescription = "Removes Weave GitOps Enterprise installed by bootstrap"
+	uninstallCmdLongDescription  = `Removes what bootstrap created from the cluster and the Git repository:
+- Extra components: removes the cert-manager, external-secrets, capi, policy-agent and tf-controller files from the Git repo.
+- Authentication: deletes the OIDC and admin credentials secrets.
+- Weave GitOps: removes the HelmRelease and HelmRepository files from the Git repo, Flux prunes them from the cluster.
+- Flux: uninstalls Flux, once the removed HelmReleases are uninstalled, unless --keep-flux is set.
+`
+	uninstallCmdExamples = `
+# Uninstall Weave GitOps Enterprise and Flux in interactive session
+gitops bootstrap uninstall
+
+# Uninstall Weave GitOps Enterprise keeping Flux without asking for confirmation
+gitops bootstrap uninstall --keep-flux --silent
+
+# Plan the uninstall without writing in the cluster or Git
+gitops bootstrap uninstall --dry-run
+`
+)
+
+type uninstallConfigFlags struct {
+	keepFlux bool
+	dryRun   bool
+	output   string
+}
+
+var uninstallFlags uninstallConfigFlags
+
+func UninstallCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     uninstallCmdName,
+		Short:   uninstallCmdShortDescription,
+		Long:    uninstallCmdLongDescription,
+		Example: uninstallCmdExamples,
+		RunE:    getUninstallCmdRun(opts),
+	}
+
+	cmd.Flags().BoolVar(&uninstallFlags.keepFlux, "keep-flux", false, "keep Flux installed in the cluster")
+	cmd.Flags().BoolVar(&uninstallFlags.dryRun, "dry-run", false, "report the actions the uninstall would take without writing in the cluster or Git")
+	cmd.Flags().StringVarP(&uninstallFlags.output, "output", "o", steps.PlanFormatText, "format of the dry-run report. Supported formats: text, json")
+
+	return cmd
+}
+
+func getUninstallCmdRun(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		logWriter := os.Stdout
+		if uninstallFlags.dryRun && uninstallFlags.output == steps.PlanFormatJSON {
+			logWriter = os.Stderr
+		}
+		cliLogger := logger.NewCLILogger(logWriter)
+
+		c, err := steps.NewConfigBuilder().
+			WithLogWriter(cliLogger).
+			WithKubeconfig(opts.Kubeconfig).
+			WithGitAuthentication(flags.privateKeyPath,
+				flags.privateKeyPassword,
+				cmd.Flag("private-key-password").Changed,
+				flags.gitUsername,
+				flags.gitPassword,
+			).
+			WithGitToken(flags.gitToken).
+			WithGitHubApp(flags.githubAppID, flags.githubAppInstallationID, flags.githubAppPrivateKey).
+			WithSilent(flags.silent).
+			WithExport(flags.export).
+			WithDryRun(uninstallFlags.dryRun, uninstallFlags.output).
+			WithKeepFlux(uninstallFlags.keepFlux).
+			WithInReader(cmd.InOrStdin()).
+			WithOutWriter(cmd.OutOrStdout()).
+			Build()
+		if err != nil {
+			return fmt.Errorf("cannot config bootstrap uninstall: %v", err)
+		}
+
+		err = Uninstall(c)
+		if err != nil {
+			return fmt.Errorf("cannot uninstall: %v", err)
+		}
+
+		return nil
+	}
+}
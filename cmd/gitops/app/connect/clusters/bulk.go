@@ -0,0 +1,168 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/connector"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// bulkPullRequestFlags are the options of the pull request adding the GitopsClusters of a bulk connection
+type bulkPullRequestFlags struct {
+	RepositoryURL string
+	BaseBranch    string
+	HeadBranch    string
+	Title         string
+	Description   string
+	CommitMessage string
+	Path          string
+}
+
+// bulkConnectClusters connects the clusters of the kubeconfig contexts, prints a summary of the results
+// and creates the pull request with the GitopsClusters in pull request mode.
+func bulkConnectClusters(ctx context.Context, options connector.ClusterConnectionOptions, w io.Writer, lookupEnv func(string) (string, bool)) error {
+	bulkOptions := &connector.BulkConnectionOptions{
+		ClusterConnectionOptions: options,
+		KubeconfigPath:           connectOptionsCmdFlags.FromKubeconfig,
+		GitopsClusterMode:        connectOptionsCmdFlags.GitopsClusterMode,
+		Concurrency:              connectOptionsCmdFlags.Concurrency,
+	}
+	if connectOptionsCmdFlags.ClusterNamesFile != "" {
+		clusterNames, err := connector.LoadClusterNames(connectOptionsCmdFlags.ClusterNamesFile)
+		if err != nil {
+			return err
+		}
+		bulkOptions.ClusterNames = clusterNames
+	}
+
+	var provider csgit.GitProvider
+	if bulkOptions.GitopsClusterMode == connector.GitopsClusterModePullRequest {
+		// Fail before connecting any cluster if the pull request cannot be created
+		var err error
+		provider, err = pullRequestGitProvider(lookupEnv)
+		if err != nil {
+			return err
+		}
+	}
+
+	results, err := connector.BulkConnectClusters(ctx, bulkOptions)
+	if err != nil {
+		return err
+	}
+
+	failed := printBulkResults(results, w)
+
+	if bulkOptions.GitopsClusterMode == connector.GitopsClusterModePullRequest && failed < len(results) {
+		prURL, err := createGitopsClustersPullRequest(ctx, provider, results)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\nCreated pull request with the GitopsClusters: %s\n", prURL)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d clusters failed to connect", failed, len(results))
+	}
+
+	return nil
+}
+
+// printBulkResults writes a table with the outcome of each connection and returns the number of failures
+func printBulkResults(results []connector.BulkConnectionResult, w io.Writer) int {
+	tw := printers.GetNewTabWriter(w)
+	defer tw.Flush()
+
+	failed := 0
+	fmt.Fprintf(tw, "CONTEXT\tCLUSTER\tSECRET\tSTATUS\n")
+	for _, result := range results {
+		cluster, secret := "", ""
+		if result.GitopsCluster != nil {
+			cluster = result.GitopsCluster.Namespace + "/" + result.GitopsCluster.Name
+			secret = result.GitopsCluster.Spec.SecretRef.Name
+		}
+		status := "Connected"
+		if result.Error != nil {
+			failed++
+			status = "Failed: " + result.Error.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Context, cluster, secret, status)
+	}
+
+	return failed
+}
+
+func pullRequestGitProvider(lookupEnv func(string) (string, bool)) (csgit.GitProvider, error) {
+	if connectOptionsCmdFlags.PullRequest.RepositoryURL == "" {
+		return csgit.GitProvider{}, fmt.Errorf("--url is required in %s mode", connector.GitopsClusterModePullRequest)
+	}
+
+	url, err := gitproviders.NewRepoURL(connectOptionsCmdFlags.PullRequest.RepositoryURL)
+	if err != nil {
+		return csgit.GitProvider{}, fmt.Errorf("cannot parse url: %w", err)
+	}
+
+	token, err := internal.GetToken(url, lookupEnv)
+	if err != nil {
+		return csgit.GitProvider{}, err
+	}
+
+	return csgit.GitProvider{
+		Token:     token,
+		TokenType: "oauth2",
+		Type:      string(url.Provider()),
+		Hostname:  url.URL().Host,
+	}, nil
+}
+
+// createGitopsClustersPullRequest opens a pull request adding the manifests of the GitopsClusters of the
+// connected clusters and returns its url
+func createGitopsClustersPullRequest(ctx context.Context, provider csgit.GitProvider, results []connector.BulkConnectionResult) (string, error) {
+	flags := connectOptionsCmdFlags.PullRequest
+
+	files := []git.CommitFile{}
+	for _, result := range results {
+		if result.Error != nil {
+			continue
+		}
+		content, err := yaml.Marshal(result.GitopsCluster)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal GitopsCluster %s: %w", result.GitopsCluster.Name, err)
+		}
+		manifest := string(content)
+		files = append(files, git.CommitFile{
+			Path:    path.Join(flags.Path, result.GitopsCluster.Namespace, result.GitopsCluster.Name+".yaml"),
+			Content: &manifest,
+		})
+	}
+
+	headBranch := flags.HeadBranch
+	if headBranch == "" {
+		headBranch = fmt.Sprintf("gitops-connect-clusters-%d", time.Now().Unix())
+	}
+
+	res, err := csgit.NewGitProviderService(log.FromContext(ctx)).WriteFilesToBranchAndCreatePullRequest(ctx, csgit.WriteFilesToBranchAndCreatePullRequestRequest{
+		GitProvider:   provider,
+		RepositoryURL: flags.RepositoryURL,
+		HeadBranch:    headBranch,
+		BaseBranch:    flags.BaseBranch,
+		Title:         flags.Title,
+		Description:   flags.Description,
+		CommitMessage: flags.CommitMessage,
+		Files:         files,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return res.WebURL, nil
+}
@@ -1,6 +1,11 @@
 package connect
 
 import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/connector"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
@@ -12,13 +17,29 @@ import (
 type connectOptionsFlags struct {
 	RemoteClusterContext   string
 	ServiceAccountName     string
+	ClusterRoleName        string
 	ClusterRoleBindingName string
+	AccessProfile          string
+	RulesFile              string
+	ImpersonateUsers       []string
+	ImpersonateGroups      []string
+	TokenExpiration        time.Duration
+	TokenRenewalLimit      time.Duration
+	Rotate                 bool
+	FromKubeconfig         string
+	ClusterNamesFile       string
+	GitopsClusterMode      string
+	Concurrency            int
+	PullRequest            bulkPullRequestFlags
 	Namespace              string
 	Debug                  string
 }
 
 var connectOptionsCmdFlags connectOptionsFlags
 
+// defaultTokenExpiration is the lifetime of the rotated token when --rotate is used without --token-expiration
+const defaultTokenExpiration = time.Hour
+
 func ConnectCommand(opts *config.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "cluster",
@@ -27,29 +48,91 @@ func ConnectCommand(opts *config.Options) *cobra.Command {
 		Example: `
 # Connect a Gitops cluster by creating a secret with API access details
 gitops connect cluster [PARAMS] <CLUSTER_NAME>
+
+# Connect a Gitops cluster with read-only access for the Explorer
+gitops connect cluster --access-profile explorer <CLUSTER_NAME>
+
+# Connect a Gitops cluster to operate Flux resources on behalf of the platform team
+gitops connect cluster --access-profile flux-operator --impersonate-groups platform-team <CLUSTER_NAME>
+
+# Connect a Gitops cluster with the rules of a custom cluster role
+gitops connect cluster --access-profile custom --rules-file rules.yaml <CLUSTER_NAME>
+
+# Connect a Gitops cluster with a time-bound token that is rotated before it expires
+gitops connect cluster --token-expiration 1h <CLUSTER_NAME>
+
+# Rotate the token of a connected cluster now
+gitops connect cluster --rotate <CLUSTER_NAME>
+
+# Connect the clusters of all the contexts of a kubeconfig, or directory of kubeconfigs, creating their GitopsClusters
+gitops connect cluster --from-kubeconfig region.yaml --gitopscluster-mode create
+
+# Connect the mapped contexts and open a pull request adding their GitopsClusters
+gitops connect cluster --from-kubeconfig kubeconfigs/ --cluster-names names.yaml \
+  --gitopscluster-mode pull-request --url https://github.com/org/fleet --path clusters/management/clusters
 `,
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.MinimumNArgs(1),
+		Args:          connectClusterArgs,
 		RunE:          connectClusterCmdRunE(opts),
 	}
 
 	cmd.Flags().StringVar(&connectOptionsCmdFlags.RemoteClusterContext, "connect-context", "", "Context name of the remote cluster")
 	cmd.Flags().StringVar(&connectOptionsCmdFlags.ServiceAccountName, "service-account", "weave-gitops-enterprise", "Service account name to be created/used")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.ClusterRoleName, "cluster-role", "weave-gitops-enterprise", "Cluster role name to be created/updated with the rules of the access profile")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.AccessProfile, "access-profile", connector.AccessProfileAdmin, fmt.Sprintf("Permissions of the service account. One of: %s", strings.Join(connector.AccessProfiles, ", ")))
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.RulesFile, "rules-file", "", "Path to a yaml list of RBAC policy rules for the custom access profile")
+	cmd.Flags().StringSliceVar(&connectOptionsCmdFlags.ImpersonateUsers, "impersonate-users", nil, "Users that the flux-operator access profile can impersonate for user requests")
+	cmd.Flags().StringSliceVar(&connectOptionsCmdFlags.ImpersonateGroups, "impersonate-groups", nil, "Groups that the flux-operator access profile can impersonate for user requests")
+	cmd.Flags().DurationVar(&connectOptionsCmdFlags.TokenExpiration, "token-expiration", 0, "Lifetime of the time-bound service account token, rotated before it expires. A long-lived token is used if not set")
+	cmd.Flags().DurationVar(&connectOptionsCmdFlags.TokenRenewalLimit, "token-renewal-limit", connector.DefaultTokenRenewalLimit, "How long the management cluster renews the time-bound token before the connection has to be rotated with --rotate")
+	cmd.Flags().BoolVar(&connectOptionsCmdFlags.Rotate, "rotate", false, "Rotate the token of a connected cluster, migrating long-lived tokens to time-bound tokens")
 	cmd.Flags().StringVar(&connectOptionsCmdFlags.ClusterRoleBindingName, "cluster-role-binding", "weave-gitops-enterprise", "Cluster role binding name to be created/used")
 	cmd.Flags().StringVarP(&connectOptionsCmdFlags.Namespace, "namespace", "n", "default", "Namespace of remote cluster")
 	cmd.Flags().StringVarP(&connectOptionsCmdFlags.Debug, "debug", "d", "INFO", "Verbose level of logs")
 
+	// Bulk connection
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.FromKubeconfig, "from-kubeconfig", "", "Connect the clusters of the contexts of a kubeconfig, or of a directory of kubeconfigs")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.ClusterNamesFile, "cluster-names", "", "Path to a yaml map of context names to GitopsCluster names. Only the mapped contexts are connected")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.GitopsClusterMode, "gitopscluster-mode", connector.GitopsClusterModeExisting, fmt.Sprintf("How the GitopsClusters of a bulk connection are managed. One of: %s, %s, %s", connector.GitopsClusterModeExisting, connector.GitopsClusterModeCreate, connector.GitopsClusterModePullRequest))
+	cmd.Flags().IntVar(&connectOptionsCmdFlags.Concurrency, "concurrency", connector.DefaultBulkConcurrency, "Number of clusters connected at the same time")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.RepositoryURL, "url", "", "The repository to open the pull request with the GitopsClusters against")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.BaseBranch, "base", "main", "The base branch to open the pull request against")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.HeadBranch, "branch", "", "The branch to create the pull request from")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.Title, "title", "Add connected GitopsClusters", "The title of the pull request")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.Description, "description", "Adds the GitopsClusters of the clusters connected with gitops connect cluster", "The description of the pull request")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.CommitMessage, "commit-message", "Add connected GitopsClusters", "The commit message of the pull request")
+	cmd.Flags().StringVar(&connectOptionsCmdFlags.PullRequest.Path, "path", "clusters", "The path of the repository to add the GitopsCluster manifests to")
+	cmd.MarkFlagsMutuallyExclusive("from-kubeconfig", "rotate")
+
 	return cmd
 }
 
+// connectClusterArgs requires the cluster name, unless the clusters are read from a kubeconfig
+func connectClusterArgs(cmd *cobra.Command, args []string) error {
+	if connectOptionsCmdFlags.FromKubeconfig != "" {
+		return cobra.NoArgs(cmd, args)
+	}
+	return cobra.MinimumNArgs(1)(cmd, args)
+}
+
 func connectClusterCmdRunE(opts *config.Options) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		clusterName := args[0]
+		clusterName := ""
+		if len(args) > 0 {
+			clusterName = args[0]
+		}
 
 		options := connector.ClusterConnectionOptions{
 			ServiceAccountName:     connectOptionsCmdFlags.ServiceAccountName,
+			ClusterRoleName:        connectOptionsCmdFlags.ClusterRoleName,
 			ClusterRoleBindingName: connectOptionsCmdFlags.ClusterRoleBindingName,
+			AccessProfile:          connectOptionsCmdFlags.AccessProfile,
+			RulesFile:              connectOptionsCmdFlags.RulesFile,
+			ImpersonateUsers:       connectOptionsCmdFlags.ImpersonateUsers,
+			ImpersonateGroups:      connectOptionsCmdFlags.ImpersonateGroups,
+			TokenExpiration:        connectOptionsCmdFlags.TokenExpiration,
+			TokenRenewalLimit:      connectOptionsCmdFlags.TokenRenewalLimit,
 			GitopsClusterName:      types.NamespacedName{Name: clusterName, Namespace: connectOptionsCmdFlags.Namespace},
 			RemoteClusterContext:   connectOptionsCmdFlags.RemoteClusterContext,
 			ConfigPath:             opts.Kubeconfig,
@@ -58,6 +141,17 @@ func connectClusterCmdRunE(opts *config.Options) func(*cobra.Command, []string)
 		newLogger, _ := logger.New(connectOptionsCmdFlags.Debug, false)
 		ctx := log.IntoContext(cmd.Context(), newLogger)
 
+		if connectOptionsCmdFlags.FromKubeconfig != "" {
+			return bulkConnectClusters(ctx, options, os.Stdout, os.LookupEnv)
+		}
+
+		if connectOptionsCmdFlags.Rotate {
+			if options.TokenExpiration == 0 {
+				options.TokenExpiration = defaultTokenExpiration
+			}
+			return connector.RotateClusterToken(ctx, &options)
+		}
+
 		return connector.ConnectCluster(ctx, &options)
 
 	}
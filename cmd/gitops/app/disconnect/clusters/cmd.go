@@ -12,6 +12,7 @@ import (
 type disconnectOptionsFlags struct {
 	RemoteClusterContext   string
 	ServiceAccountName     string
+	ClusterRoleName        string
 	ClusterRoleBindingName string
 	Namespace              string
 	Debug                  string
@@ -37,6 +38,7 @@ gitops disconnect cluster [PARAMS] <CLUSTER_NAME>
 
 	cmd.Flags().StringVar(&disconnectOptionsCmdFlags.RemoteClusterContext, "connect-context", "", "Context name of the remote cluster")
 	cmd.Flags().StringVar(&disconnectOptionsCmdFlags.ServiceAccountName, "service-account", "weave-gitops-enterprise", "Service account name to be created/used")
+	cmd.Flags().StringVar(&disconnectOptionsCmdFlags.ClusterRoleName, "cluster-role", "weave-gitops-enterprise", "Cluster role name to be deleted")
 	cmd.Flags().StringVar(&disconnectOptionsCmdFlags.ClusterRoleBindingName, "cluster-role-binding", "weave-gitops-enterprise", "Cluster role binding name to be created/used")
 	cmd.Flags().StringVarP(&disconnectOptionsCmdFlags.Namespace, "namespace", "n", "default", "Namespace of remote cluster")
 	cmd.Flags().StringVarP(&disconnectOptionsCmdFlags.Debug, "debug", "d", "INFO", "Verbose level of logs")
@@ -51,6 +53,7 @@ func disconnectClusterCmdRunE(opts *config.Options) func(*cobra.Command, []strin
 		options := connector.ClusterConnectionOptions{
 			GitopsClusterName:      types.NamespacedName{Name: clusterName, Namespace: disconnectOptionsCmdFlags.Namespace},
 			ServiceAccountName:     disconnectOptionsCmdFlags.ServiceAccountName,
+			ClusterRoleName:        disconnectOptionsCmdFlags.ClusterRoleName,
 			ClusterRoleBindingName: disconnectOptionsCmdFlags.ClusterRoleBindingName,
 			RemoteClusterContext:   disconnectOptionsCmdFlags.RemoteClusterContext,
 			ConfigPath:             opts.Kubeconfig,
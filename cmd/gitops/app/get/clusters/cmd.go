@@ -13,7 +13,11 @@ import (
 )
 
 type clustersGetFlags struct {
-	Kubeconfig bool
+	Kubeconfig      bool
+	KubeconfigMode  string
+	ClusterSelector string
+	Inventory       bool
+	Output          string
 }
 
 var clustersGetCmdFlags clustersGetFlags
@@ -27,11 +31,20 @@ func GetCommand(opts *config.Options, client *adapters.HTTPClient) *cobra.Comman
 # Get all clusters
 gitops get clusters
 
+# Get the clusters labelled env=prod in the eu-west region
+gitops get clusters -l env=prod,region=eu-west
+
+# Export the inventory of the clusters, with their provider, Kubernetes version, node pools, profiles and owners
+gitops get clusters --inventory -o csv
+
 # Get a single cluster
 gitops get cluster <cluster-name>
 
 # Get the Kubeconfig of a cluster
-gitops get cluster <cluster-name> --print-kubeconfig`,
+gitops get cluster <cluster-name> --print-kubeconfig
+
+# Get a Kubeconfig of a cluster without credentials, that logs in with OIDC
+gitops get cluster <cluster-name> --print-kubeconfig --kubeconfig-mode oidc`,
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		PreRunE:       getClusterCmdPreRunE(&opts.Endpoint),
@@ -39,6 +52,10 @@ gitops get cluster <cluster-name> --print-kubeconfig`,
 	}
 
 	cmd.PersistentFlags().BoolVar(&clustersGetCmdFlags.Kubeconfig, "print-kubeconfig", false, "Returns the Kubeconfig of the workload cluster")
+	cmd.PersistentFlags().StringVar(&clustersGetCmdFlags.KubeconfigMode, "kubeconfig-mode", "", "How the Kubeconfig authenticates to the cluster, one of 'embedded', 'oidc' or 'proxy' (the last two require the kubelogin kubectl plugin). Defaults to the mode of the server")
+	cmd.Flags().StringVarP(&clustersGetCmdFlags.ClusterSelector, "selector", "l", "", "Select clusters by the labels of their GitopsCluster, e.g. -l env=prod,region=eu-west")
+	cmd.Flags().BoolVar(&clustersGetCmdFlags.Inventory, "inventory", false, "Export the inventory of the clusters, with their provider, Kubernetes version, node pools, installed profiles, owners and template")
+	cmd.Flags().StringVarP(&clustersGetCmdFlags.Output, "output", "o", clusters.OutputJSON, "Output format of the inventory, one of json or csv")
 
 	return cmd
 }
@@ -69,13 +86,21 @@ func getClusterCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*
 				return errors.New("cluster name is required")
 			}
 
-			return clusters.GetClusterKubeconfig(args[0], client, os.Stdout)
+			return clusters.GetClusterKubeconfig(args[0], clustersGetCmdFlags.KubeconfigMode, client, os.Stdout)
+		}
+
+		if clustersGetCmdFlags.Inventory {
+			if len(args) > 0 {
+				return errors.New("the inventory is exported for all clusters, select them with --selector instead")
+			}
+
+			return clusters.GetClusterInventory(client, clustersGetCmdFlags.ClusterSelector, clustersGetCmdFlags.Output, os.Stdout)
 		}
 
 		if len(args) == 1 {
 			return clusters.GetClusterByName(args[0], client, w)
 		}
 
-		return clusters.GetClusters(client, w)
+		return clusters.GetClusters(client, clustersGetCmdFlags.ClusterSelector, w)
 	}
 }
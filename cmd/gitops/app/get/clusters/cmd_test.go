@@ -32,6 +32,28 @@ func TestGetCluster(t *testing.T) {
 				"--endpoint", "http://localhost:8000",
 			},
 		},
+		{
+			name:     "cluster inventory",
+			url:      "http://localhost:8000/v1/cluster-inventory",
+			status:   http.StatusOK,
+			response: httpmock.File("../../../pkg/adapters/testdata/cluster_inventory.json"),
+			args: []string{
+				"get", "clusters",
+				"--inventory",
+				"-o", "csv",
+				"--endpoint", "http://localhost:8000",
+			},
+		},
+		{
+			name: "cluster inventory of a single cluster",
+			args: []string{
+				"get", "cluster",
+				"dev-cluster",
+				"--inventory",
+				"--endpoint", "http://localhost:8000",
+			},
+			errString: "the inventory is exported for all clusters, select them with --selector instead",
+		},
 		{
 			name: "http error",
 			args: []string{
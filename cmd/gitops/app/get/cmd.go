@@ -8,6 +8,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/get/profiles"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/get/templates"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/get/templates/terraform"
+	tfobjects "github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/get/terraform"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/bcrypt"
@@ -26,7 +27,16 @@ gitops get templates
 gitops get credentials
 
 # Get all CAPI clusters
-gitops get clusters`,
+gitops get clusters
+
+# Get the Terraform objects of all clusters
+gitops get terraform -A
+
+# Get the plan of a Terraform object
+gitops get terraform-plan <name>
+
+# Get the drifted Terraform objects of all clusters
+gitops get terraform --drifted -A`,
 	}
 
 	templateCommand := templates.GetCommand(opts, client)
@@ -37,6 +47,8 @@ gitops get clusters`,
 	cmd.AddCommand(credentials.GetCommand(opts, client))
 	cmd.AddCommand(clusters.GetCommand(opts, client))
 	cmd.AddCommand(profiles.GetCommand(opts, client))
+	cmd.AddCommand(tfobjects.GetCommand(opts, client))
+	cmd.AddCommand(tfobjects.GetPlanCommand(opts, client))
 	cmd.AddCommand(bcrypt.HashCommand(opts))
 	cmd.AddCommand(configCmd.ConfigCommand(opts))
 
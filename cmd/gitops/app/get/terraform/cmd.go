@@ -0,0 +1,95 @@
+package terraform
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+type terraformGetFlags struct {
+	Drifted         bool
+	Clusters        []string
+	AllNamespaces   bool
+	LabelSelector   string
+	ClusterSelector string
+	Output          string
+}
+
+var terraformGetCmdFlags terraformGetFlags
+
+func GetCommand(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform",
+		Short: "Display Terraform objects",
+		Example: `
+# Get the Terraform objects in the flux-system namespace of all clusters
+gitops get terraform
+
+# Get the Terraform objects of the management cluster labelled team=infra
+gitops get terraform --cluster management -A -l team=infra
+
+# Get the drifted Terraform objects of all clusters
+gitops get terraform --drifted -A
+
+# Export the drifted Terraform objects of a cluster as CSV
+gitops get terraform --drifted -A --cluster management --output csv`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       getTerraformCmdPreRunE(&opts.Endpoint),
+		RunE:          getTerraformCmdRunE(opts, client),
+		Args:          cobra.NoArgs,
+	}
+
+	cmd.Flags().BoolVar(&terraformGetCmdFlags.Drifted, "drifted", false, "Report the drifted Terraform objects, with the resources drifting and for how long")
+	cmd.Flags().StringVarP(&terraformGetCmdFlags.Output, "output", "o", terraform.OutputTable, "Output format, one of table, csv or json")
+	internal.AddTerraformSelectorFlags(cmd, &terraformGetCmdFlags.Clusters, &terraformGetCmdFlags.AllNamespaces, &terraformGetCmdFlags.LabelSelector, &terraformGetCmdFlags.ClusterSelector)
+
+	return cmd
+}
+
+func getTerraformCmdPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(c *cobra.Command, s []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoWGEEndpoint
+		}
+
+		return nil
+	}
+}
+
+func getTerraformCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		w := printers.GetNewTabWriter(os.Stdout)
+		defer w.Flush()
+
+		selector := terraform.Selector{
+			Clusters:        terraformGetCmdFlags.Clusters,
+			Namespace:       namespace,
+			AllNamespaces:   terraformGetCmdFlags.AllNamespaces,
+			LabelSelector:   terraformGetCmdFlags.LabelSelector,
+			ClusterSelector: terraformGetCmdFlags.ClusterSelector,
+		}
+
+		if terraformGetCmdFlags.Drifted {
+			return terraform.GetDriftReport(selector, terraformGetCmdFlags.Output, client, w)
+		}
+
+		return terraform.GetObjects(selector, terraformGetCmdFlags.Output, client, w)
+	}
+}
@@ -0,0 +1,100 @@
+package terraform_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/root"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+)
+
+func TestGetTerraform(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		status    int
+		response  interface{}
+		args      []string
+		errString string
+	}{
+		{
+			name:     "drift report",
+			url:      "http://localhost:8000/v1/terraform-objects/drift-report",
+			status:   http.StatusOK,
+			response: httpmock.File("../../../pkg/adapters/testdata/terraform_drift_report.json"),
+			args: []string{
+				"get", "terraform",
+				"--drifted",
+				"--output", "csv",
+				"--endpoint", "http://localhost:8000",
+			},
+		},
+		{
+			name:     "objects",
+			url:      "http://localhost:8000/v1/terraform-objects",
+			status:   http.StatusOK,
+			response: httpmock.File("../../../pkg/adapters/testdata/terraform_objects.json"),
+			args: []string{
+				"get", "terraform",
+				"-A",
+				"-l", "team=infra",
+				"--endpoint", "http://localhost:8000",
+			},
+		},
+		{
+			name:     "plan",
+			url:      "http://localhost:8000/v1/namespaces/flux-system/terraform-objects/vpc/plan?clusterName=management",
+			status:   http.StatusOK,
+			response: httpmock.File("../../../pkg/adapters/testdata/terraform_object_plan.json"),
+			args: []string{
+				"get", "terraform-plan", "vpc",
+				"--endpoint", "http://localhost:8000",
+			},
+		},
+		{
+			name: "drift report with label selector",
+			args: []string{
+				"get", "terraform",
+				"--drifted",
+				"-l", "team=infra",
+				"--endpoint", "http://localhost:8000",
+			},
+			errString: "label selectors are not supported by the drift report",
+		},
+		{
+			name: "no endpoint",
+			args: []string{
+				"get", "terraform",
+				"--drifted",
+			},
+			errString: "the Weave GitOps Enterprise HTTP API endpoint flag (--endpoint) has not been set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := adapters.NewHTTPClient()
+			httpmock.ActivateNonDefault(client.GetBaseClient())
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder(
+				http.MethodGet,
+				tt.url,
+				func(r *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, tt.response)
+				},
+			)
+
+			cmd := root.Command(client)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.errString == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errString)
+			}
+		})
+	}
+}
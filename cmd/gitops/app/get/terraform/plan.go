@@ -0,0 +1,66 @@
+package terraform
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"k8s.io/cli-runtime/pkg/printers"
+)
+
+type terraformPlanGetFlags struct {
+	ClusterName string
+	Output      string
+}
+
+var terraformPlanGetCmdFlags terraformPlanGetFlags
+
+func GetPlanCommand(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform-plan",
+		Short: "Display the plan of a Terraform object",
+		Example: `
+# Get the plan of a Terraform object of the management cluster
+gitops get terraform-plan <name> --namespace flux-system
+
+# Get the plan of a Terraform object of a leaf cluster as JSON
+gitops get terraform-plan <name> --cluster <cluster-namespace>/<cluster-name> -o json`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       getTerraformCmdPreRunE(&opts.Endpoint),
+		RunE:          getTerraformPlanCmdRunE(opts, client),
+		Args:          cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().StringVar(&terraformPlanGetCmdFlags.ClusterName, "cluster", terraform.DefaultClusterName, "The cluster of the Terraform object")
+	cmd.Flags().StringVarP(&terraformPlanGetCmdFlags.Output, "output", "o", terraform.OutputTable, "Output format, one of table or json")
+
+	return cmd
+}
+
+func getTerraformPlanCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		w := printers.GetNewTabWriter(os.Stdout)
+		defer w.Flush()
+
+		ref := terraform.ObjectRef{
+			ClusterName: terraformPlanGetCmdFlags.ClusterName,
+			Namespace:   namespace,
+			Name:        args[0],
+		}
+
+		return terraform.GetPlan(ref, terraformPlanGetCmdFlags.Output, client, w)
+	}
+}
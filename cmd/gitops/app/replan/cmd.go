@@ -0,0 +1,22 @@
+package replan
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/replan/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replan",
+		Short: "Request new plans of Weave GitOps resources",
+		Example: `
+# Request a new plan for a Terraform object and wait for it
+gitops replan terraform <name> --wait`,
+	}
+
+	cmd.AddCommand(terraform.Command(opts, client))
+
+	return cmd
+}
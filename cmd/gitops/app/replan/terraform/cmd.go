@@ -0,0 +1,111 @@
+package terraform
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+type terraformReplanFlags struct {
+	Clusters        []string
+	AllNamespaces   bool
+	LabelSelector   string
+	ClusterSelector string
+	Wait            bool
+	Timeout         time.Duration
+}
+
+var terraformReplanCmdFlags terraformReplanFlags
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform [name...]",
+		Short: "Request new plans for Terraform objects",
+		Example: `
+# Request a new plan for a Terraform object of the management cluster and wait until it is planned
+gitops replan terraform <name> --namespace flux-system --wait
+
+# Request new plans for the Terraform objects labelled team=infra of a leaf cluster
+gitops replan terraform --cluster <cluster-namespace>/<cluster-name> -A -l team=infra`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       replanTerraformCmdPreRunE(&opts.Endpoint),
+		RunE:          replanTerraformCmdRunE(opts, client),
+	}
+
+	internal.AddTerraformSelectorFlags(cmd, &terraformReplanCmdFlags.Clusters, &terraformReplanCmdFlags.AllNamespaces, &terraformReplanCmdFlags.LabelSelector, &terraformReplanCmdFlags.ClusterSelector)
+	internal.AddWaitFlags(cmd, &terraformReplanCmdFlags.Wait, &terraformReplanCmdFlags.Timeout)
+
+	return cmd
+}
+
+func replanTerraformCmdPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(c *cobra.Command, s []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoWGEEndpoint
+		}
+
+		return nil
+	}
+}
+
+func replanTerraformCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		selector := terraform.Selector{
+			Clusters:        terraformReplanCmdFlags.Clusters,
+			Namespace:       namespace,
+			AllNamespaces:   terraformReplanCmdFlags.AllNamespaces,
+			LabelSelector:   terraformReplanCmdFlags.LabelSelector,
+			ClusterSelector: terraformReplanCmdFlags.ClusterSelector,
+		}
+
+		refs, err := terraform.ResolveObjectRefs(args, selector, client)
+		if err != nil {
+			return err
+		}
+
+		var since map[terraform.ObjectRef]string
+
+		// Record when the objects were last reconciled to wait for the
+		// reconciliation producing the new plans, which may then be pending
+		// approval rather than applied.
+		if terraformReplanCmdFlags.Wait {
+			since, err = terraform.LastUpdated(refs, client)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := terraform.ReplanObjects(refs, client, os.Stdout); err != nil {
+			return err
+		}
+
+		if !terraformReplanCmdFlags.Wait {
+			return nil
+		}
+
+		waitOpts := terraform.WaitOptions{
+			Timeout:       terraformReplanCmdFlags.Timeout,
+			Interval:      terraform.DefaultWaitInterval,
+			ConditionType: terraform.ConditionPlan,
+		}
+
+		return terraform.WaitForReady(refs, since, waitOpts, client, os.Stdout)
+	}
+}
@@ -0,0 +1,22 @@
+package resume
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/resume/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume the reconciliation of Weave GitOps resources",
+		Example: `
+# Resume a Terraform object of the management cluster
+gitops resume terraform <name>`,
+	}
+
+	cmd.AddCommand(terraform.Command(opts, client))
+
+	return cmd
+}
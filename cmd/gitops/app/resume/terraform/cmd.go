@@ -0,0 +1,95 @@
+package terraform
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+type terraformResumeFlags struct {
+	Clusters        []string
+	AllNamespaces   bool
+	LabelSelector   string
+	ClusterSelector string
+	Wait            bool
+	Timeout         time.Duration
+}
+
+var terraformResumeCmdFlags terraformResumeFlags
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform [name...]",
+		Short: "Resume the reconciliation of Terraform objects",
+		Example: `
+# Resume a Terraform object of the management cluster and wait for it to be ready
+gitops resume terraform <name> --namespace flux-system --wait
+
+# Resume the Terraform objects labelled team=infra of all clusters
+gitops resume terraform -A -l team=infra`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       resumeTerraformCmdPreRunE(&opts.Endpoint),
+		RunE:          resumeTerraformCmdRunE(opts, client),
+	}
+
+	internal.AddTerraformSelectorFlags(cmd, &terraformResumeCmdFlags.Clusters, &terraformResumeCmdFlags.AllNamespaces, &terraformResumeCmdFlags.LabelSelector, &terraformResumeCmdFlags.ClusterSelector)
+	internal.AddWaitFlags(cmd, &terraformResumeCmdFlags.Wait, &terraformResumeCmdFlags.Timeout)
+
+	return cmd
+}
+
+func resumeTerraformCmdPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(c *cobra.Command, s []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoWGEEndpoint
+		}
+
+		return nil
+	}
+}
+
+func resumeTerraformCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		selector := terraform.Selector{
+			Clusters:        terraformResumeCmdFlags.Clusters,
+			Namespace:       namespace,
+			AllNamespaces:   terraformResumeCmdFlags.AllNamespaces,
+			LabelSelector:   terraformResumeCmdFlags.LabelSelector,
+			ClusterSelector: terraformResumeCmdFlags.ClusterSelector,
+		}
+
+		refs, err := terraform.ResolveObjectRefs(args, selector, client)
+		if err != nil {
+			return err
+		}
+
+		if err := terraform.SuspendObjects(refs, false, client, os.Stdout); err != nil {
+			return err
+		}
+
+		if !terraformResumeCmdFlags.Wait {
+			return nil
+		}
+
+		waitOpts := terraform.WaitOptions{Timeout: terraformResumeCmdFlags.Timeout, Interval: terraform.DefaultWaitInterval}
+
+		return terraform.WaitForReady(refs, nil, waitOpts, client, os.Stdout)
+	}
+}
@@ -17,6 +17,10 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/disconnect"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/generate"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/get"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/replan"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/resume"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/suspend"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/sync"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/update"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/upgrade"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
@@ -132,6 +136,10 @@ func Command(client *adapters.HTTPClient) *cobra.Command {
 	rootCmd.AddCommand(create.Command())
 	rootCmd.AddCommand(update.Command(options, client))
 	rootCmd.AddCommand(delete.Command(options, client))
+	rootCmd.AddCommand(sync.Command(options, client))
+	rootCmd.AddCommand(suspend.Command(options, client))
+	rootCmd.AddCommand(resume.Command(options, client))
+	rootCmd.AddCommand(replan.Command(options, client))
 	rootCmd.AddCommand(upgrade.Cmd)
 	rootCmd.AddCommand(docs.Cmd)
 	rootCmd.AddCommand(check.GetCommand(options))
@@ -0,0 +1,22 @@
+package suspend
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/suspend/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suspend",
+		Short: "Suspend the reconciliation of Weave GitOps resources",
+		Example: `
+# Suspend the Terraform objects labelled team=infra of all clusters
+gitops suspend terraform -A -l team=infra`,
+	}
+
+	cmd.AddCommand(terraform.Command(opts, client))
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+package terraform
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+type terraformSuspendFlags struct {
+	Clusters        []string
+	AllNamespaces   bool
+	LabelSelector   string
+	ClusterSelector string
+}
+
+var terraformSuspendCmdFlags terraformSuspendFlags
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform [name...]",
+		Short: "Suspend the reconciliation of Terraform objects",
+		Example: `
+# Suspend a Terraform object of the management cluster
+gitops suspend terraform <name> --namespace flux-system
+
+# Suspend the Terraform objects labelled team=infra of all clusters
+gitops suspend terraform -A -l team=infra`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       suspendTerraformCmdPreRunE(&opts.Endpoint),
+		RunE:          suspendTerraformCmdRunE(opts, client),
+	}
+
+	internal.AddTerraformSelectorFlags(cmd, &terraformSuspendCmdFlags.Clusters, &terraformSuspendCmdFlags.AllNamespaces, &terraformSuspendCmdFlags.LabelSelector, &terraformSuspendCmdFlags.ClusterSelector)
+
+	return cmd
+}
+
+func suspendTerraformCmdPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(c *cobra.Command, s []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoWGEEndpoint
+		}
+
+		return nil
+	}
+}
+
+func suspendTerraformCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		selector := terraform.Selector{
+			Clusters:        terraformSuspendCmdFlags.Clusters,
+			Namespace:       namespace,
+			AllNamespaces:   terraformSuspendCmdFlags.AllNamespaces,
+			LabelSelector:   terraformSuspendCmdFlags.LabelSelector,
+			ClusterSelector: terraformSuspendCmdFlags.ClusterSelector,
+		}
+
+		refs, err := terraform.ResolveObjectRefs(args, selector, client)
+		if err != nil {
+			return err
+		}
+
+		return terraform.SuspendObjects(refs, true, client, os.Stdout)
+	}
+}
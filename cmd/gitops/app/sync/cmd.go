@@ -0,0 +1,22 @@
+package sync
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/sync/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Trigger the reconciliation of Weave GitOps resources",
+		Example: `
+# Sync a Terraform object of the management cluster and wait for it to be ready
+gitops sync terraform <name> --wait`,
+	}
+
+	cmd.AddCommand(terraform.Command(opts, client))
+
+	return cmd
+}
@@ -0,0 +1,101 @@
+package terraform
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/internal"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+)
+
+type terraformSyncFlags struct {
+	Clusters        []string
+	AllNamespaces   bool
+	LabelSelector   string
+	ClusterSelector string
+	Wait            bool
+	Timeout         time.Duration
+}
+
+var terraformSyncCmdFlags terraformSyncFlags
+
+func Command(opts *config.Options, client *adapters.HTTPClient) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terraform [name...]",
+		Short: "Trigger the reconciliation of Terraform objects",
+		Example: `
+# Sync a Terraform object of the management cluster
+gitops sync terraform <name> --namespace flux-system
+
+# Sync a Terraform object on several clusters and wait for them to be ready
+gitops sync terraform <name> --cluster management --cluster <cluster-namespace>/<cluster-name> --wait
+
+# Sync the Terraform objects labelled team=infra of all clusters
+gitops sync terraform -A -l team=infra
+
+# Sync the Terraform objects of the production clusters in the eu-west region
+gitops sync terraform -A --cluster-selector env=prod,region=eu-west`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PreRunE:       syncTerraformCmdPreRunE(&opts.Endpoint),
+		RunE:          syncTerraformCmdRunE(opts, client),
+	}
+
+	internal.AddTerraformSelectorFlags(cmd, &terraformSyncCmdFlags.Clusters, &terraformSyncCmdFlags.AllNamespaces, &terraformSyncCmdFlags.LabelSelector, &terraformSyncCmdFlags.ClusterSelector)
+	internal.AddWaitFlags(cmd, &terraformSyncCmdFlags.Wait, &terraformSyncCmdFlags.Timeout)
+
+	return cmd
+}
+
+func syncTerraformCmdPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(c *cobra.Command, s []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoWGEEndpoint
+		}
+
+		return nil
+	}
+}
+
+func syncTerraformCmdRunE(opts *config.Options, client *adapters.HTTPClient) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := client.ConfigureClientWithOptions(opts, os.Stdout)
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		selector := terraform.Selector{
+			Clusters:        terraformSyncCmdFlags.Clusters,
+			Namespace:       namespace,
+			AllNamespaces:   terraformSyncCmdFlags.AllNamespaces,
+			LabelSelector:   terraformSyncCmdFlags.LabelSelector,
+			ClusterSelector: terraformSyncCmdFlags.ClusterSelector,
+		}
+
+		refs, err := terraform.ResolveObjectRefs(args, selector, client)
+		if err != nil {
+			return err
+		}
+
+		if err := terraform.SyncObjects(refs, client, os.Stdout); err != nil {
+			return err
+		}
+
+		if !terraformSyncCmdFlags.Wait {
+			return nil
+		}
+
+		waitOpts := terraform.WaitOptions{Timeout: terraformSyncCmdFlags.Timeout, Interval: terraform.DefaultWaitInterval}
+
+		return terraform.WaitForReady(refs, nil, waitOpts, client, os.Stdout)
+	}
+}
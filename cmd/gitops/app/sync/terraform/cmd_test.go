@@ -0,0 +1,106 @@
+package terraform_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/app/root"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
+)
+
+func TestSyncTerraform(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		args      []string
+		errString string
+	}{
+		{
+			name:   "sync by name",
+			status: http.StatusOK,
+			args: []string{
+				"sync", "terraform", "vpc",
+				"--endpoint", "http://localhost:8000",
+			},
+		},
+		{
+			name:   "sync and wait",
+			status: http.StatusOK,
+			args: []string{
+				"sync", "terraform",
+				"-A",
+				"-l", "team=infra",
+				"--wait",
+				"--endpoint", "http://localhost:8000",
+			},
+		},
+		{
+			name:   "sync fails",
+			status: http.StatusInternalServerError,
+			args: []string{
+				"sync", "terraform", "vpc",
+				"--endpoint", "http://localhost:8000",
+			},
+			errString: "unable to sync terraform objects: response status for PATCH \"http://localhost:8000/v1/terraform-objects/sync\" was 500",
+		},
+		{
+			name: "names and label selector",
+			args: []string{
+				"sync", "terraform", "vpc",
+				"-l", "team=infra",
+				"--endpoint", "http://localhost:8000",
+			},
+			errString: "object names and label selectors are mutually exclusive",
+		},
+		{
+			name: "no endpoint",
+			args: []string{
+				"sync", "terraform", "vpc",
+			},
+			errString: "the Weave GitOps Enterprise HTTP API endpoint flag (--endpoint) has not been set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := adapters.NewHTTPClient()
+			httpmock.ActivateNonDefault(client.GetBaseClient())
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder(
+				http.MethodPatch,
+				"http://localhost:8000/v1/terraform-objects/sync",
+				func(r *http.Request) (*http.Response, error) {
+					return httpmock.NewJsonResponse(tt.status, map[string]interface{}{})
+				},
+			)
+			httpmock.RegisterResponder(
+				http.MethodGet,
+				"http://localhost:8000/v1/terraform-objects",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, httpmock.File("../../../pkg/adapters/testdata/terraform_objects.json")),
+			)
+			httpmock.RegisterResponder(
+				http.MethodGet,
+				"http://localhost:8000/v1/namespaces/flux-system/terraform-objects/vpc?clusterName=management",
+				httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]interface{}{
+					"object": map[string]interface{}{
+						"name":       "vpc",
+						"namespace":  "flux-system",
+						"conditions": []map[string]string{{"type": "Ready", "status": "True"}},
+					},
+				}),
+			)
+
+			cmd := root.Command(client)
+			cmd.SetArgs(tt.args)
+
+			err := cmd.Execute()
+			if tt.errString == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errString)
+			}
+		})
+	}
+}
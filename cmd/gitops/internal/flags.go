@@ -1,6 +1,10 @@
 package internal
 
-import "github.com/spf13/cobra"
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+)
 
 func AddPRFlags(cmd *cobra.Command, headBranch, baseBranch, description, message, title *string) {
 	cmd.Flags().StringVar(headBranch, "branch", "", "The branch to create the pull request from")
@@ -15,3 +19,15 @@ func AddTemplateFlags(cmd *cobra.Command, template, templateNamespace *string, p
 	cmd.Flags().StringVar(templateNamespace, "template-namespace", "default", "Specify the namespace of the template")
 	cmd.Flags().StringSliceVar(parameterValues, "set", []string{}, "Set parameter values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 }
+
+func AddTerraformSelectorFlags(cmd *cobra.Command, clusters *[]string, allNamespaces *bool, labelSelector *string, clusterSelector *string) {
+	cmd.Flags().StringSliceVar(clusters, "cluster", []string{}, "Select Terraform objects of these clusters (can specify multiple), all clusters if not set and no names are given")
+	cmd.Flags().BoolVarP(allNamespaces, "all-namespaces", "A", false, "Select Terraform objects in all namespaces")
+	cmd.Flags().StringVarP(labelSelector, "selector", "l", "", "Select Terraform objects by label, e.g. -l team=infra")
+	cmd.Flags().StringVar(clusterSelector, "cluster-selector", "", "Select Terraform objects of the clusters whose GitopsCluster labels match, e.g. --cluster-selector env=prod,region=eu-west")
+}
+
+func AddWaitFlags(cmd *cobra.Command, wait *bool, timeout *time.Duration) {
+	cmd.Flags().BoolVar(wait, "wait", false, "Wait for the Terraform objects to be reconciled")
+	cmd.Flags().DurationVar(timeout, "timeout", 5*time.Minute, "How long to wait for the Terraform objects to be reconciled")
+}
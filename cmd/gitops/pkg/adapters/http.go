@@ -17,6 +17,7 @@ import (
 
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/clusters"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/templates"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/services/profiles"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	kubecfg "sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -537,7 +538,7 @@ func (c *HTTPClient) RetrieveCredentialsByName(name string) (templates.Credentia
 }
 
 // RetrieveClusters returns the list of all clusters from the cluster service.
-func (c *HTTPClient) RetrieveClusters() ([]clusters.Cluster, error) {
+func (c *HTTPClient) RetrieveClusters(clusterSelector string) ([]clusters.Cluster, error) {
 	endpoint := "/v1/clusters"
 
 	type ClusterView struct {
@@ -549,9 +550,15 @@ func (c *HTTPClient) RetrieveClusters() ([]clusters.Cluster, error) {
 		Clusters []ClusterView `json:"gitopsClusters"`
 	}
 
+	queryParams := map[string]string{}
+	if clusterSelector != "" {
+		queryParams["clusterSelector"] = clusterSelector
+	}
+
 	var clustersResponse ClustersResponse
 	res, err := c.client.R().
 		SetHeader("Accept", "application/json").
+		SetQueryParams(queryParams).
 		SetResult(&clustersResponse).
 		Get(endpoint)
 
@@ -584,7 +591,36 @@ func (c *HTTPClient) RetrieveClusters() ([]clusters.Cluster, error) {
 	return cs, nil
 }
 
-func (c *HTTPClient) GetClusterKubeconfig(name string) (string, error) {
+// RetrieveClusterInventory returns the inventory of the clusters from the cluster service.
+func (c *HTTPClient) RetrieveClusterInventory(clusterSelector string) (*clusters.Inventory, error) {
+	endpoint := "/v1/cluster-inventory"
+
+	queryParams := map[string]string{}
+	if clusterSelector != "" {
+		queryParams["clusterSelector"] = clusterSelector
+	}
+
+	var inventory clusters.Inventory
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetQueryParams(queryParams).
+		SetResult(&inventory).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to GET the cluster inventory from %q: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("response status for GET %q was %d", res.Request.URL, res.StatusCode())
+	}
+
+	return &inventory, nil
+}
+
+// GetClusterKubeconfig returns the kubeconfig of a cluster, in the default mode of the server
+// if the mode is empty.
+func (c *HTTPClient) GetClusterKubeconfig(name, mode string) (string, error) {
 	endpoint := "v1/namespaces/{namespace}/clusters/{name}/kubeconfig"
 
 	type GetKubeconfigResponse struct {
@@ -592,14 +628,18 @@ func (c *HTTPClient) GetClusterKubeconfig(name string) (string, error) {
 	}
 
 	var result GetKubeconfigResponse
-	res, err := c.client.R().
+	req := c.client.R().
 		SetHeader("Accept", "application/json").
 		SetPathParams(map[string]string{
 			"name":      name,
 			"namespace": "default",
 		}).
-		SetResult(&result).
-		Get(endpoint)
+		SetResult(&result)
+	if mode != "" {
+		req.SetQueryParam("mode", mode)
+	}
+
+	res, err := req.Get(endpoint)
 
 	if err != nil {
 		return "", fmt.Errorf("unable to GET cluster kubeconfig from %q: %w", res.Request.URL, err)
@@ -761,3 +801,304 @@ func (c *HTTPClient) RetrieveTemplateProfiles(name string, namespace string) ([]
 
 	return tps, nil
 }
+
+// RetrieveTerraformDriftReport returns the drifted Terraform objects of all
+// clusters.
+func (c *HTTPClient) RetrieveTerraformDriftReport(params terraform.DriftReportParams) (*terraform.DriftReport, error) {
+	endpoint := "/v1/terraform-objects/drift-report"
+
+	type DriftReportEntryView struct {
+		ClusterName         string   `json:"clusterName"`
+		Namespace           string   `json:"namespace"`
+		Name                string   `json:"name"`
+		DriftingSince       string   `json:"driftingSince"`
+		DriftingForSeconds  int64    `json:"driftingForSeconds,string"`
+		LastDriftDetectedAt string   `json:"lastDriftDetectedAt"`
+		PlanID              string   `json:"planId"`
+		DriftedResources    []string `json:"driftedResources"`
+	}
+
+	type DriftReportResponse struct {
+		Entries     []DriftReportEntryView `json:"entries"`
+		Errors      []terraform.ListError  `json:"errors"`
+		GeneratedAt string                 `json:"generatedAt"`
+	}
+
+	queryParams := map[string]string{}
+	if params.ClusterName != "" {
+		queryParams["clusterName"] = params.ClusterName
+	}
+
+	if params.Namespace != "" {
+		queryParams["namespace"] = params.Namespace
+	}
+
+	if params.ClusterSelector != "" {
+		queryParams["clusterSelector"] = params.ClusterSelector
+	}
+
+	var result DriftReportResponse
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetQueryParams(queryParams).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to GET terraform drift report from %q: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("response status for GET %q was %d", res.Request.URL, res.StatusCode())
+	}
+
+	report := &terraform.DriftReport{
+		GeneratedAt: result.GeneratedAt,
+		Entries:     []terraform.DriftReportEntry{},
+		Errors:      result.Errors,
+	}
+
+	for _, e := range result.Entries {
+		report.Entries = append(report.Entries, terraform.DriftReportEntry{
+			ClusterName:         e.ClusterName,
+			Namespace:           e.Namespace,
+			Name:                e.Name,
+			DriftingSince:       e.DriftingSince,
+			DriftingForSeconds:  e.DriftingForSeconds,
+			LastDriftDetectedAt: e.LastDriftDetectedAt,
+			PlanID:              e.PlanID,
+			DriftedResources:    e.DriftedResources,
+		})
+	}
+
+	return report, nil
+}
+
+type terraformObjectView struct {
+	Name                 string                `json:"name"`
+	Namespace            string                `json:"namespace"`
+	ClusterName          string                `json:"clusterName"`
+	AppliedRevision      string                `json:"appliedRevision"`
+	LastUpdatedAt        string                `json:"lastUpdatedAt"`
+	DriftDetectionResult bool                  `json:"driftDetectionResult"`
+	Suspended            bool                  `json:"suspended"`
+	Labels               map[string]string     `json:"labels"`
+	Conditions           []terraform.Condition `json:"conditions"`
+}
+
+func (v terraformObjectView) toObject() terraform.Object {
+	return terraform.Object{
+		ClusterName:     v.ClusterName,
+		Namespace:       v.Namespace,
+		Name:            v.Name,
+		AppliedRevision: v.AppliedRevision,
+		LastUpdatedAt:   v.LastUpdatedAt,
+		Drifted:         v.DriftDetectionResult,
+		Suspended:       v.Suspended,
+		Labels:          v.Labels,
+		Conditions:      v.Conditions,
+	}
+}
+
+// RetrieveTerraformObjects returns the Terraform objects of all clusters in
+// a namespace, or in all namespaces if empty.
+func (c *HTTPClient) RetrieveTerraformObjects(namespace, clusterSelector string) ([]terraform.Object, []terraform.ListError, error) {
+	endpoint := "/v1/terraform-objects"
+
+	type ListTerraformObjectsResponse struct {
+		Objects []terraformObjectView `json:"objects"`
+		Errors  []terraform.ListError `json:"errors"`
+	}
+
+	queryParams := map[string]string{}
+	if namespace != "" {
+		queryParams["namespace"] = namespace
+	}
+
+	if clusterSelector != "" {
+		queryParams["clusterSelector"] = clusterSelector
+	}
+
+	var result ListTerraformObjectsResponse
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetQueryParams(queryParams).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to GET terraform objects from %q: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, nil, fmt.Errorf("response status for GET %q was %d", res.Request.URL, res.StatusCode())
+	}
+
+	objects := []terraform.Object{}
+	for _, o := range result.Objects {
+		objects = append(objects, o.toObject())
+	}
+
+	return objects, result.Errors, nil
+}
+
+// RetrieveTerraformObject returns a Terraform object.
+func (c *HTTPClient) RetrieveTerraformObject(ref terraform.ObjectRef) (*terraform.Object, error) {
+	endpoint := "/v1/namespaces/{namespace}/terraform-objects/{name}"
+
+	type GetTerraformObjectResponse struct {
+		Object terraformObjectView `json:"object"`
+	}
+
+	var result GetTerraformObjectResponse
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetPathParams(map[string]string{
+			"name":      ref.Name,
+			"namespace": ref.Namespace,
+		}).
+		SetQueryParam("clusterName", ref.ClusterName).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to GET terraform object from %q: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("response status for GET %q was %d", res.Request.URL, res.StatusCode())
+	}
+
+	o := result.Object.toObject()
+
+	return &o, nil
+}
+
+// RetrieveTerraformObjectPlan returns the plan of a Terraform object.
+func (c *HTTPClient) RetrieveTerraformObjectPlan(ref terraform.ObjectRef) (*terraform.Plan, error) {
+	endpoint := "/v1/namespaces/{namespace}/terraform-objects/{name}/plan"
+
+	type StructuredPlanView struct {
+		PlanID          string                         `json:"planId"`
+		Summary         terraform.PlanSummary          `json:"summary"`
+		ResourceChanges []terraform.PlanResourceChange `json:"resourceChanges"`
+	}
+
+	type GetTerraformObjectPlanResponse struct {
+		Plan           string              `json:"plan"`
+		Error          string              `json:"error"`
+		StructuredPlan *StructuredPlanView `json:"structuredPlan"`
+	}
+
+	var result GetTerraformObjectPlanResponse
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetPathParams(map[string]string{
+			"name":      ref.Name,
+			"namespace": ref.Namespace,
+		}).
+		SetQueryParam("clusterName", ref.ClusterName).
+		SetResult(&result).
+		Get(endpoint)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to GET terraform plan from %q: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("response status for GET %q was %d", res.Request.URL, res.StatusCode())
+	}
+
+	plan := &terraform.Plan{
+		Plan:  result.Plan,
+		Error: result.Error,
+	}
+
+	if result.StructuredPlan != nil {
+		plan.PlanID = result.StructuredPlan.PlanID
+		plan.Summary = &result.StructuredPlan.Summary
+		plan.ResourceChanges = result.StructuredPlan.ResourceChanges
+	}
+
+	return plan, nil
+}
+
+// SyncTerraformObjects requests the reconciliation of Terraform objects and
+// waits for them to be reconciled.
+func (c *HTTPClient) SyncTerraformObjects(refs []terraform.ObjectRef) error {
+	endpoint := "/v1/terraform-objects/sync"
+
+	type SyncTerraformObjectsRequest struct {
+		Objects []terraform.ObjectRef `json:"objects"`
+	}
+
+	var serviceErr *ServiceError
+
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetBody(SyncTerraformObjectsRequest{Objects: refs}).
+		SetError(&serviceErr).
+		Patch(endpoint)
+
+	return terraformRequestError(res, err, serviceErr)
+}
+
+// ToggleSuspendTerraformObjects suspends or resumes the reconciliation of
+// Terraform objects.
+func (c *HTTPClient) ToggleSuspendTerraformObjects(refs []terraform.ObjectRef, suspend bool) error {
+	endpoint := "/v1/terraform-objects/suspend"
+
+	type ToggleSuspendTerraformObjectsRequest struct {
+		Objects []terraform.ObjectRef `json:"objects"`
+		Suspend bool                  `json:"suspend"`
+	}
+
+	var serviceErr *ServiceError
+
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetBody(ToggleSuspendTerraformObjectsRequest{Objects: refs, Suspend: suspend}).
+		SetError(&serviceErr).
+		Patch(endpoint)
+
+	return terraformRequestError(res, err, serviceErr)
+}
+
+// ReplanTerraformObject requests a new plan for a Terraform object.
+func (c *HTTPClient) ReplanTerraformObject(ref terraform.ObjectRef) error {
+	endpoint := "/v1/namespaces/{namespace}/terraform-objects/{name}/replan"
+
+	type ReplanTerraformObjectRequest struct {
+		ClusterName string `json:"clusterName"`
+	}
+
+	var serviceErr *ServiceError
+
+	res, err := c.client.R().
+		SetHeader("Accept", "application/json").
+		SetPathParams(map[string]string{
+			"name":      ref.Name,
+			"namespace": ref.Namespace,
+		}).
+		SetBody(ReplanTerraformObjectRequest{ClusterName: ref.ClusterName}).
+		SetError(&serviceErr).
+		Post(endpoint)
+
+	return terraformRequestError(res, err, serviceErr)
+}
+
+func terraformRequestError(res *resty.Response, err error, serviceErr *ServiceError) error {
+	if serviceErr != nil && serviceErr.Message != "" {
+		return fmt.Errorf("request to %q failed: %s", res.Request.URL, serviceErr.Message)
+	}
+
+	if err != nil {
+		return fmt.Errorf("request to %q failed: %w", res.Request.URL, err)
+	}
+
+	if res.StatusCode() != http.StatusOK {
+		return fmt.Errorf("response status for %s %q was %d", res.Request.Method, res.Request.URL, res.StatusCode())
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/clusters"
 	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/templates"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/pkg/testutils"
 )
@@ -684,15 +685,84 @@ func TestRetrieveClusters(t *testing.T) {
 
 			err := client.ConfigureClientWithOptions(opts, os.Stdout)
 			assert.NoError(t, err)
-			cs, err := client.RetrieveClusters()
+			cs, err := client.RetrieveClusters("")
 			tt.assertFunc(t, cs, err)
 		})
 	}
 }
 
+func TestRetrieveClusterInventory(t *testing.T) {
+	tests := []struct {
+		name       string
+		responder  httpmock.Responder
+		assertFunc func(t *testing.T, inventory *clusters.Inventory, err error)
+	}{
+		{
+			name:      "inventory returned",
+			responder: httpmock.NewJsonResponderOrPanic(200, httpmock.File("./testdata/cluster_inventory.json")),
+			assertFunc: func(t *testing.T, inventory *clusters.Inventory, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &clusters.Inventory{
+					Clusters: []clusters.ClusterInventory{
+						{
+							ClusterName:       "default/dev",
+							Name:              "dev",
+							Namespace:         "default",
+							Labels:            map[string]string{"env": "dev"},
+							Provider:          "aws",
+							KubernetesVersion: "v1.28.2",
+							NodePools: []clusters.NodePool{
+								{Name: "dev-md-0", Replicas: 3, ReadyReplicas: 3, KubernetesVersion: "v1.28.2", InfrastructureKind: "AWSMachineTemplate"},
+							},
+							Profiles: []clusters.InstalledProfile{
+								{Name: "podinfo", Namespace: "flux-system", Chart: "podinfo", Version: "6.5.0", Status: "Success"},
+							},
+							Owners: []string{"team-a"},
+							Template: &clusters.TemplateRef{
+								Name:         "eks",
+								Namespace:    "default",
+								TemplateKind: "GitOpsTemplate",
+								Provider:     "aws",
+								Description:  "An EKS cluster",
+							},
+						},
+					},
+					Errors:     []clusters.ListError{{Message: "failed to get the installed profiles: the explorer is not enabled"}},
+					ExportedAt: "2023-10-02T10:00:00Z",
+				}, inventory)
+			},
+		},
+		{
+			name:      "unexpected status code",
+			responder: httpmock.NewStringResponder(http.StatusBadRequest, ""),
+			assertFunc: func(t *testing.T, inventory *clusters.Inventory, err error) {
+				assert.EqualError(t, err, "response status for GET \"https://weave.works/api/v1/cluster-inventory?clusterSelector=env%3Ddev\" was 400")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &config.Options{
+				Endpoint: testutils.BaseURI,
+			}
+			client := adapters.NewHTTPClient()
+			httpmock.ActivateNonDefault(client.GetBaseClient())
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("GET", testutils.BaseURI+"/v1/cluster-inventory?clusterSelector=env%3Ddev", tt.responder)
+
+			err := client.ConfigureClientWithOptions(opts, os.Stdout)
+			assert.NoError(t, err)
+			inventory, err := client.RetrieveClusterInventory("env=dev")
+			tt.assertFunc(t, inventory, err)
+		})
+	}
+}
+
 func TestGetClusterKubeconfig(t *testing.T) {
 	tests := []struct {
 		name       string
+		mode       string
 		responder  httpmock.Responder
 		assertFunc func(t *testing.T, s string, err error)
 	}{
@@ -724,6 +794,14 @@ func TestGetClusterKubeconfig(t *testing.T) {
 				assert.EqualError(t, err, "unable to base64 decode the cluster kubeconfig: illegal base64 data at input byte 3")
 			},
 		},
+		{
+			name:      "kubeconfig mode",
+			mode:      "oidc",
+			responder: httpmock.NewStringResponder(http.StatusBadRequest, ""),
+			assertFunc: func(t *testing.T, s string, err error) {
+				assert.EqualError(t, err, "response status for GET \"https://weave.works/api/v1/namespaces/default/clusters/dev/kubeconfig?mode=oidc\" was 400")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -738,7 +816,7 @@ func TestGetClusterKubeconfig(t *testing.T) {
 
 			err := client.ConfigureClientWithOptions(opts, os.Stdout)
 			assert.NoError(t, err)
-			k, err := client.GetClusterKubeconfig("dev")
+			k, err := client.GetClusterKubeconfig("dev", tt.mode)
 			tt.assertFunc(t, k, err)
 		})
 	}
@@ -944,3 +1022,189 @@ func TestSignin(t *testing.T) {
 		})
 	}
 }
+
+func TestRetrieveTerraformDriftReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		responder  httpmock.Responder
+		assertFunc func(t *testing.T, r *terraform.DriftReport, err error)
+	}{
+		{
+			name:      "report returned",
+			responder: httpmock.NewJsonResponderOrPanic(200, httpmock.File("./testdata/terraform_drift_report.json")),
+			assertFunc: func(t *testing.T, r *terraform.DriftReport, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, &terraform.DriftReport{
+					GeneratedAt: "2023-10-02T10:00:00Z",
+					Entries: []terraform.DriftReportEntry{
+						{
+							ClusterName:         "management",
+							Namespace:           "flux-system",
+							Name:                "vpc",
+							DriftingSince:       "2023-10-01T10:00:00Z",
+							DriftingForSeconds:  86400,
+							LastDriftDetectedAt: "2023-10-02T10:00:00Z",
+							PlanID:              "plan-main-abc123",
+							DriftedResources:    []string{"aws_vpc.main"},
+						},
+					},
+					Errors: []terraform.ListError{
+						{ClusterName: "leaf-1", Message: "connection refused"},
+					},
+				}, r)
+			},
+		},
+		{
+			name:      "unexpected status code",
+			responder: httpmock.NewStringResponder(http.StatusBadRequest, ""),
+			assertFunc: func(t *testing.T, r *terraform.DriftReport, err error) {
+				assert.EqualError(t, err, "response status for GET \"https://weave.works/api/v1/terraform-objects/drift-report\" was 400")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &config.Options{
+				Endpoint: testutils.BaseURI,
+			}
+			client := adapters.NewHTTPClient()
+			httpmock.ActivateNonDefault(client.GetBaseClient())
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("GET", testutils.BaseURI+"/v1/terraform-objects/drift-report", tt.responder)
+
+			err := client.ConfigureClientWithOptions(opts, os.Stdout)
+			assert.NoError(t, err)
+			r, err := client.RetrieveTerraformDriftReport(terraform.DriftReportParams{})
+			tt.assertFunc(t, r, err)
+		})
+	}
+}
+
+func TestRetrieveTerraformObjects(t *testing.T) {
+	tests := []struct {
+		name       string
+		responder  httpmock.Responder
+		assertFunc func(t *testing.T, objects []terraform.Object, listErrors []terraform.ListError, err error)
+	}{
+		{
+			name:      "objects returned",
+			responder: httpmock.NewJsonResponderOrPanic(200, httpmock.File("./testdata/terraform_objects.json")),
+			assertFunc: func(t *testing.T, objects []terraform.Object, listErrors []terraform.ListError, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []terraform.Object{
+					{
+						ClusterName:     "management",
+						Namespace:       "flux-system",
+						Name:            "vpc",
+						AppliedRevision: "main@sha1:a1b2c3",
+						LastUpdatedAt:   "2023-10-02T10:00:00Z",
+						Labels:          map[string]string{"team": "infra"},
+						Conditions: []terraform.Condition{
+							{Type: "Ready", Status: "True", Reason: "TerraformAppliedSucceed", Message: "Applied successfully: main@sha1:a1b2c3"},
+						},
+					},
+				}, objects)
+				assert.Equal(t, []terraform.ListError{{ClusterName: "leaf-1", Message: "connection refused"}}, listErrors)
+			},
+		},
+		{
+			name:      "unexpected status code",
+			responder: httpmock.NewStringResponder(http.StatusBadRequest, ""),
+			assertFunc: func(t *testing.T, objects []terraform.Object, listErrors []terraform.ListError, err error) {
+				assert.EqualError(t, err, "response status for GET \"https://weave.works/api/v1/terraform-objects?namespace=flux-system\" was 400")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &config.Options{
+				Endpoint: testutils.BaseURI,
+			}
+			client := adapters.NewHTTPClient()
+			httpmock.ActivateNonDefault(client.GetBaseClient())
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("GET", testutils.BaseURI+"/v1/terraform-objects?namespace=flux-system", tt.responder)
+
+			err := client.ConfigureClientWithOptions(opts, os.Stdout)
+			assert.NoError(t, err)
+			objects, listErrors, err := client.RetrieveTerraformObjects("flux-system", "")
+			tt.assertFunc(t, objects, listErrors, err)
+		})
+	}
+}
+
+func TestRetrieveTerraformObjectPlan(t *testing.T) {
+	opts := &config.Options{
+		Endpoint: testutils.BaseURI,
+	}
+	client := adapters.NewHTTPClient()
+	httpmock.ActivateNonDefault(client.GetBaseClient())
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", testutils.BaseURI+"/v1/namespaces/flux-system/terraform-objects/vpc/plan?clusterName=management",
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("./testdata/terraform_object_plan.json")))
+
+	err := client.ConfigureClientWithOptions(opts, os.Stdout)
+	assert.NoError(t, err)
+
+	plan, err := client.RetrieveTerraformObjectPlan(terraform.ObjectRef{ClusterName: "management", Namespace: "flux-system", Name: "vpc"})
+	assert.NoError(t, err)
+	assert.Equal(t, &terraform.Plan{
+		Plan:            "Terraform will perform the following actions: ...",
+		PlanID:          "plan-main-a1b2c3",
+		Summary:         &terraform.PlanSummary{Create: 1},
+		ResourceChanges: []terraform.PlanResourceChange{{Address: "aws_subnet.a", Action: "create"}},
+	}, plan)
+}
+
+func TestToggleSuspendTerraformObjects(t *testing.T) {
+	tests := []struct {
+		name      string
+		responder httpmock.Responder
+		errString string
+	}{
+		{
+			name: "objects suspended",
+			responder: func(r *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					return nil, err
+				}
+
+				if string(body) != `{"objects":[{"clusterName":"management","namespace":"flux-system","name":"vpc"}],"suspend":true}` {
+					return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+				}
+
+				return httpmock.NewJsonResponse(http.StatusOK, map[string]interface{}{})
+			},
+		},
+		{
+			name:      "service error",
+			responder: httpmock.NewJsonResponderOrPanic(http.StatusForbidden, map[string]interface{}{"code": 7, "message": "permission denied"}),
+			errString: "request to \"https://weave.works/api/v1/terraform-objects/suspend\" failed: permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &config.Options{
+				Endpoint: testutils.BaseURI,
+			}
+			client := adapters.NewHTTPClient()
+			httpmock.ActivateNonDefault(client.GetBaseClient())
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("PATCH", testutils.BaseURI+"/v1/terraform-objects/suspend", tt.responder)
+
+			err := client.ConfigureClientWithOptions(opts, os.Stdout)
+			assert.NoError(t, err)
+
+			err = client.ToggleSuspendTerraformObjects([]terraform.ObjectRef{{ClusterName: "management", Namespace: "flux-system", Name: "vpc"}}, true)
+			if tt.errString == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errString)
+			}
+		})
+	}
+}
@@ -9,8 +9,9 @@ import (
 // need to implement in order to return an array of clusters.
 type ClustersRetriever interface {
 	Source() string
-	RetrieveClusters() ([]Cluster, error)
-	GetClusterKubeconfig(string) (string, error)
+	RetrieveClusters(clusterSelector string) ([]Cluster, error)
+	RetrieveClusterInventory(clusterSelector string) (*Inventory, error)
+	GetClusterKubeconfig(name, mode string) (string, error)
 	DeleteClusters(DeleteClustersParams) (string, error)
 }
 
@@ -26,9 +27,9 @@ type Condition struct {
 }
 
 // GetClusters uses a ClustersRetriever adapter to show
-// a list of clusters to the console.
-func GetClusters(r ClustersRetriever, w io.Writer) error {
-	cs, err := r.RetrieveClusters()
+// a list of clusters, matching the cluster selector if set, to the console.
+func GetClusters(r ClustersRetriever, clusterSelector string, w io.Writer) error {
+	cs, err := r.RetrieveClusters(clusterSelector)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve clusters from %q: %w", r.Source(), err)
 	}
@@ -51,7 +52,7 @@ func GetClusters(r ClustersRetriever, w io.Writer) error {
 // GetClusterByName uses a ClustersRetriever adapter to show
 // a cluster to the console given its name.
 func GetClusterByName(name string, r ClustersRetriever, w io.Writer) error {
-	cs, err := r.RetrieveClusters()
+	cs, err := r.RetrieveClusters("")
 	if err != nil {
 		return fmt.Errorf("unable to retrieve clusters from %q: %w", r.Source(), err)
 	}
@@ -73,8 +74,10 @@ func GetClusterByName(name string, r ClustersRetriever, w io.Writer) error {
 	return nil
 }
 
-func GetClusterKubeconfig(name string, r ClustersRetriever, w io.Writer) error {
-	k, err := r.GetClusterKubeconfig(name)
+// GetClusterKubeconfig uses a ClustersRetriever adapter to show the kubeconfig of a cluster,
+// in the given mode, to the console.
+func GetClusterKubeconfig(name, mode string, r ClustersRetriever, w io.Writer) error {
+	k, err := r.GetClusterKubeconfig(name, mode)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve cluster %q from %q: %w", name, r.Source(), err)
 	}
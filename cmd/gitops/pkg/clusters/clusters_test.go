@@ -59,7 +59,7 @@ func TestGetClusters(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewFakeClient(tt.cs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := clusters.GetClusters(c, w)
+			err := clusters.GetClusters(c, "", w)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -68,6 +68,15 @@ func TestGetClusters(t *testing.T) {
 	}
 }
 
+func TestGetClusters_ClusterSelector(t *testing.T) {
+	c := NewFakeClient([]clusters.Cluster{{Name: "cluster-a"}}, "", nil)
+	w := new(bytes.Buffer)
+	err := clusters.GetClusters(c, "env=prod,region=eu-west", w)
+	assert.NoError(t, err)
+	assert.Equal(t, "env=prod,region=eu-west", c.clusterSelector)
+	assert.Equal(t, "NAME\tSTATUS\tSTATUS_MESSAGE\ncluster-a\t\t\n", w.String())
+}
+
 func TestGetClusterByName(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -163,9 +172,12 @@ func TestDeleteClusters(t *testing.T) {
 }
 
 type FakeClient struct {
-	cs  []clusters.Cluster
-	s   string
-	err error
+	cs        []clusters.Cluster
+	s         string
+	err       error
+	inventory *clusters.Inventory
+	// clusterSelector is the cluster selector of the last retrieval
+	clusterSelector string
 }
 
 func NewFakeClient(cs []clusters.Cluster, s string, err error) *FakeClient {
@@ -180,7 +192,8 @@ func (c *FakeClient) Source() string {
 	return "In-memory fake"
 }
 
-func (c *FakeClient) RetrieveClusters() ([]clusters.Cluster, error) {
+func (c *FakeClient) RetrieveClusters(clusterSelector string) ([]clusters.Cluster, error) {
+	c.clusterSelector = clusterSelector
 	if c.err != nil {
 		return nil, c.err
 	}
@@ -188,7 +201,16 @@ func (c *FakeClient) RetrieveClusters() ([]clusters.Cluster, error) {
 	return c.cs, nil
 }
 
-func (c *FakeClient) GetClusterKubeconfig(name string) (string, error) {
+func (c *FakeClient) RetrieveClusterInventory(clusterSelector string) (*clusters.Inventory, error) {
+	c.clusterSelector = clusterSelector
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.inventory, nil
+}
+
+func (c *FakeClient) GetClusterKubeconfig(name, mode string) (string, error) {
 	if c.err != nil {
 		return "", c.err
 	}
@@ -0,0 +1,144 @@
+package clusters
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	OutputJSON = "json"
+	OutputCSV  = "csv"
+)
+
+// Inventory is the inventory of the clusters, combining their provider, Kubernetes version,
+// node pools, installed profiles, owners and the template they were created from.
+type Inventory struct {
+	Clusters   []ClusterInventory `json:"clusters"`
+	Errors     []ListError        `json:"errors,omitempty"`
+	ExportedAt string             `json:"exportedAt"`
+}
+
+type ClusterInventory struct {
+	ClusterName       string             `json:"clusterName"`
+	Name              string             `json:"name"`
+	Namespace         string             `json:"namespace,omitempty"`
+	Labels            map[string]string  `json:"labels,omitempty"`
+	Provider          string             `json:"provider,omitempty"`
+	KubernetesVersion string             `json:"kubernetesVersion,omitempty"`
+	NodePools         []NodePool         `json:"nodePools"`
+	Profiles          []InstalledProfile `json:"profiles"`
+	Owners            []string           `json:"owners"`
+	Template          *TemplateRef       `json:"template,omitempty"`
+	ControlPlane      bool               `json:"controlPlane,omitempty"`
+}
+
+type NodePool struct {
+	Name               string `json:"name"`
+	Replicas           int32  `json:"replicas"`
+	ReadyReplicas      int32  `json:"readyReplicas"`
+	KubernetesVersion  string `json:"kubernetesVersion,omitempty"`
+	InfrastructureKind string `json:"infrastructureKind,omitempty"`
+}
+
+type InstalledProfile struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Chart     string `json:"chart,omitempty"`
+	Version   string `json:"version,omitempty"`
+	Status    string `json:"status,omitempty"`
+}
+
+type TemplateRef struct {
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace,omitempty"`
+	TemplateKind string `json:"templateKind,omitempty"`
+	Provider     string `json:"provider,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// ListError is a partial failure of the inventory, e.g. when the node pools of a cluster could not be listed.
+type ListError struct {
+	ClusterName string `json:"clusterName,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Message     string `json:"message"`
+}
+
+// GetClusterInventory uses a ClustersRetriever adapter to show the inventory
+// of the clusters, matching the cluster selector if set, in the given format.
+func GetClusterInventory(r ClustersRetriever, clusterSelector, output string, w io.Writer) error {
+	if output != OutputJSON && output != OutputCSV {
+		return fmt.Errorf("unsupported output format %q, must be one of %s or %s", output, OutputJSON, OutputCSV)
+	}
+
+	inventory, err := r.RetrieveClusterInventory(clusterSelector)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the cluster inventory from %q: %w", r.Source(), err)
+	}
+
+	if output == OutputCSV {
+		return writeInventoryCSV(inventory, w)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(inventory)
+}
+
+func writeInventoryCSV(inventory *Inventory, w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	records := [][]string{
+		{"cluster", "provider", "kubernetes_version", "node_pools", "nodes", "profiles", "owners", "template"},
+	}
+
+	for _, c := range inventory.Clusters {
+		var nodes int32
+		nodePools := []string{}
+		for _, np := range c.NodePools {
+			nodes += np.Replicas
+			nodePools = append(nodePools, fmt.Sprintf("%s:%d", np.Name, np.Replicas))
+		}
+
+		profiles := []string{}
+		for _, p := range c.Profiles {
+			name := p.Chart
+			if name == "" {
+				name = p.Name
+			}
+			if p.Version != "" {
+				name = fmt.Sprintf("%s@%s", name, p.Version)
+			}
+			profiles = append(profiles, name)
+		}
+
+		var template string
+		if c.Template != nil {
+			template = c.Template.Name
+			if c.Template.Namespace != "" {
+				template = c.Template.Namespace + "/" + template
+			}
+		}
+
+		records = append(records, []string{
+			c.ClusterName,
+			c.Provider,
+			c.KubernetesVersion,
+			strings.Join(nodePools, ";"),
+			strconv.Itoa(int(nodes)),
+			strings.Join(profiles, ";"),
+			strings.Join(c.Owners, ";"),
+			template,
+		})
+	}
+
+	if err := cw.WriteAll(records); err != nil {
+		return fmt.Errorf("writing csv: %w", err)
+	}
+
+	return nil
+}
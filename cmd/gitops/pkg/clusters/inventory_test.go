@@ -0,0 +1,144 @@
+package clusters_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/clusters"
+)
+
+func TestGetClusterInventory(t *testing.T) {
+	inventory := &clusters.Inventory{
+		Clusters: []clusters.ClusterInventory{
+			{
+				ClusterName:       "default/dev",
+				Name:              "dev",
+				Namespace:         "default",
+				Provider:          "aws",
+				KubernetesVersion: "v1.28.2",
+				NodePools: []clusters.NodePool{
+					{Name: "dev-md-0", Replicas: 3, ReadyReplicas: 3},
+					{Name: "dev-md-1", Replicas: 2, ReadyReplicas: 1},
+				},
+				Profiles: []clusters.InstalledProfile{
+					{Name: "podinfo", Namespace: "flux-system", Chart: "podinfo", Version: "6.5.0"},
+					{Name: "cert-manager", Namespace: "cert-manager"},
+				},
+				Owners:   []string{"team-a", "jane@example.com"},
+				Template: &clusters.TemplateRef{Name: "eks", Namespace: "default"},
+			},
+			{
+				ClusterName:  "management",
+				Name:         "management",
+				NodePools:    []clusters.NodePool{},
+				Profiles:     []clusters.InstalledProfile{},
+				Owners:       []string{},
+				ControlPlane: true,
+			},
+		},
+		ExportedAt: "2023-10-02T10:00:00Z",
+	}
+
+	tests := []struct {
+		name             string
+		output           string
+		err              error
+		expected         string
+		expectedErrorStr string
+	}{
+		{
+			name:   "csv",
+			output: clusters.OutputCSV,
+			expected: `cluster,provider,kubernetes_version,node_pools,nodes,profiles,owners,template
+default/dev,aws,v1.28.2,dev-md-0:3;dev-md-1:2,5,podinfo@6.5.0;cert-manager,team-a;jane@example.com,default/eks
+management,,,,0,,,
+`,
+		},
+		{
+			name:   "json",
+			output: clusters.OutputJSON,
+			expected: `{
+  "clusters": [
+    {
+      "clusterName": "default/dev",
+      "name": "dev",
+      "namespace": "default",
+      "provider": "aws",
+      "kubernetesVersion": "v1.28.2",
+      "nodePools": [
+        {
+          "name": "dev-md-0",
+          "replicas": 3,
+          "readyReplicas": 3
+        },
+        {
+          "name": "dev-md-1",
+          "replicas": 2,
+          "readyReplicas": 1
+        }
+      ],
+      "profiles": [
+        {
+          "name": "podinfo",
+          "namespace": "flux-system",
+          "chart": "podinfo",
+          "version": "6.5.0"
+        },
+        {
+          "name": "cert-manager",
+          "namespace": "cert-manager"
+        }
+      ],
+      "owners": [
+        "team-a",
+        "jane@example.com"
+      ],
+      "template": {
+        "name": "eks",
+        "namespace": "default"
+      }
+    },
+    {
+      "clusterName": "management",
+      "name": "management",
+      "nodePools": [],
+      "profiles": [],
+      "owners": [],
+      "controlPlane": true
+    }
+  ],
+  "exportedAt": "2023-10-02T10:00:00Z"
+}
+`,
+		},
+		{
+			name:             "unsupported output",
+			output:           "table",
+			expectedErrorStr: "unsupported output format \"table\", must be one of json or csv",
+		},
+		{
+			name:             "error retrieving inventory",
+			output:           clusters.OutputJSON,
+			err:              errors.New("something went wrong"),
+			expectedErrorStr: "unable to retrieve the cluster inventory from \"In-memory fake\": something went wrong",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewFakeClient(nil, "", tt.err)
+			c.inventory = inventory
+			w := new(bytes.Buffer)
+			err := clusters.GetClusterInventory(c, "env=dev", tt.output, w)
+			if tt.expectedErrorStr != "" {
+				assert.EqualError(t, err, tt.expectedErrorStr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "env=dev", c.clusterSelector)
+			assert.Equal(t, tt.expected, w.String())
+		})
+	}
+}
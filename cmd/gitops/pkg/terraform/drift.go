@@ -0,0 +1,122 @@
+package terraform
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type DriftReportParams struct {
+	ClusterName     string
+	Namespace       string
+	ClusterSelector string
+}
+
+type DriftReport struct {
+	GeneratedAt string             `json:"generatedAt"`
+	Entries     []DriftReportEntry `json:"entries"`
+	Errors      []ListError        `json:"errors,omitempty"`
+}
+
+type DriftReportEntry struct {
+	ClusterName         string   `json:"clusterName"`
+	Namespace           string   `json:"namespace"`
+	Name                string   `json:"name"`
+	DriftingSince       string   `json:"driftingSince"`
+	DriftingForSeconds  int64    `json:"driftingForSeconds"`
+	LastDriftDetectedAt string   `json:"lastDriftDetectedAt"`
+	PlanID              string   `json:"planId,omitempty"`
+	DriftedResources    []string `json:"driftedResources"`
+}
+
+// GetDriftReport uses a TerraformRetriever adapter to show the drifted
+// Terraform objects selected in the given format.
+func GetDriftReport(selector Selector, output string, r TerraformRetriever, w io.Writer) error {
+	if selector.LabelSelector != "" {
+		return errors.New("label selectors are not supported by the drift report")
+	}
+
+	params := DriftReportParams{Namespace: selector.namespace(), ClusterSelector: selector.ClusterSelector}
+	if len(selector.Clusters) == 1 {
+		params.ClusterName = selector.Clusters[0]
+	}
+
+	report, err := r.RetrieveTerraformDriftReport(params)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve terraform drift report from %q: %w", r.Source(), err)
+	}
+
+	entries := []DriftReportEntry{}
+	for _, e := range report.Entries {
+		if selector.matchesCluster(e.ClusterName) {
+			entries = append(entries, e)
+		}
+	}
+	report.Entries = entries
+
+	switch output {
+	case OutputJSON:
+		return writeJSON(report, w)
+	case OutputCSV:
+		return writeDriftReportCSV(report, w)
+	case OutputTable, "":
+	default:
+		return unsupportedOutputError(output)
+	}
+
+	for _, e := range report.Errors {
+		fmt.Fprintf(w, "Error listing terraform objects on cluster %s: %s\n", e.ClusterName, e.Message)
+	}
+
+	if len(report.Entries) == 0 {
+		fmt.Fprintf(w, "No drifted terraform objects found.\n")
+
+		return nil
+	}
+
+	fmt.Fprintf(w, "CLUSTER\tNAMESPACE\tNAME\tDRIFTING FOR\tDRIFTING SINCE\tDRIFTED RESOURCES\n")
+
+	for _, e := range report.Entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			e.ClusterName,
+			e.Namespace,
+			e.Name,
+			time.Duration(e.DriftingForSeconds)*time.Second,
+			e.DriftingSince,
+			strings.Join(e.DriftedResources, ","),
+		)
+	}
+
+	return nil
+}
+
+func writeDriftReportCSV(report *DriftReport, w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	records := [][]string{
+		{"cluster", "namespace", "name", "drifting_since", "drifting_for_seconds", "last_drift_detected_at", "plan_id", "drifted_resources"},
+	}
+
+	for _, e := range report.Entries {
+		records = append(records, []string{
+			e.ClusterName,
+			e.Namespace,
+			e.Name,
+			e.DriftingSince,
+			strconv.FormatInt(e.DriftingForSeconds, 10),
+			e.LastDriftDetectedAt,
+			e.PlanID,
+			strings.Join(e.DriftedResources, ";"),
+		})
+	}
+
+	if err := cw.WriteAll(records); err != nil {
+		return fmt.Errorf("writing csv: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package terraform_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+)
+
+var testReport = &terraform.DriftReport{
+	GeneratedAt: "2023-10-02T10:00:00Z",
+	Entries: []terraform.DriftReportEntry{
+		{
+			ClusterName:         "management",
+			Namespace:           "flux-system",
+			Name:                "vpc",
+			DriftingSince:       "2023-10-01T10:00:00Z",
+			DriftingForSeconds:  86400,
+			LastDriftDetectedAt: "2023-10-02T10:00:00Z",
+			PlanID:              "plan-main-abc123",
+			DriftedResources:    []string{"aws_vpc.main", "aws_subnet.a"},
+		},
+	},
+}
+
+func TestGetDriftReport(t *testing.T) {
+	tests := []struct {
+		name             string
+		report           *terraform.DriftReport
+		output           string
+		err              error
+		expected         string
+		expectedErrorStr string
+	}{
+		{
+			name:     "no drift",
+			report:   &terraform.DriftReport{},
+			expected: "No drifted terraform objects found.\n",
+		},
+		{
+			name:     "table",
+			report:   testReport,
+			expected: "CLUSTER\tNAMESPACE\tNAME\tDRIFTING FOR\tDRIFTING SINCE\tDRIFTED RESOURCES\nmanagement\tflux-system\tvpc\t24h0m0s\t2023-10-01T10:00:00Z\taws_vpc.main,aws_subnet.a\n",
+		},
+		{
+			name:     "csv",
+			report:   testReport,
+			output:   terraform.OutputCSV,
+			expected: "cluster,namespace,name,drifting_since,drifting_for_seconds,last_drift_detected_at,plan_id,drifted_resources\nmanagement,flux-system,vpc,2023-10-01T10:00:00Z,86400,2023-10-02T10:00:00Z,plan-main-abc123,aws_vpc.main;aws_subnet.a\n",
+		},
+		{
+			name:             "unknown output",
+			report:           testReport,
+			output:           "yaml",
+			expectedErrorStr: "unsupported output format \"yaml\", must be one of table, csv or json",
+		},
+		{
+			name:             "error retrieving report",
+			err:              fmt.Errorf("oops something went wrong"),
+			expectedErrorStr: "unable to retrieve terraform drift report from \"In-memory fake\": oops something went wrong",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &fakeClient{report: tt.report, err: tt.err}
+			w := new(bytes.Buffer)
+			err := terraform.GetDriftReport(terraform.Selector{}, tt.output, c, w)
+			assert.Equal(t, tt.expected, w.String())
+			if tt.expectedErrorStr != "" {
+				assert.EqualError(t, err, tt.expectedErrorStr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetDriftReport_JSON(t *testing.T) {
+	w := new(bytes.Buffer)
+	err := terraform.GetDriftReport(terraform.Selector{}, terraform.OutputJSON, &fakeClient{report: testReport}, w)
+	assert.NoError(t, err)
+	assert.Contains(t, w.String(), `"driftingForSeconds": 86400`)
+	assert.Contains(t, w.String(), `"planId": "plan-main-abc123"`)
+}
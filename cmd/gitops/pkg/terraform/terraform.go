@@ -0,0 +1,480 @@
+package terraform
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/strings/slices"
+)
+
+const (
+	OutputTable = "table"
+	OutputCSV   = "csv"
+	OutputJSON  = "json"
+
+	// DefaultClusterName is the name of the management cluster.
+	DefaultClusterName = "management"
+
+	ConditionReady = "Ready"
+	ConditionPlan  = "Plan"
+)
+
+// TerraformRetriever defines the interface that adapters
+// need to implement in order to return and operate on Terraform objects.
+type TerraformRetriever interface {
+	Source() string
+	RetrieveTerraformObjects(namespace, clusterSelector string) ([]Object, []ListError, error)
+	RetrieveTerraformObject(ObjectRef) (*Object, error)
+	RetrieveTerraformObjectPlan(ObjectRef) (*Plan, error)
+	SyncTerraformObjects([]ObjectRef) error
+	ToggleSuspendTerraformObjects(refs []ObjectRef, suspend bool) error
+	ReplanTerraformObject(ObjectRef) error
+	RetrieveTerraformDriftReport(DriftReportParams) (*DriftReport, error)
+}
+
+// ObjectRef identifies a Terraform object of a cluster.
+type ObjectRef struct {
+	ClusterName string `json:"clusterName"`
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+}
+
+func (r ObjectRef) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.ClusterName, r.Namespace, r.Name)
+}
+
+type Object struct {
+	ClusterName     string            `json:"clusterName"`
+	Namespace       string            `json:"namespace"`
+	Name            string            `json:"name"`
+	AppliedRevision string            `json:"appliedRevision,omitempty"`
+	LastUpdatedAt   string            `json:"lastUpdatedAt,omitempty"`
+	Drifted         bool              `json:"drifted"`
+	Suspended       bool              `json:"suspended"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Conditions      []Condition       `json:"conditions,omitempty"`
+}
+
+type Condition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+type ListError struct {
+	ClusterName string `json:"clusterName"`
+	Message     string `json:"message"`
+}
+
+// Plan is the plan of a Terraform object, either as written by Terraform or
+// summarised from its JSON representation.
+type Plan struct {
+	Plan            string               `json:"plan,omitempty"`
+	PlanID          string               `json:"planId,omitempty"`
+	Summary         *PlanSummary         `json:"summary,omitempty"`
+	ResourceChanges []PlanResourceChange `json:"resourceChanges,omitempty"`
+	Error           string               `json:"error,omitempty"`
+}
+
+type PlanSummary struct {
+	Create  int `json:"create"`
+	Update  int `json:"update"`
+	Delete  int `json:"delete"`
+	Replace int `json:"replace"`
+}
+
+type PlanResourceChange struct {
+	Address string `json:"address"`
+	Action  string `json:"action"`
+}
+
+// Selector selects Terraform objects across clusters.
+type Selector struct {
+	// Clusters limits the selection to these clusters, all clusters are
+	// selected if empty.
+	Clusters      []string
+	Namespace     string
+	AllNamespaces bool
+	LabelSelector string
+	// ClusterSelector limits the selection to the clusters whose
+	// GitopsCluster labels match this label selector.
+	ClusterSelector string
+}
+
+func (s Selector) namespace() string {
+	if s.AllNamespaces {
+		return ""
+	}
+
+	return s.Namespace
+}
+
+func (s Selector) matchesCluster(cluster string) bool {
+	if len(s.Clusters) == 0 {
+		return true
+	}
+
+	for _, c := range s.Clusters {
+		if c == cluster {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ready returns the Ready condition of an object.
+func (o Object) ready() Condition {
+	return o.condition(ConditionReady)
+}
+
+func (o Object) condition(conditionType string) Condition {
+	for _, c := range o.Conditions {
+		if c.Type == conditionType {
+			return c
+		}
+	}
+
+	return Condition{Type: conditionType, Status: "Unknown"}
+}
+
+// SelectObjects returns the objects matching a selector.
+func SelectObjects(selector Selector, r TerraformRetriever) ([]Object, []ListError, error) {
+	labelSelector, err := labels.Parse(selector.LabelSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid label selector %q: %w", selector.LabelSelector, err)
+	}
+
+	objects, listErrors, err := r.RetrieveTerraformObjects(selector.namespace(), selector.ClusterSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve terraform objects from %q: %w", r.Source(), err)
+	}
+
+	selected := []Object{}
+
+	for _, o := range objects {
+		if !selector.matchesCluster(o.ClusterName) || !labelSelector.Matches(labels.Set(o.Labels)) {
+			continue
+		}
+
+		selected = append(selected, o)
+	}
+
+	errs := []ListError{}
+
+	for _, e := range listErrors {
+		if selector.matchesCluster(e.ClusterName) {
+			errs = append(errs, e)
+		}
+	}
+
+	return selected, errs, nil
+}
+
+// ResolveObjectRefs returns references to the named objects on each selected
+// cluster, or to every object matching the selector if no name is given. The
+// named objects must exist on the clusters selected by a cluster selector.
+func ResolveObjectRefs(names []string, selector Selector, r TerraformRetriever) ([]ObjectRef, error) {
+	if len(names) > 0 && selector.ClusterSelector == "" {
+		if selector.LabelSelector != "" {
+			return nil, errors.New("object names and label selectors are mutually exclusive")
+		}
+
+		if selector.AllNamespaces {
+			return nil, errors.New("a namespace is required when selecting objects by name")
+		}
+
+		clusters := selector.Clusters
+		if len(clusters) == 0 {
+			clusters = []string{DefaultClusterName}
+		}
+
+		refs := []ObjectRef{}
+
+		for _, cluster := range clusters {
+			for _, name := range names {
+				refs = append(refs, ObjectRef{ClusterName: cluster, Namespace: selector.Namespace, Name: name})
+			}
+		}
+
+		return refs, nil
+	}
+
+	objects, _, err := SelectObjects(selector, r)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []ObjectRef{}
+	for _, o := range objects {
+		if len(names) > 0 && !slices.Contains(names, o.Name) {
+			continue
+		}
+
+		refs = append(refs, ObjectRef{ClusterName: o.ClusterName, Namespace: o.Namespace, Name: o.Name})
+	}
+
+	if len(refs) == 0 {
+		return nil, errors.New("no terraform objects selected")
+	}
+
+	return refs, nil
+}
+
+// GetObjects uses a TerraformRetriever adapter to show the objects matching
+// a selector in the given format.
+func GetObjects(selector Selector, output string, r TerraformRetriever, w io.Writer) error {
+	objects, listErrors, err := SelectObjects(selector, r)
+	if err != nil {
+		return err
+	}
+
+	switch output {
+	case OutputJSON:
+		return writeJSON(objects, w)
+	case OutputCSV:
+		return writeObjectsCSV(objects, w)
+	case OutputTable, "":
+	default:
+		return unsupportedOutputError(output)
+	}
+
+	for _, e := range listErrors {
+		fmt.Fprintf(w, "Error listing terraform objects on cluster %s: %s\n", e.ClusterName, e.Message)
+	}
+
+	if len(objects) == 0 {
+		fmt.Fprintf(w, "No terraform objects found.\n")
+
+		return nil
+	}
+
+	fmt.Fprintf(w, "CLUSTER\tNAMESPACE\tNAME\tREADY\tSUSPENDED\tDRIFTED\tMESSAGE\n")
+
+	for _, o := range objects {
+		ready := o.ready()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\t%s\n", o.ClusterName, o.Namespace, o.Name, ready.Status, o.Suspended, o.Drifted, ready.Message)
+	}
+
+	return nil
+}
+
+func writeObjectsCSV(objects []Object, w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	records := [][]string{
+		{"cluster", "namespace", "name", "ready", "suspended", "drifted", "applied_revision", "message"},
+	}
+
+	for _, o := range objects {
+		ready := o.ready()
+		records = append(records, []string{
+			o.ClusterName,
+			o.Namespace,
+			o.Name,
+			ready.Status,
+			strconv.FormatBool(o.Suspended),
+			strconv.FormatBool(o.Drifted),
+			o.AppliedRevision,
+			ready.Message,
+		})
+	}
+
+	if err := cw.WriteAll(records); err != nil {
+		return fmt.Errorf("writing csv: %w", err)
+	}
+
+	return nil
+}
+
+// GetPlan uses a TerraformRetriever adapter to show the plan of an object.
+func GetPlan(ref ObjectRef, output string, r TerraformRetriever, w io.Writer) error {
+	plan, err := r.RetrieveTerraformObjectPlan(ref)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve plan of terraform object %s from %q: %w", ref, r.Source(), err)
+	}
+
+	if plan.Error != "" {
+		return fmt.Errorf("unable to retrieve plan of terraform object %s: %s", ref, plan.Error)
+	}
+
+	switch output {
+	case OutputJSON:
+		return writeJSON(plan, w)
+	case OutputTable, "":
+	default:
+		return unsupportedOutputError(output)
+	}
+
+	if plan.Summary == nil {
+		fmt.Fprint(w, plan.Plan)
+
+		return nil
+	}
+
+	fmt.Fprintf(w, "Plan %s: %d to create, %d to update, %d to replace, %d to delete.\n",
+		plan.PlanID, plan.Summary.Create, plan.Summary.Update, plan.Summary.Replace, plan.Summary.Delete)
+
+	if len(plan.ResourceChanges) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(w, "ACTION\tADDRESS\n")
+
+	for _, rc := range plan.ResourceChanges {
+		fmt.Fprintf(w, "%s\t%s\n", rc.Action, rc.Address)
+	}
+
+	return nil
+}
+
+// SyncObjects uses a TerraformRetriever adapter to request the reconciliation
+// of objects, the server waits for the objects to be reconciled.
+func SyncObjects(refs []ObjectRef, r TerraformRetriever, w io.Writer) error {
+	if err := r.SyncTerraformObjects(refs); err != nil {
+		return fmt.Errorf("unable to sync terraform objects: %w", err)
+	}
+
+	for _, ref := range refs {
+		fmt.Fprintf(w, "Synced terraform object %s\n", ref)
+	}
+
+	return nil
+}
+
+// SuspendObjects uses a TerraformRetriever adapter to suspend or resume the
+// reconciliation of objects.
+func SuspendObjects(refs []ObjectRef, suspend bool, r TerraformRetriever, w io.Writer) error {
+	action, done := "resume", "Resumed"
+	if suspend {
+		action, done = "suspend", "Suspended"
+	}
+
+	if err := r.ToggleSuspendTerraformObjects(refs, suspend); err != nil {
+		return fmt.Errorf("unable to %s terraform objects: %w", action, err)
+	}
+
+	for _, ref := range refs {
+		fmt.Fprintf(w, "%s terraform object %s\n", done, ref)
+	}
+
+	return nil
+}
+
+// ReplanObjects uses a TerraformRetriever adapter to request new plans for
+// objects.
+func ReplanObjects(refs []ObjectRef, r TerraformRetriever, w io.Writer) error {
+	for _, ref := range refs {
+		if err := r.ReplanTerraformObject(ref); err != nil {
+			return fmt.Errorf("unable to replan terraform object %s: %w", ref, err)
+		}
+
+		fmt.Fprintf(w, "Requested a new plan for terraform object %s\n", ref)
+	}
+
+	return nil
+}
+
+// DefaultWaitInterval is how often objects are polled while waiting for them.
+const DefaultWaitInterval = 5 * time.Second
+
+// WaitOptions configures how long to wait for objects to become ready.
+type WaitOptions struct {
+	Timeout  time.Duration
+	Interval time.Duration
+	// ConditionType is the condition to wait for, Ready if empty.
+	ConditionType string
+}
+
+// WaitForReady polls objects until their condition is true. Objects with an
+// entry in since are only considered once reconciled again after that time,
+// and objects failing to reconcile are reported right away.
+func WaitForReady(refs []ObjectRef, since map[ObjectRef]string, opts WaitOptions, r TerraformRetriever, w io.Writer) error {
+	deadline := time.Now().Add(opts.Timeout)
+
+	conditionType := opts.ConditionType
+	if conditionType == "" {
+		conditionType = ConditionReady
+	}
+
+	pending := append([]ObjectRef{}, refs...)
+
+	for {
+		remaining := []ObjectRef{}
+
+		for _, ref := range pending {
+			o, err := r.RetrieveTerraformObject(ref)
+			if err != nil {
+				return fmt.Errorf("unable to retrieve terraform object %s from %q: %w", ref, r.Source(), err)
+			}
+
+			condition := o.condition(conditionType)
+			baseline, ok := since[ref]
+			updated := !ok || o.LastUpdatedAt != baseline
+
+			switch {
+			case updated && condition.Status == "True" && conditionType == ConditionReady:
+				fmt.Fprintf(w, "Terraform object %s is ready\n", ref)
+			case updated && condition.Status == "True":
+				fmt.Fprintf(w, "Terraform object %s: %s\n", ref, condition.Message)
+			case updated && condition.Status == "False" && strings.HasSuffix(condition.Reason, "Failed"):
+				return fmt.Errorf("terraform object %s failed to reconcile: %s", ref, condition.Message)
+			default:
+				remaining = append(remaining, ref)
+			}
+		}
+
+		if len(remaining) == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			names := []string{}
+			for _, ref := range remaining {
+				names = append(names, ref.String())
+			}
+
+			return fmt.Errorf("timed out waiting for the %s condition of terraform objects: %s", conditionType, strings.Join(names, ", "))
+		}
+
+		pending = remaining
+
+		time.Sleep(opts.Interval)
+	}
+}
+
+// LastUpdated returns when each object was last reconciled on request, to
+// wait for the objects to be reconciled again.
+func LastUpdated(refs []ObjectRef, r TerraformRetriever) (map[ObjectRef]string, error) {
+	since := map[ObjectRef]string{}
+
+	for _, ref := range refs {
+		o, err := r.RetrieveTerraformObject(ref)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve terraform object %s from %q: %w", ref, r.Source(), err)
+		}
+
+		since[ref] = o.LastUpdatedAt
+	}
+
+	return since, nil
+}
+
+func writeJSON(v interface{}, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func unsupportedOutputError(output string) error {
+	return fmt.Errorf("unsupported output format %q, must be one of %s, %s or %s", output, OutputTable, OutputCSV, OutputJSON)
+}
@@ -0,0 +1,321 @@
+package terraform_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/gitops/pkg/terraform"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// testClusterLabels are the labels of the GitopsClusters matched by the
+// cluster selectors, the management cluster has none.
+var testClusterLabels = map[string]map[string]string{
+	"default/leaf": {"env": "prod", "region": "eu-west"},
+}
+
+var testObjects = []terraform.Object{
+	{
+		ClusterName: "management",
+		Namespace:   "flux-system",
+		Name:        "vpc",
+		Labels:      map[string]string{"team": "infra"},
+		Conditions: []terraform.Condition{
+			{Type: "Ready", Status: "True", Reason: "TerraformAppliedSucceed", Message: "Applied successfully"},
+		},
+	},
+	{
+		ClusterName: "default/leaf",
+		Namespace:   "flux-system",
+		Name:        "bucket",
+		Drifted:     true,
+		Suspended:   true,
+		Conditions: []terraform.Condition{
+			{Type: "Ready", Status: "False", Reason: "DriftDetected", Message: "Drift detected"},
+		},
+	},
+}
+
+func TestGetObjects(t *testing.T) {
+	tests := []struct {
+		name             string
+		selector         terraform.Selector
+		objects          []terraform.Object
+		listErrors       []terraform.ListError
+		output           string
+		err              error
+		expected         string
+		expectedErrorStr string
+	}{
+		{
+			name:     "no objects",
+			expected: "No terraform objects found.\n",
+		},
+		{
+			name:     "table",
+			objects:  testObjects,
+			expected: "CLUSTER\tNAMESPACE\tNAME\tREADY\tSUSPENDED\tDRIFTED\tMESSAGE\nmanagement\tflux-system\tvpc\tTrue\tfalse\tfalse\tApplied successfully\ndefault/leaf\tflux-system\tbucket\tFalse\ttrue\ttrue\tDrift detected\n",
+		},
+		{
+			name:     "cluster selector",
+			selector: terraform.Selector{Clusters: []string{"default/leaf"}},
+			objects:  testObjects,
+			listErrors: []terraform.ListError{
+				{ClusterName: "management", Message: "not reachable"},
+				{ClusterName: "default/leaf", Message: "forbidden"},
+			},
+			expected: "Error listing terraform objects on cluster default/leaf: forbidden\nCLUSTER\tNAMESPACE\tNAME\tREADY\tSUSPENDED\tDRIFTED\tMESSAGE\ndefault/leaf\tflux-system\tbucket\tFalse\ttrue\ttrue\tDrift detected\n",
+		},
+		{
+			name:     "cluster label selector",
+			selector: terraform.Selector{ClusterSelector: "env=prod,region=eu-west"},
+			objects:  testObjects,
+			expected: "CLUSTER\tNAMESPACE\tNAME\tREADY\tSUSPENDED\tDRIFTED\tMESSAGE\ndefault/leaf\tflux-system\tbucket\tFalse\ttrue\ttrue\tDrift detected\n",
+		},
+		{
+			name:     "label selector",
+			selector: terraform.Selector{LabelSelector: "team=infra"},
+			objects:  testObjects,
+			output:   terraform.OutputCSV,
+			expected: "cluster,namespace,name,ready,suspended,drifted,applied_revision,message\nmanagement,flux-system,vpc,True,false,false,,Applied successfully\n",
+		},
+		{
+			name:             "invalid label selector",
+			selector:         terraform.Selector{LabelSelector: "team in"},
+			expectedErrorStr: "invalid label selector \"team in\"",
+		},
+		{
+			name:             "error retrieving objects",
+			err:              fmt.Errorf("oops something went wrong"),
+			expectedErrorStr: "unable to retrieve terraform objects from \"In-memory fake\": oops something went wrong",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &fakeClient{objects: tt.objects, listErrors: tt.listErrors, err: tt.err}
+			w := new(bytes.Buffer)
+			err := terraform.GetObjects(tt.selector, tt.output, c, w)
+			assert.Equal(t, tt.expected, w.String())
+			if tt.expectedErrorStr != "" {
+				assert.ErrorContains(t, err, tt.expectedErrorStr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResolveObjectRefs(t *testing.T) {
+	tests := []struct {
+		name             string
+		names            []string
+		selector         terraform.Selector
+		expected         []terraform.ObjectRef
+		expectedErrorStr string
+	}{
+		{
+			name:     "names default to the management cluster",
+			names:    []string{"vpc"},
+			selector: terraform.Selector{Namespace: "flux-system"},
+			expected: []terraform.ObjectRef{
+				{ClusterName: "management", Namespace: "flux-system", Name: "vpc"},
+			},
+		},
+		{
+			name:     "names on each cluster",
+			names:    []string{"vpc"},
+			selector: terraform.Selector{Namespace: "flux-system", Clusters: []string{"management", "default/leaf"}},
+			expected: []terraform.ObjectRef{
+				{ClusterName: "management", Namespace: "flux-system", Name: "vpc"},
+				{ClusterName: "default/leaf", Namespace: "flux-system", Name: "vpc"},
+			},
+		},
+		{
+			name:     "selector",
+			selector: terraform.Selector{AllNamespaces: true, Clusters: []string{"default/leaf"}},
+			expected: []terraform.ObjectRef{
+				{ClusterName: "default/leaf", Namespace: "flux-system", Name: "bucket"},
+			},
+		},
+		{
+			name:     "names on the clusters of a cluster selector",
+			names:    []string{"bucket", "vpc"},
+			selector: terraform.Selector{Namespace: "flux-system", ClusterSelector: "env=prod"},
+			expected: []terraform.ObjectRef{
+				{ClusterName: "default/leaf", Namespace: "flux-system", Name: "bucket"},
+			},
+		},
+		{
+			name:             "names and label selector",
+			names:            []string{"vpc"},
+			selector:         terraform.Selector{LabelSelector: "team=infra"},
+			expectedErrorStr: "object names and label selectors are mutually exclusive",
+		},
+		{
+			name:             "names in all namespaces",
+			names:            []string{"vpc"},
+			selector:         terraform.Selector{AllNamespaces: true},
+			expectedErrorStr: "a namespace is required when selecting objects by name",
+		},
+		{
+			name:             "nothing selected",
+			selector:         terraform.Selector{LabelSelector: "team=apps"},
+			expectedErrorStr: "no terraform objects selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refs, err := terraform.ResolveObjectRefs(tt.names, tt.selector, &fakeClient{objects: testObjects})
+			if tt.expectedErrorStr != "" {
+				assert.EqualError(t, err, tt.expectedErrorStr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, refs)
+		})
+	}
+}
+
+func TestGetPlan(t *testing.T) {
+	ref := terraform.ObjectRef{ClusterName: "management", Namespace: "flux-system", Name: "vpc"}
+
+	c := &fakeClient{plan: &terraform.Plan{
+		PlanID:  "plan-main-abc123",
+		Summary: &terraform.PlanSummary{Create: 1, Delete: 1},
+		ResourceChanges: []terraform.PlanResourceChange{
+			{Address: "aws_subnet.a", Action: "create"},
+			{Address: "aws_subnet.b", Action: "delete"},
+		},
+	}}
+
+	w := new(bytes.Buffer)
+	assert.NoError(t, terraform.GetPlan(ref, "", c, w))
+	assert.Equal(t, "Plan plan-main-abc123: 1 to create, 0 to update, 0 to replace, 1 to delete.\nACTION\tADDRESS\ncreate\taws_subnet.a\ndelete\taws_subnet.b\n", w.String())
+
+	c = &fakeClient{plan: &terraform.Plan{Error: "plan not found"}}
+	assert.EqualError(t, terraform.GetPlan(ref, "", c, new(bytes.Buffer)), "unable to retrieve plan of terraform object management/flux-system/vpc: plan not found")
+}
+
+func TestWaitForReady(t *testing.T) {
+	ref := terraform.ObjectRef{ClusterName: "management", Namespace: "flux-system", Name: "vpc"}
+	opts := terraform.WaitOptions{Timeout: time.Second, Interval: time.Millisecond}
+
+	notReady := terraform.Object{LastUpdatedAt: "2023-10-02T10:00:00Z", Conditions: []terraform.Condition{{Type: "Ready", Status: "Unknown", Reason: "Progressing"}}}
+	ready := terraform.Object{LastUpdatedAt: "2023-10-02T10:05:00Z", Conditions: []terraform.Condition{{Type: "Ready", Status: "True"}}}
+	failed := terraform.Object{LastUpdatedAt: "2023-10-02T10:05:00Z", Conditions: []terraform.Condition{{Type: "Ready", Status: "False", Reason: "TerraformPlannedFailed", Message: "error: invalid provider"}}}
+	stale := terraform.Object{LastUpdatedAt: "2023-10-02T10:00:00Z", Conditions: []terraform.Condition{{Type: "Ready", Status: "True"}}}
+
+	t.Run("becomes ready", func(t *testing.T) {
+		c := &fakeClient{states: []terraform.Object{notReady, notReady, ready}}
+		w := new(bytes.Buffer)
+		assert.NoError(t, terraform.WaitForReady([]terraform.ObjectRef{ref}, nil, opts, c, w))
+		assert.Equal(t, "Terraform object management/flux-system/vpc is ready\n", w.String())
+	})
+
+	t.Run("planned", func(t *testing.T) {
+		planned := terraform.Object{LastUpdatedAt: "2023-10-02T10:05:00Z", Conditions: []terraform.Condition{
+			{Type: "Ready", Status: "Unknown", Reason: "TerraformPlannedWithChanges"},
+			{Type: "Plan", Status: "True", Reason: "TerraformPlannedWithChanges", Message: "Plan generated"},
+		}}
+		c := &fakeClient{states: []terraform.Object{stale, planned}}
+		since := map[terraform.ObjectRef]string{ref: stale.LastUpdatedAt}
+		w := new(bytes.Buffer)
+		assert.NoError(t, terraform.WaitForReady([]terraform.ObjectRef{ref}, since, terraform.WaitOptions{Timeout: time.Second, Interval: time.Millisecond, ConditionType: terraform.ConditionPlan}, c, w))
+		assert.Equal(t, "Terraform object management/flux-system/vpc: Plan generated\n", w.String())
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		c := &fakeClient{states: []terraform.Object{notReady, failed}}
+		err := terraform.WaitForReady([]terraform.ObjectRef{ref}, nil, opts, c, new(bytes.Buffer))
+		assert.EqualError(t, err, "terraform object management/flux-system/vpc failed to reconcile: error: invalid provider")
+	})
+
+	t.Run("waits for a new reconciliation", func(t *testing.T) {
+		c := &fakeClient{states: []terraform.Object{stale}}
+		since := map[terraform.ObjectRef]string{ref: stale.LastUpdatedAt}
+		err := terraform.WaitForReady([]terraform.ObjectRef{ref}, since, terraform.WaitOptions{Timeout: 10 * time.Millisecond, Interval: time.Millisecond}, c, new(bytes.Buffer))
+		assert.EqualError(t, err, "timed out waiting for the Ready condition of terraform objects: management/flux-system/vpc")
+	})
+}
+
+type fakeClient struct {
+	objects    []terraform.Object
+	listErrors []terraform.ListError
+	plan       *terraform.Plan
+	report     *terraform.DriftReport
+	// states are returned in turn when retrieving an object, the last one
+	// is returned once exhausted.
+	states []terraform.Object
+	err    error
+}
+
+func (c *fakeClient) Source() string {
+	return "In-memory fake"
+}
+
+func (c *fakeClient) RetrieveTerraformObjects(namespace, clusterSelector string) ([]terraform.Object, []terraform.ListError, error) {
+	if c.err != nil {
+		return nil, nil, c.err
+	}
+
+	selector, err := labels.Parse(clusterSelector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects := []terraform.Object{}
+	for _, o := range c.objects {
+		if (namespace == "" || o.Namespace == namespace) && selector.Matches(labels.Set(testClusterLabels[o.ClusterName])) {
+			objects = append(objects, o)
+		}
+	}
+
+	return objects, c.listErrors, nil
+}
+
+func (c *fakeClient) RetrieveTerraformObject(terraform.ObjectRef) (*terraform.Object, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	o := c.states[0]
+	if len(c.states) > 1 {
+		c.states = c.states[1:]
+	}
+
+	return &o, nil
+}
+
+func (c *fakeClient) RetrieveTerraformObjectPlan(terraform.ObjectRef) (*terraform.Plan, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.plan, nil
+}
+
+func (c *fakeClient) SyncTerraformObjects([]terraform.ObjectRef) error {
+	return c.err
+}
+
+func (c *fakeClient) ToggleSuspendTerraformObjects([]terraform.ObjectRef, bool) error {
+	return c.err
+}
+
+func (c *fakeClient) ReplanTerraformObject(terraform.ObjectRef) error {
+	return c.err
+}
+
+func (c *fakeClient) RetrieveTerraformDriftReport(terraform.DriftReportParams) (*terraform.DriftReport, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return c.report, nil
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	helm "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	"github.com/go-logr/logr"
 	gitopssetsv1 "github.com/weaveworks/gitopssets-controller/api/v1alpha1"
 	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
@@ -34,6 +36,8 @@ func BuildScheme() *runtime.Scheme {
 	utilruntime.Must(gitopssetsv1.AddToScheme(scheme))
 	utilruntime.Must(rbacv1.AddToScheme(scheme))
 	utilruntime.Must(appsv1.AddToScheme(scheme))
+	utilruntime.Must(kustomizev1.AddToScheme(scheme))
+	utilruntime.Must(sourcev1.AddToScheme(scheme))
 
 	return scheme
 }
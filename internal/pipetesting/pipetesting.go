@@ -12,7 +12,9 @@ import (
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/pipelines/server"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -24,7 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func SetupServer(t *testing.T, fact clustersmngr.ClustersManager, c client.Client, cluster string, pipelineControllerAddress string, gitProvider git.Provider) pb.PipelinesClient {
+func SetupServer(t *testing.T, fact clustersmngr.ClustersManager, c client.Client, cluster string, pipelineControllerAddress string, gitProvider git.Provider, opts ...grpc.ServerOption) pb.PipelinesClient {
 	mgmtFetcher := mgmtfetcher.NewManagementCrossNamespacesFetcher(&mgmtfetcherfake.FakeNamespaceCache{
 		Namespaces: []*v1.Namespace{
 			{
@@ -60,11 +62,38 @@ func SetupServer(t *testing.T, fact clustersmngr.ClustersManager, c client.Clien
 
 	conn := grpctesting.Setup(t, func(s *grpc.Server) {
 		pb.RegisterPipelinesServer(s, pipeSrv)
-	})
+	}, opts...)
 
 	return pb.NewPipelinesClient(conn)
 }
 
+// WithPrincipalFromMetadata sets the request principal from the "user" and
+// "groups" metadata sent with AsUser.
+func WithPrincipalFromMetadata() grpc.ServerOption {
+	return grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok && len(md.Get("user")) > 0 {
+			ctx = auth.WithPrincipal(ctx, &auth.UserPrincipal{
+				ID:     md.Get("user")[0],
+				Groups: md.Get("groups"),
+			})
+		}
+
+		return handler(ctx, req)
+	})
+}
+
+// AsUser returns a context to call a server set up with
+// WithPrincipalFromMetadata as the given user.
+func AsUser(ctx context.Context, id string, groups ...string) context.Context {
+	kv := []string{"user", id}
+	for _, g := range groups {
+		kv = append(kv, "groups", g)
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
 func NewNamespace(ctx context.Context, t *testing.T, k client.Client) v1.Namespace {
 	ns := v1.Namespace{}
 	ns.Name = "kube-test-" + rand.String(5)
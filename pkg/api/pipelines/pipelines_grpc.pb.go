@@ -23,10 +23,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Pipelines_ListPipelines_FullMethodName    = "/pipelines.v1.Pipelines/ListPipelines"
-	Pipelines_GetPipeline_FullMethodName      = "/pipelines.v1.Pipelines/GetPipeline"
-	Pipelines_ApprovePromotion_FullMethodName = "/pipelines.v1.Pipelines/ApprovePromotion"
-	Pipelines_ListPullRequests_FullMethodName = "/pipelines.v1.Pipelines/ListPullRequests"
+	Pipelines_ListPipelines_FullMethodName            = "/pipelines.v1.Pipelines/ListPipelines"
+	Pipelines_GetPipeline_FullMethodName              = "/pipelines.v1.Pipelines/GetPipeline"
+	Pipelines_ApprovePromotion_FullMethodName         = "/pipelines.v1.Pipelines/ApprovePromotion"
+	Pipelines_ListPullRequests_FullMethodName         = "/pipelines.v1.Pipelines/ListPullRequests"
+	Pipelines_ListPromotionHistory_FullMethodName     = "/pipelines.v1.Pipelines/ListPromotionHistory"
+	Pipelines_ListPendingApprovals_FullMethodName     = "/pipelines.v1.Pipelines/ListPendingApprovals"
+	Pipelines_DiffPipelineEnvironments_FullMethodName = "/pipelines.v1.Pipelines/DiffPipelineEnvironments"
+	Pipelines_PreviewPromotion_FullMethodName         = "/pipelines.v1.Pipelines/PreviewPromotion"
 )
 
 // PipelinesClient is the client API for Pipelines service.
@@ -41,6 +45,19 @@ type PipelinesClient interface {
 	ApprovePromotion(ctx context.Context, in *ApprovePromotionRequest, opts ...grpc.CallOption) (*ApprovePromotionResponse, error)
 	// FIXME
 	ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error)
+	// ListPromotionHistory returns, for each environment of a pipeline,
+	// a timeline of the approvals, deployments and events recorded for it.
+	ListPromotionHistory(ctx context.Context, in *ListPromotionHistoryRequest, opts ...grpc.CallOption) (*ListPromotionHistoryResponse, error)
+	// ListPendingApprovals returns the approvals collected so far for the
+	// revisions waiting to be promoted in a pipeline.
+	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	// DiffPipelineEnvironments compares what is deployed in two environments
+	// of a pipeline.
+	DiffPipelineEnvironments(ctx context.Context, in *DiffPipelineEnvironmentsRequest, opts ...grpc.CallOption) (*DiffPipelineEnvironmentsResponse, error)
+	// PreviewPromotion computes the changes a pull request promotion of a
+	// revision into an environment would make, without creating the pull
+	// request.
+	PreviewPromotion(ctx context.Context, in *PreviewPromotionRequest, opts ...grpc.CallOption) (*PreviewPromotionResponse, error)
 }
 
 type pipelinesClient struct {
@@ -87,6 +104,42 @@ func (c *pipelinesClient) ListPullRequests(ctx context.Context, in *ListPullRequ
 	return out, nil
 }
 
+func (c *pipelinesClient) ListPromotionHistory(ctx context.Context, in *ListPromotionHistoryRequest, opts ...grpc.CallOption) (*ListPromotionHistoryResponse, error) {
+	out := new(ListPromotionHistoryResponse)
+	err := c.cc.Invoke(ctx, Pipelines_ListPromotionHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelinesClient) ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error) {
+	out := new(ListPendingApprovalsResponse)
+	err := c.cc.Invoke(ctx, Pipelines_ListPendingApprovals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelinesClient) DiffPipelineEnvironments(ctx context.Context, in *DiffPipelineEnvironmentsRequest, opts ...grpc.CallOption) (*DiffPipelineEnvironmentsResponse, error) {
+	out := new(DiffPipelineEnvironmentsResponse)
+	err := c.cc.Invoke(ctx, Pipelines_DiffPipelineEnvironments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pipelinesClient) PreviewPromotion(ctx context.Context, in *PreviewPromotionRequest, opts ...grpc.CallOption) (*PreviewPromotionResponse, error) {
+	out := new(PreviewPromotionResponse)
+	err := c.cc.Invoke(ctx, Pipelines_PreviewPromotion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PipelinesServer is the server API for Pipelines service.
 // All implementations must embed UnimplementedPipelinesServer
 // for forward compatibility
@@ -99,6 +152,19 @@ type PipelinesServer interface {
 	ApprovePromotion(context.Context, *ApprovePromotionRequest) (*ApprovePromotionResponse, error)
 	// FIXME
 	ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error)
+	// ListPromotionHistory returns, for each environment of a pipeline,
+	// a timeline of the approvals, deployments and events recorded for it.
+	ListPromotionHistory(context.Context, *ListPromotionHistoryRequest) (*ListPromotionHistoryResponse, error)
+	// ListPendingApprovals returns the approvals collected so far for the
+	// revisions waiting to be promoted in a pipeline.
+	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	// DiffPipelineEnvironments compares what is deployed in two environments
+	// of a pipeline.
+	DiffPipelineEnvironments(context.Context, *DiffPipelineEnvironmentsRequest) (*DiffPipelineEnvironmentsResponse, error)
+	// PreviewPromotion computes the changes a pull request promotion of a
+	// revision into an environment would make, without creating the pull
+	// request.
+	PreviewPromotion(context.Context, *PreviewPromotionRequest) (*PreviewPromotionResponse, error)
 	mustEmbedUnimplementedPipelinesServer()
 }
 
@@ -118,6 +184,18 @@ func (UnimplementedPipelinesServer) ApprovePromotion(context.Context, *ApprovePr
 func (UnimplementedPipelinesServer) ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPullRequests not implemented")
 }
+func (UnimplementedPipelinesServer) ListPromotionHistory(context.Context, *ListPromotionHistoryRequest) (*ListPromotionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotionHistory not implemented")
+}
+func (UnimplementedPipelinesServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
+}
+func (UnimplementedPipelinesServer) DiffPipelineEnvironments(context.Context, *DiffPipelineEnvironmentsRequest) (*DiffPipelineEnvironmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPipelineEnvironments not implemented")
+}
+func (UnimplementedPipelinesServer) PreviewPromotion(context.Context, *PreviewPromotionRequest) (*PreviewPromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPromotion not implemented")
+}
 func (UnimplementedPipelinesServer) mustEmbedUnimplementedPipelinesServer() {}
 
 // UnsafePipelinesServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +281,78 @@ func _Pipelines_ListPullRequests_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Pipelines_ListPromotionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelinesServer).ListPromotionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pipelines_ListPromotionHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelinesServer).ListPromotionHistory(ctx, req.(*ListPromotionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pipelines_ListPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelinesServer).ListPendingApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pipelines_ListPendingApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelinesServer).ListPendingApprovals(ctx, req.(*ListPendingApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pipelines_DiffPipelineEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPipelineEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelinesServer).DiffPipelineEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pipelines_DiffPipelineEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelinesServer).DiffPipelineEnvironments(ctx, req.(*DiffPipelineEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pipelines_PreviewPromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PipelinesServer).PreviewPromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pipelines_PreviewPromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PipelinesServer).PreviewPromotion(ctx, req.(*PreviewPromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Pipelines_ServiceDesc is the grpc.ServiceDesc for Pipelines service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -226,6 +376,22 @@ var Pipelines_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPullRequests",
 			Handler:    _Pipelines_ListPullRequests_Handler,
 		},
+		{
+			MethodName: "ListPromotionHistory",
+			Handler:    _Pipelines_ListPromotionHistory_Handler,
+		},
+		{
+			MethodName: "ListPendingApprovals",
+			Handler:    _Pipelines_ListPendingApprovals_Handler,
+		},
+		{
+			MethodName: "DiffPipelineEnvironments",
+			Handler:    _Pipelines_DiffPipelineEnvironments_Handler,
+		},
+		{
+			MethodName: "PreviewPromotion",
+			Handler:    _Pipelines_PreviewPromotion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/pipelines/pipelines.proto",
@@ -23,12 +23,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Terraform_ListTerraformObjects_FullMethodName          = "/terraform.v1.Terraform/ListTerraformObjects"
-	Terraform_GetTerraformObject_FullMethodName            = "/terraform.v1.Terraform/GetTerraformObject"
-	Terraform_SyncTerraformObjects_FullMethodName          = "/terraform.v1.Terraform/SyncTerraformObjects"
-	Terraform_ToggleSuspendTerraformObjects_FullMethodName = "/terraform.v1.Terraform/ToggleSuspendTerraformObjects"
-	Terraform_GetTerraformObjectPlan_FullMethodName        = "/terraform.v1.Terraform/GetTerraformObjectPlan"
-	Terraform_ReplanTerraformObject_FullMethodName         = "/terraform.v1.Terraform/ReplanTerraformObject"
+	Terraform_ListTerraformObjects_FullMethodName              = "/terraform.v1.Terraform/ListTerraformObjects"
+	Terraform_GetTerraformObject_FullMethodName                = "/terraform.v1.Terraform/GetTerraformObject"
+	Terraform_SyncTerraformObjects_FullMethodName              = "/terraform.v1.Terraform/SyncTerraformObjects"
+	Terraform_ToggleSuspendTerraformObjects_FullMethodName     = "/terraform.v1.Terraform/ToggleSuspendTerraformObjects"
+	Terraform_GetTerraformObjectPlan_FullMethodName            = "/terraform.v1.Terraform/GetTerraformObjectPlan"
+	Terraform_ReplanTerraformObject_FullMethodName             = "/terraform.v1.Terraform/ReplanTerraformObject"
+	Terraform_ListTerraformObjectPlans_FullMethodName          = "/terraform.v1.Terraform/ListTerraformObjectPlans"
+	Terraform_DiffTerraformObjectPlans_FullMethodName          = "/terraform.v1.Terraform/DiffTerraformObjectPlans"
+	Terraform_ApproveTerraformPlan_FullMethodName              = "/terraform.v1.Terraform/ApproveTerraformPlan"
+	Terraform_RejectTerraformPlan_FullMethodName               = "/terraform.v1.Terraform/RejectTerraformPlan"
+	Terraform_ListTerraformObjectOutputs_FullMethodName        = "/terraform.v1.Terraform/ListTerraformObjectOutputs"
+	Terraform_ListTerraformObjectStateResources_FullMethodName = "/terraform.v1.Terraform/ListTerraformObjectStateResources"
+	Terraform_GetTerraformDriftReport_FullMethodName           = "/terraform.v1.Terraform/GetTerraformDriftReport"
 )
 
 // TerraformClient is the client API for Terraform service.
@@ -47,6 +54,20 @@ type TerraformClient interface {
 	GetTerraformObjectPlan(ctx context.Context, in *GetTerraformObjectPlanRequest, opts ...grpc.CallOption) (*GetTerraformObjectPlanResponse, error)
 	// Replan a terraform object
 	ReplanTerraformObject(ctx context.Context, in *ReplanTerraformObjectRequest, opts ...grpc.CallOption) (*ReplanTerraformObjectResponse, error)
+	// List the plans recorded for a terraform object, newest first
+	ListTerraformObjectPlans(ctx context.Context, in *ListTerraformObjectPlansRequest, opts ...grpc.CallOption) (*ListTerraformObjectPlansResponse, error)
+	// Compare two plans recorded for a terraform object
+	DiffTerraformObjectPlans(ctx context.Context, in *DiffTerraformObjectPlansRequest, opts ...grpc.CallOption) (*DiffTerraformObjectPlansResponse, error)
+	// Approve the pending plan of a terraform object
+	ApproveTerraformPlan(ctx context.Context, in *ApproveTerraformPlanRequest, opts ...grpc.CallOption) (*ApproveTerraformPlanResponse, error)
+	// Reject the pending plan of a terraform object
+	RejectTerraformPlan(ctx context.Context, in *RejectTerraformPlanRequest, opts ...grpc.CallOption) (*RejectTerraformPlanResponse, error)
+	// List the outputs a terraform object writes to its output secret
+	ListTerraformObjectOutputs(ctx context.Context, in *ListTerraformObjectOutputsRequest, opts ...grpc.CallOption) (*ListTerraformObjectOutputsResponse, error)
+	// List the resources in the state of a terraform object
+	ListTerraformObjectStateResources(ctx context.Context, in *ListTerraformObjectStateResourcesRequest, opts ...grpc.CallOption) (*ListTerraformObjectStateResourcesResponse, error)
+	// Report the drifted terraform objects across all clusters
+	GetTerraformDriftReport(ctx context.Context, in *GetTerraformDriftReportRequest, opts ...grpc.CallOption) (*GetTerraformDriftReportResponse, error)
 }
 
 type terraformClient struct {
@@ -111,6 +132,69 @@ func (c *terraformClient) ReplanTerraformObject(ctx context.Context, in *ReplanT
 	return out, nil
 }
 
+func (c *terraformClient) ListTerraformObjectPlans(ctx context.Context, in *ListTerraformObjectPlansRequest, opts ...grpc.CallOption) (*ListTerraformObjectPlansResponse, error) {
+	out := new(ListTerraformObjectPlansResponse)
+	err := c.cc.Invoke(ctx, Terraform_ListTerraformObjectPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terraformClient) DiffTerraformObjectPlans(ctx context.Context, in *DiffTerraformObjectPlansRequest, opts ...grpc.CallOption) (*DiffTerraformObjectPlansResponse, error) {
+	out := new(DiffTerraformObjectPlansResponse)
+	err := c.cc.Invoke(ctx, Terraform_DiffTerraformObjectPlans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terraformClient) ApproveTerraformPlan(ctx context.Context, in *ApproveTerraformPlanRequest, opts ...grpc.CallOption) (*ApproveTerraformPlanResponse, error) {
+	out := new(ApproveTerraformPlanResponse)
+	err := c.cc.Invoke(ctx, Terraform_ApproveTerraformPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terraformClient) RejectTerraformPlan(ctx context.Context, in *RejectTerraformPlanRequest, opts ...grpc.CallOption) (*RejectTerraformPlanResponse, error) {
+	out := new(RejectTerraformPlanResponse)
+	err := c.cc.Invoke(ctx, Terraform_RejectTerraformPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terraformClient) ListTerraformObjectOutputs(ctx context.Context, in *ListTerraformObjectOutputsRequest, opts ...grpc.CallOption) (*ListTerraformObjectOutputsResponse, error) {
+	out := new(ListTerraformObjectOutputsResponse)
+	err := c.cc.Invoke(ctx, Terraform_ListTerraformObjectOutputs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terraformClient) ListTerraformObjectStateResources(ctx context.Context, in *ListTerraformObjectStateResourcesRequest, opts ...grpc.CallOption) (*ListTerraformObjectStateResourcesResponse, error) {
+	out := new(ListTerraformObjectStateResourcesResponse)
+	err := c.cc.Invoke(ctx, Terraform_ListTerraformObjectStateResources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *terraformClient) GetTerraformDriftReport(ctx context.Context, in *GetTerraformDriftReportRequest, opts ...grpc.CallOption) (*GetTerraformDriftReportResponse, error) {
+	out := new(GetTerraformDriftReportResponse)
+	err := c.cc.Invoke(ctx, Terraform_GetTerraformDriftReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TerraformServer is the server API for Terraform service.
 // All implementations must embed UnimplementedTerraformServer
 // for forward compatibility
@@ -127,6 +211,20 @@ type TerraformServer interface {
 	GetTerraformObjectPlan(context.Context, *GetTerraformObjectPlanRequest) (*GetTerraformObjectPlanResponse, error)
 	// Replan a terraform object
 	ReplanTerraformObject(context.Context, *ReplanTerraformObjectRequest) (*ReplanTerraformObjectResponse, error)
+	// List the plans recorded for a terraform object, newest first
+	ListTerraformObjectPlans(context.Context, *ListTerraformObjectPlansRequest) (*ListTerraformObjectPlansResponse, error)
+	// Compare two plans recorded for a terraform object
+	DiffTerraformObjectPlans(context.Context, *DiffTerraformObjectPlansRequest) (*DiffTerraformObjectPlansResponse, error)
+	// Approve the pending plan of a terraform object
+	ApproveTerraformPlan(context.Context, *ApproveTerraformPlanRequest) (*ApproveTerraformPlanResponse, error)
+	// Reject the pending plan of a terraform object
+	RejectTerraformPlan(context.Context, *RejectTerraformPlanRequest) (*RejectTerraformPlanResponse, error)
+	// List the outputs a terraform object writes to its output secret
+	ListTerraformObjectOutputs(context.Context, *ListTerraformObjectOutputsRequest) (*ListTerraformObjectOutputsResponse, error)
+	// List the resources in the state of a terraform object
+	ListTerraformObjectStateResources(context.Context, *ListTerraformObjectStateResourcesRequest) (*ListTerraformObjectStateResourcesResponse, error)
+	// Report the drifted terraform objects across all clusters
+	GetTerraformDriftReport(context.Context, *GetTerraformDriftReportRequest) (*GetTerraformDriftReportResponse, error)
 	mustEmbedUnimplementedTerraformServer()
 }
 
@@ -152,6 +250,27 @@ func (UnimplementedTerraformServer) GetTerraformObjectPlan(context.Context, *Get
 func (UnimplementedTerraformServer) ReplanTerraformObject(context.Context, *ReplanTerraformObjectRequest) (*ReplanTerraformObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplanTerraformObject not implemented")
 }
+func (UnimplementedTerraformServer) ListTerraformObjectPlans(context.Context, *ListTerraformObjectPlansRequest) (*ListTerraformObjectPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTerraformObjectPlans not implemented")
+}
+func (UnimplementedTerraformServer) DiffTerraformObjectPlans(context.Context, *DiffTerraformObjectPlansRequest) (*DiffTerraformObjectPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffTerraformObjectPlans not implemented")
+}
+func (UnimplementedTerraformServer) ApproveTerraformPlan(context.Context, *ApproveTerraformPlanRequest) (*ApproveTerraformPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTerraformPlan not implemented")
+}
+func (UnimplementedTerraformServer) RejectTerraformPlan(context.Context, *RejectTerraformPlanRequest) (*RejectTerraformPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTerraformPlan not implemented")
+}
+func (UnimplementedTerraformServer) ListTerraformObjectOutputs(context.Context, *ListTerraformObjectOutputsRequest) (*ListTerraformObjectOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTerraformObjectOutputs not implemented")
+}
+func (UnimplementedTerraformServer) ListTerraformObjectStateResources(context.Context, *ListTerraformObjectStateResourcesRequest) (*ListTerraformObjectStateResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTerraformObjectStateResources not implemented")
+}
+func (UnimplementedTerraformServer) GetTerraformDriftReport(context.Context, *GetTerraformDriftReportRequest) (*GetTerraformDriftReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTerraformDriftReport not implemented")
+}
 func (UnimplementedTerraformServer) mustEmbedUnimplementedTerraformServer() {}
 
 // UnsafeTerraformServer may be embedded to opt out of forward compatibility for this service.
@@ -273,6 +392,132 @@ func _Terraform_ReplanTerraformObject_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Terraform_ListTerraformObjectPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTerraformObjectPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).ListTerraformObjectPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_ListTerraformObjectPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).ListTerraformObjectPlans(ctx, req.(*ListTerraformObjectPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Terraform_DiffTerraformObjectPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTerraformObjectPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).DiffTerraformObjectPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_DiffTerraformObjectPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).DiffTerraformObjectPlans(ctx, req.(*DiffTerraformObjectPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Terraform_ApproveTerraformPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTerraformPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).ApproveTerraformPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_ApproveTerraformPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).ApproveTerraformPlan(ctx, req.(*ApproveTerraformPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Terraform_RejectTerraformPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTerraformPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).RejectTerraformPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_RejectTerraformPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).RejectTerraformPlan(ctx, req.(*RejectTerraformPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Terraform_ListTerraformObjectOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTerraformObjectOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).ListTerraformObjectOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_ListTerraformObjectOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).ListTerraformObjectOutputs(ctx, req.(*ListTerraformObjectOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Terraform_ListTerraformObjectStateResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTerraformObjectStateResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).ListTerraformObjectStateResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_ListTerraformObjectStateResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).ListTerraformObjectStateResources(ctx, req.(*ListTerraformObjectStateResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Terraform_GetTerraformDriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTerraformDriftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerraformServer).GetTerraformDriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Terraform_GetTerraformDriftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerraformServer).GetTerraformDriftReport(ctx, req.(*GetTerraformDriftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Terraform_ServiceDesc is the grpc.ServiceDesc for Terraform service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -304,6 +549,34 @@ var Terraform_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReplanTerraformObject",
 			Handler:    _Terraform_ReplanTerraformObject_Handler,
 		},
+		{
+			MethodName: "ListTerraformObjectPlans",
+			Handler:    _Terraform_ListTerraformObjectPlans_Handler,
+		},
+		{
+			MethodName: "DiffTerraformObjectPlans",
+			Handler:    _Terraform_DiffTerraformObjectPlans_Handler,
+		},
+		{
+			MethodName: "ApproveTerraformPlan",
+			Handler:    _Terraform_ApproveTerraformPlan_Handler,
+		},
+		{
+			MethodName: "RejectTerraformPlan",
+			Handler:    _Terraform_RejectTerraformPlan_Handler,
+		},
+		{
+			MethodName: "ListTerraformObjectOutputs",
+			Handler:    _Terraform_ListTerraformObjectOutputs_Handler,
+		},
+		{
+			MethodName: "ListTerraformObjectStateResources",
+			Handler:    _Terraform_ListTerraformObjectStateResources_Handler,
+		},
+		{
+			MethodName: "GetTerraformDriftReport",
+			Handler:    _Terraform_GetTerraformDriftReport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/terraform/terraform.proto",
@@ -21,7 +21,7 @@ func Bootstrap(config steps.Config) error {
 		return fmt.Errorf("cannot create check ui: %v", err)
 	}
 
-	installWge, err := steps.NewInstallWGEStep(config.WgeConfig, config.Logger)
+	installWge, err := steps.NewInstallWGEStep(config.WgeConfig, config.ModesConfig, config.Logger)
 	if err != nil {
 		return fmt.Errorf("cannot create install WGE: %v", err)
 	}
@@ -40,6 +40,7 @@ func Bootstrap(config steps.Config) error {
 		steps.NewOIDCConfigStep(config),
 		componentsExtra,
 		checkUiDomain,
+		steps.NewVerifyStep(config.ModesConfig),
 	}
 
 	return execute(config, workflow)
@@ -67,5 +68,12 @@ func execute(config steps.Config, worfklow []steps.BootstrapStep) error {
 		}
 	}
 
+	if config.ModesConfig.DryRun {
+		config.Logger.Actionf("planned actions")
+		if err := steps.PrintPlan(config.OutWriter, config.Plan, config.PlanFormat); err != nil {
+			return fmt.Errorf("error printing plan: %v", err)
+		}
+	}
+
 	return nil
 }
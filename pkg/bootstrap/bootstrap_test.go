@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	. "github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/steps"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 func Test_executeSteps(t *testing.T) {
@@ -35,4 +37,29 @@ func Test_executeSteps(t *testing.T) {
 		assert.Contains(t, buf.String(), "cluster-user-auth")
 	})
 
+	t.Run("should support dry-run", func(t *testing.T) {
+		var planBuf bytes.Buffer
+		config := MakeTestConfig(t, Config{
+			OutWriter: &planBuf,
+			ClusterUserAuth: ClusterUserAuthConfig{
+				Password: "password123",
+			},
+			ModesConfig: ModesConfig{
+				Silent: true,
+				DryRun: true,
+			},
+			PlanFormat: PlanFormatJSON,
+		})
+		clusterUserAuthStep, err := NewAskAdminCredsSecretStep(config.ClusterUserAuth, config.ModesConfig)
+		assert.NoError(t, err)
+
+		err = execute(config, []BootstrapStep{clusterUserAuthStep})
+		assert.NoError(t, err)
+		assert.Contains(t, planBuf.String(), `"action": "create"`)
+		assert.Contains(t, planBuf.String(), `"name": "flux-system/cluster-user-auth"`)
+
+		_, err = utils.GetSecret(config.KubernetesClient, "cluster-user-auth", "flux-system")
+		assert.True(t, apierrors.IsNotFound(err), "dry-run should not create the secret")
+	})
+
 }
@@ -0,0 +1,15 @@
+package bootstrap
+
+import (
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/steps"
+)
+
+// Doctor initiated by the command verifies an existing WGE installation. It runs the same health checks
+// as the bootstrap verification, and collects a support bundle in case of failure.
+func Doctor(config steps.Config) error {
+	var workflow = []steps.BootstrapStep{
+		steps.NewVerifyStep(config.ModesConfig),
+	}
+
+	return execute(config, workflow)
+}
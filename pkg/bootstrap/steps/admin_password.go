@@ -43,6 +43,8 @@ type ClusterUserAuthConfig struct {
 	Username         string
 	Password         string
 	ExistCredentials bool
+	// PasswordUnchanged indicates that the password is the one of the existing credentials
+	PasswordUnchanged bool
 }
 
 // NewClusterUserAuthConfig creates new configuration out of the user input and discovered state
@@ -51,9 +53,10 @@ func NewClusterUserAuthConfig(password string, client k8sclient.Client) (Cluster
 		return ClusterUserAuthConfig{}, fmt.Errorf("password minimum characters should be >= 6")
 	}
 	return ClusterUserAuthConfig{
-		Username:         defaultAdminUsername,
-		Password:         password,
-		ExistCredentials: isExistingAdminSecret(client),
+		Username:          defaultAdminUsername,
+		Password:          password,
+		ExistCredentials:  isExistingAdminSecret(client),
+		PasswordUnchanged: isExistingAdminPassword(client, password),
 	}, nil
 }
 
@@ -76,7 +79,16 @@ func NewAskAdminCredsSecretStep(config ClusterUserAuthConfig, modes ModesConfig)
 		}
 	} else {
 		if config.ExistCredentials {
-			if config.Password != "" {
+			// re-running a declarative bootstrap keeps the credentials when they are unchanged
+			// and updates them to the password of the config file otherwise
+			if modes.Declarative && config.PasswordUnchanged {
+				return BootstrapStep{
+					Name:  "user authentication",
+					Input: inputs,
+					Step:  doNothingStep,
+				}, nil
+			}
+			if config.Password != "" && !modes.Declarative {
 				return BootstrapStep{}, fmt.Errorf(adminSecretExistsErrorMsgFormat, adminSecretName, WGEDefaultNamespace)
 			}
 
@@ -150,3 +162,15 @@ func isExistingAdminSecret(client k8sclient.Client) bool {
 	_, err := utils.GetSecret(client, adminSecretName, WGEDefaultNamespace)
 	return err == nil
 }
+
+// isExistingAdminPassword checks whether the password is the one of the admin secret on management cluster
+func isExistingAdminPassword(client k8sclient.Client, password string) bool {
+	if password == "" {
+		return false
+	}
+	secret, err := utils.GetSecret(client, adminSecretName, WGEDefaultNamespace)
+	if err != nil {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword(secret.Data["password"], []byte(password)) == nil
+}
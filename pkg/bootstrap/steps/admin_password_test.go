@@ -91,6 +91,37 @@ func TestNewAskAdminCredsSecretStep(t *testing.T) {
 			want:    BootstrapStep{},
 			wantErr: "admin login credentials already exist on the cluster. To reset admin credentials please remove secret 'cluster-user-auth' in namespace 'flux-system'",
 		},
+		{
+			name: "should keep existing credentials in declarative mode if password is unchanged",
+			modes: ModesConfig{
+				Silent:      true,
+				Declarative: true,
+			},
+			config: ClusterUserAuthConfig{
+				ExistCredentials:  true,
+				Password:          "password123",
+				PasswordUnchanged: true,
+			},
+			want: BootstrapStep{
+				Name:  "user authentication",
+				Input: []StepInput{},
+			},
+		},
+		{
+			name: "should update existing credentials in declarative mode if password changed",
+			modes: ModesConfig{
+				Silent:      true,
+				Declarative: true,
+			},
+			config: ClusterUserAuthConfig{
+				ExistCredentials: true,
+				Password:         "password124",
+			},
+			want: BootstrapStep{
+				Name:  "user authentication",
+				Input: []StepInput{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
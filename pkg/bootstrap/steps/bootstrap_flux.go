@@ -122,10 +122,32 @@ func configureFluxCreds(input []StepInput, c *Config) ([]StepOutput, error) {
 		return []StepOutput{}, nil
 	}
 
-	c.Logger.Waitingf("bootstrapping flux ...")
-	err := bootstrapFlux(c)
-	if err != nil {
-		return []StepOutput{}, fmt.Errorf("failed to bootstrap flux: %v", err)
+	if c.GitHubApp != nil {
+		if err := checkGitHubAppFluxVersion(); err != nil {
+			return []StepOutput{}, err
+		}
+	}
+
+	if c.ModesConfig.DryRun {
+		c.Plan = append(c.Plan, PlannedAction{
+			Step:    bootstrapFluxStepName,
+			Action:  actionBootstrap,
+			Kind:    "Flux",
+			Name:    c.GitRepository.Url,
+			Details: fmt.Sprintf("flux bootstrap git --branch %s --path %s", c.GitRepository.Branch, c.GitRepository.Path),
+		})
+	} else {
+		c.Logger.Waitingf("bootstrapping flux ...")
+		err := bootstrapFlux(c)
+		if err != nil {
+			return []StepOutput{}, fmt.Errorf("failed to bootstrap flux: %v", err)
+		}
+	}
+
+	// flux bootstrap authenticates with the installation token that expires, so flux is
+	// configured to authenticate as the GitHub App instead
+	if c.GitHubApp != nil {
+		return githubAppFluxOutputs(c), nil
 	}
 
 	return []StepOutput{}, nil
@@ -0,0 +1,58 @@
+package steps
+
+const (
+	capi = "capi"
+)
+
+const (
+	capiInfrastructureMsg        = "please enter the Cluster API infrastructure providers to install, comma separated (example: aws,azure)"
+	capiChartURL                 = "https://kubernetes-sigs.github.io/cluster-api-operator"
+	capiChartName                = "cluster-api-operator"
+	capiChartVersion             = "0.7.x"
+	capiFileName                 = "capi.yaml"
+	capiCommitMsg                = "Add Cluster API Operator HelmRelease"
+	capiRemoveCommitMsg          = "Remove Cluster API Operator HelmRelease"
+	capiDefaultInfrastructure    = "docker"
+	capiCoreProvider             = "cluster-api"
+	capiDefaultTargetNamespace   = "capi-operator-system"
+	inCapiInfrastructureProvider = "capiInfrastructureProvider"
+)
+
+// capiComponent installs the Cluster API operator with the core and infrastructure providers and
+// enables the CAPI templates and clusters in the dashboard
+var capiComponent = Component{
+	Name:            capi,
+	DisplayName:     "Cluster API",
+	FileName:        capiFileName,
+	CommitMsg:       capiCommitMsg,
+	RemoveCommitMsg: capiRemoveCommitMsg,
+	Chart: &ComponentChart{
+		RepositoryURL:   capiChartURL,
+		Chart:           capiChartName,
+		Version:         capiChartVersion,
+		TargetNamespace: capiDefaultTargetNamespace,
+		DefaultValues: map[string]interface{}{
+			"core": capiCoreProvider,
+			"cert-manager": map[string]interface{}{
+				"enabled": false,
+			},
+		},
+	},
+	Inputs: []StepInput{
+		{
+			Name:         inCapiInfrastructureProvider,
+			Type:         stringInput,
+			Msg:          capiInfrastructureMsg,
+			DefaultValue: capiDefaultInfrastructure,
+		},
+	},
+	Values: func(input []StepInput, c *Config) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"infrastructure": inputValue(input, inCapiInfrastructureProvider),
+		}, nil
+	},
+	Requires: []string{certManager},
+	enableInWge: func(values *valuesFile) {
+		values.Global.CapiEnabled = true
+	},
+}
@@ -0,0 +1,31 @@
+package steps
+
+const (
+	certManager = "cert-manager"
+)
+
+const (
+	certManagerChartURL        = "https://charts.jetstack.io"
+	certManagerChartVersion    = "1.13.x"
+	certManagerFileName        = "cert-manager.yaml"
+	certManagerCommitMsg       = "Add cert-manager HelmRelease"
+	certManagerRemoveCommitMsg = "Remove cert-manager HelmRelease"
+)
+
+// certManagerComponent installs cert-manager, required by the policy agent and the Cluster API providers
+var certManagerComponent = Component{
+	Name:            certManager,
+	DisplayName:     "cert-manager",
+	FileName:        certManagerFileName,
+	CommitMsg:       certManagerCommitMsg,
+	RemoveCommitMsg: certManagerRemoveCommitMsg,
+	Chart: &ComponentChart{
+		RepositoryURL:   certManagerChartURL,
+		Chart:           certManager,
+		Version:         certManagerChartVersion,
+		TargetNamespace: certManager,
+		DefaultValues: map[string]interface{}{
+			"installCRDs": true,
+		},
+	},
+}
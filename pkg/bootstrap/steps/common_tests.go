@@ -26,6 +26,10 @@ func (f fakeGitClient) CreateFileToRepo(filename, filecontent, path, commitmsg,
 	return nil
 }
 
+func (f fakeGitClient) RemoveFileFromRepo(filename, path, commitmsg, authType, privateKeyPath, privateKeyPassword, username, token string) (bool, error) {
+	return true, nil
+}
+
 type fakeFluxClient struct {
 }
 
@@ -37,12 +41,17 @@ func (f fakeFluxClient) ReconcileHelmRelease(hrName string) error {
 	return nil
 }
 
+func (f fakeFluxClient) UninstallFlux() error {
+	return nil
+}
+
 func MakeTestConfig(t *testing.T, config Config, objects ...runtime.Object) Config {
 	fakeClient := utils.CreateFakeClient(t, objects...)
 	cliLogger := utils.CreateLogger()
 
 	return Config{
 		KubernetesClient:          fakeClient,
+		KubernetesClientSet:       config.KubernetesClientSet,
 		GitClient:                 fakeGitClient{},
 		FluxClient:                fakeFluxClient{},
 		InReader:                  config.InReader,
@@ -58,6 +67,7 @@ func MakeTestConfig(t *testing.T, config Config, objects ...runtime.Object) Conf
 		PrivateKeyPasswordChanged: config.PrivateKeyPasswordChanged,
 		GitUsername:               config.GitUsername,
 		GitToken:                  config.GitToken,
+		GitHubApp:                 config.GitHubApp,
 		AuthType:                  config.AuthType,
 		InstallOIDC:               config.InstallOIDC,
 		DiscoveryURL:              config.DiscoveryURL,
@@ -67,6 +77,9 @@ func MakeTestConfig(t *testing.T, config Config, objects ...runtime.Object) Conf
 		RedirectURL:               config.RedirectURL,
 		PromptedForDiscoveryURL:   config.PromptedForDiscoveryURL,
 		ComponentsExtra:           config.ComponentsExtra,
+		PlanFormat:                config.PlanFormat,
+		KeepFlux:                  config.KeepFlux,
+		SupportBundlePath:         config.SupportBundlePath,
 	}
 }
 
@@ -0,0 +1,308 @@
+package steps
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	sourcev1beta2 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	"golang.org/x/exp/slices"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	componentInstallInfoMsg    = "installing %s ..."
+	componentInstallConfirmMsg = "%s is installed successfully"
+	componentReadyWaitMsg      = "waiting for %s to be ready"
+	componentRequiresMsg       = "%s requires %s, adding it to the components to install"
+	componentExistsMsg         = " not installing %s: found in the cluster"
+)
+
+// Component is an extra component that bootstrap installs on demand, like the policy-agent or
+// cert-manager. Components are registered with RegisterComponent and selected by name.
+type Component struct {
+	// Name identifies the component. It is also the name of its HelmRelease in the flux-system namespace,
+	// used to find whether it is installed.
+	Name string
+	// DisplayName is the name shown to the user.
+	DisplayName string
+	// FileName is the file committed to the repo with the component manifests.
+	FileName string
+	// CommitMsg is the message of the commits installing the component.
+	CommitMsg string
+	// RemoveCommitMsg is the message of the commit removing the component on uninstall.
+	RemoveCommitMsg string
+	// ManifestsURL is the url to download the manifests from, for components that publish them.
+	ManifestsURL string
+	// Chart is the helm chart to render the manifests from, for components that don't publish them.
+	Chart *ComponentChart
+	// Inputs are the prompts to configure the component. Silent mode uses their default values.
+	Inputs []StepInput
+	// Values returns chart values out of the inputs. They are merged over the chart default values.
+	Values func(input []StepInput, c *Config) (map[string]interface{}, error)
+	// Requires are the components this component depends on.
+	Requires []string
+	// IsReady checks the component is ready once installed. It reconciles its HelmRelease by default.
+	IsReady func(c *Config) error
+
+	// enableInWge updates the WGE values to enable the component features in the dashboard.
+	enableInWge func(values *valuesFile)
+}
+
+// ComponentChart is the helm chart of a component.
+type ComponentChart struct {
+	// RepositoryURL is the url of the helm repository.
+	RepositoryURL string
+	// Chart is the name of the chart in the repository.
+	Chart string
+	// Version is the chart version or semver range.
+	Version string
+	// TargetNamespace is the namespace to install the chart to. It is created if it doesn't exist.
+	TargetNamespace string
+	// DefaultValues are the chart values used unless overridden from the inputs.
+	DefaultValues map[string]interface{}
+}
+
+// componentsRegistry holds the registered components in installation order.
+var componentsRegistry = []Component{}
+
+func init() {
+	for _, component := range []Component{
+		certManagerComponent,
+		externalSecretsComponent,
+		capiComponent,
+		policyAgentComponent,
+		tfControllerComponent,
+	} {
+		if err := RegisterComponent(component); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterComponent adds a component to the ones bootstrap can install. Components are installed in
+// the order they are registered.
+func RegisterComponent(component Component) error {
+	if component.Name == "" || component.FileName == "" {
+		return fmt.Errorf("component requires a name and a file name")
+	}
+	if component.ManifestsURL == "" && component.Chart == nil {
+		return fmt.Errorf("component %s requires either a manifests url or a chart", component.Name)
+	}
+	if _, ok := GetComponent(component.Name); ok || component.Name == none {
+		return fmt.Errorf("component %s is already registered", component.Name)
+	}
+
+	componentsRegistry = append(componentsRegistry, component)
+	ComponentsExtra = append(ComponentsExtra, component.Name)
+	return nil
+}
+
+// GetComponent returns a registered component by name.
+func GetComponent(name string) (Component, bool) {
+	for _, component := range componentsRegistry {
+		if component.Name == name {
+			return component, true
+		}
+	}
+	return Component{}, false
+}
+
+// NewInstallComponentStep creates the installation step of a component. It does nothing if the component
+// is already installed.
+func NewInstallComponentStep(component Component, config Config) BootstrapStep {
+	if slices.Contains(config.ComponentsExtra.Existing, component.Name) {
+		config.Logger.Warningf(componentExistsMsg, component.Name)
+		return BootstrapStep{
+			Name:  fmt.Sprintf("existing %s installation", component.DisplayName),
+			Input: []StepInput{},
+			Step:  doNothingStep,
+		}
+	}
+
+	// silent mode uses the default values of the inputs
+	inputs := []StepInput{}
+	if !config.ModesConfig.Silent {
+		inputs = append(inputs, component.Inputs...)
+	}
+
+	return BootstrapStep{
+		Name:  fmt.Sprintf("install %s", component.DisplayName),
+		Input: inputs,
+		Step:  installComponent(component),
+	}
+}
+
+// installComponent renders the component manifests and, for components with dashboard
+// features, the WGE HelmRelease enabling them.
+func installComponent(component Component) func(input []StepInput, c *Config) ([]StepOutput, error) {
+	return func(input []StepInput, c *Config) ([]StepOutput, error) {
+		c.Logger.Actionf(componentInstallInfoMsg, component.DisplayName)
+
+		manifests, err := renderComponent(component, input, c)
+		if err != nil {
+			return []StepOutput{}, err
+		}
+
+		outputs := []StepOutput{
+			{
+				Name: component.FileName,
+				Type: typeFile,
+				Value: fileContent{
+					Name:      component.FileName,
+					Content:   manifests,
+					CommitMsg: component.CommitMsg,
+				},
+			},
+		}
+
+		if component.enableInWge != nil {
+			wgeValues, err := getWGEValues(c)
+			if err != nil {
+				return []StepOutput{}, err
+			}
+			component.enableInWge(&wgeValues)
+			if err := setWGEValues(c, wgeValues); err != nil {
+				return []StepOutput{}, err
+			}
+
+			wgeHelmRelease, err := constructWGEhelmRelease(wgeValues, c.WgeConfig.version())
+			if err != nil {
+				return []StepOutput{}, err
+			}
+			c.Logger.Actionf("rendered WGE HelmRelease file")
+
+			outputs = append(outputs, StepOutput{
+				Name: wgeHelmReleaseFileName,
+				Type: typeFile,
+				Value: fileContent{
+					Name:      wgeHelmReleaseFileName,
+					Content:   wgeHelmRelease,
+					CommitMsg: component.CommitMsg,
+				},
+			})
+		}
+
+		return outputs, nil
+	}
+}
+
+// renderComponent downloads the component manifests or renders them from its chart.
+func renderComponent(component Component, input []StepInput, c *Config) (string, error) {
+	if component.ManifestsURL != "" {
+		bodyBytes, err := doBasicAuthGetRequest(component.ManifestsURL, "", "")
+		if err != nil {
+			return "", fmt.Errorf("error getting %s manifests: %v", component.DisplayName, err)
+		}
+		return string(bodyBytes), nil
+	}
+
+	chart := component.Chart
+	values := map[string]interface{}{}
+	for k, v := range chart.DefaultValues {
+		values[k] = v
+	}
+	if component.Values != nil {
+		inputValues, err := component.Values(withDefaultInputs(component.Inputs, input), c)
+		if err != nil {
+			return "", fmt.Errorf("error getting %s values: %v", component.DisplayName, err)
+		}
+		for k, v := range inputValues {
+			values[k] = v
+		}
+	}
+
+	helmRepository, err := utils.CreateHelmRepositoryYamlString(sourcev1beta2.HelmRepository{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      component.Name,
+			Namespace: WGEDefaultNamespace,
+		},
+		Spec: sourcev1beta2.HelmRepositorySpec{
+			URL: chart.RepositoryURL,
+			Interval: v1.Duration{
+				Duration: time.Hour,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	valuesBytes, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	helmRelease := helmv2.HelmRelease{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      component.Name,
+			Namespace: WGEDefaultNamespace,
+		},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart: chart.Chart,
+					SourceRef: helmv2.CrossNamespaceObjectReference{
+						Name:      component.Name,
+						Namespace: WGEDefaultNamespace,
+					},
+					Version: chart.Version,
+				},
+			},
+			Install: &helmv2.Install{
+				CRDs:            helmv2.CreateReplace,
+				CreateNamespace: chart.TargetNamespace != "",
+			},
+			Upgrade: &helmv2.Upgrade{
+				CRDs: helmv2.CreateReplace,
+			},
+			TargetNamespace: chart.TargetNamespace,
+			Interval: v1.Duration{
+				Duration: time.Hour,
+			},
+			Values: &apiextensionsv1.JSON{Raw: valuesBytes},
+		},
+	}
+
+	helmReleaseContent, err := utils.CreateHelmReleaseYamlString(helmRelease)
+	if err != nil {
+		return "", err
+	}
+
+	return helmRepository + "---\n" + helmReleaseContent, nil
+}
+
+// waitForComponent checks the installed component is ready.
+func waitForComponent(component Component, c *Config) error {
+	c.Logger.Waitingf(componentReadyWaitMsg, component.DisplayName)
+	if component.IsReady != nil {
+		return component.IsReady(c)
+	}
+	return c.FluxClient.ReconcileHelmRelease(component.Name)
+}
+
+// withDefaultInputs completes the processed inputs with the default values of the ones not asked to the user.
+func withDefaultInputs(inputs []StepInput, processed []StepInput) []StepInput {
+	completed := append([]StepInput{}, processed...)
+	for _, input := range inputs {
+		if !slices.ContainsFunc(processed, func(p StepInput) bool { return p.Name == input.Name }) {
+			input.Value = input.DefaultValue
+			completed = append(completed, input)
+		}
+	}
+	return completed
+}
+
+// inputValue returns the string value of an input.
+func inputValue(input []StepInput, name string) string {
+	for _, param := range input {
+		if param.Name == name {
+			value, _ := param.Value.(string)
+			return value
+		}
+	}
+	return ""
+}
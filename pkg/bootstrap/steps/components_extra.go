@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"golang.org/x/exp/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -13,15 +14,13 @@ const (
 )
 
 const (
-	none                  = "none"
-	policyAgentController = "policy-agent"
-	tfController          = "tf-controller"
+	none = "none"
 )
 
+// ComponentsExtra are the names of the components that can be selected, the registered
+// components are added on registration.
 var ComponentsExtra = []string{
 	none,
-	policyAgentController,
-	tfController,
 }
 
 // ComponentsExtraConfig contains the configuration for the extra components
@@ -88,26 +87,55 @@ func installExtraComponents(input []StepInput, c *Config) ([]StepOutput, error)
 			}
 		}
 	}
-	for _, controller := range c.ComponentsExtra.Requested {
-		switch controller {
-		case none:
-			return []StepOutput{}, nil
-		case policyAgentController:
-			agentStep := NewInstallPolicyAgentStep(*c)
-			_, err := agentStep.Execute(c)
-			if err != nil {
-				return []StepOutput{}, fmt.Errorf("can't install policy agent: %v", err)
-			}
-		case tfController:
-			tfControllerStep := NewInstallTFControllerStep(*c)
-			_, err := tfControllerStep.Execute(c)
-			if err != nil {
-				return []StepOutput{}, fmt.Errorf("can't install tf controller: %v", err)
-			}
-		default:
-			return []StepOutput{}, fmt.Errorf("unsupported component selected: %s", controller)
+	if slices.Contains(c.ComponentsExtra.Requested, none) {
+		return []StepOutput{}, nil
+	}
+	for _, name := range c.ComponentsExtra.Requested {
+		if _, ok := GetComponent(name); !ok {
+			return []StepOutput{}, fmt.Errorf("unsupported component selected: %s", name)
+		}
+	}
+
+	c.ComponentsExtra.Requested = withRequiredComponents(c.ComponentsExtra, c.Logger)
+
+	// install in the registry order so dependencies are installed first
+	for _, component := range componentsRegistry {
+		if !slices.Contains(c.ComponentsExtra.Requested, component.Name) {
+			continue
+		}
+
+		_, err := NewInstallComponentStep(component, *c).Execute(c)
+		if err != nil {
+			return []StepOutput{}, fmt.Errorf("can't install %s: %v", component.Name, err)
 		}
+
+		if slices.Contains(c.ComponentsExtra.Existing, component.Name) || c.ModesConfig.Export || c.ModesConfig.DryRun {
+			continue
+		}
+		if err := waitForComponent(component, c); err != nil {
+			return []StepOutput{}, fmt.Errorf("%s is not ready: %v", component.Name, err)
+		}
+		c.Logger.Successf(componentInstallConfirmMsg, component.DisplayName)
 	}
 
 	return []StepOutput{}, nil
 }
+
+// withRequiredComponents returns the requested components with the components they require that are
+// not in the cluster, so a component is never waited for without its dependencies.
+func withRequiredComponents(config ComponentsExtraConfig, log logger.Logger) []string {
+	requested := append([]string{}, config.Requested...)
+	for i := 0; i < len(requested); i++ {
+		component, ok := GetComponent(requested[i])
+		if !ok {
+			continue
+		}
+		for _, required := range component.Requires {
+			if !slices.Contains(requested, required) && !slices.Contains(config.Existing, required) {
+				log.Actionf(componentRequiresMsg, component.DisplayName, required)
+				requested = append(requested, required)
+			}
+		}
+	}
+	return requested
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
 )
 
 func TestNewInstallExtraComponents(t *testing.T) {
@@ -151,3 +152,58 @@ func TestInstallExtraComponents(t *testing.T) {
 	}
 
 }
+
+func TestWithRequiredComponents(t *testing.T) {
+	tests := []struct {
+		name   string
+		config ComponentsExtraConfig
+		want   []string
+	}{
+		{
+			name:   "should add cert-manager to policy-agent installed without it",
+			config: ComponentsExtraConfig{Requested: []string{policyAgentController}},
+			want:   []string{policyAgentController, certManager},
+		},
+		{
+			name:   "should not add cert-manager when requested",
+			config: ComponentsExtraConfig{Requested: []string{certManager, policyAgentController}},
+			want:   []string{certManager, policyAgentController},
+		},
+		{
+			name:   "should not add cert-manager found in the cluster",
+			config: ComponentsExtraConfig{Requested: []string{policyAgentController}, Existing: []string{certManager}},
+			want:   []string{policyAgentController},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := MakeTestConfig(t, Config{})
+			assert.Equal(t, tt.want, withRequiredComponents(tt.config, config.Logger))
+		})
+	}
+
+	t.Run("should install the required components first", func(t *testing.T) {
+		wgeObject, err := createWGEHelmReleaseFakeObject("1.0.0")
+		assert.NoError(t, err)
+		config := MakeTestConfig(t, Config{
+			ModesConfig: ModesConfig{
+				Silent: true,
+				DryRun: true,
+			},
+			ComponentsExtra: ComponentsExtraConfig{
+				Requested: []string{capi},
+			},
+		}, &wgeObject)
+
+		_, err = installExtraComponents([]StepInput{}, &config)
+		assert.NoError(t, err)
+
+		planned := []string{}
+		for _, action := range config.Plan {
+			if !slices.Contains(planned, action.Step) {
+				planned = append(planned, action.Step)
+			}
+		}
+		assert.Equal(t, []string{"install cert-manager", "install Cluster API"}, planned)
+	})
+}
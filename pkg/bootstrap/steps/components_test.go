@@ -0,0 +1,191 @@
+package steps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const certManagerFakeFile = `apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: HelmRepository
+metadata:
+  creationTimestamp: null
+  name: cert-manager
+  namespace: flux-system
+spec:
+  interval: 1h0m0s
+  url: https://charts.jetstack.io
+status: {}
+---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  creationTimestamp: null
+  name: cert-manager
+  namespace: flux-system
+spec:
+  chart:
+    spec:
+      chart: cert-manager
+      reconcileStrategy: ChartVersion
+      sourceRef:
+        kind: HelmRepository
+        name: cert-manager
+        namespace: flux-system
+      version: 1.13.x
+  install:
+    crds: CreateReplace
+    createNamespace: true
+  interval: 1h0m0s
+  targetNamespace: cert-manager
+  upgrade:
+    crds: CreateReplace
+  values:
+    installCRDs: true
+status: {}
+`
+
+func TestRegisterComponent(t *testing.T) {
+	tests := []struct {
+		name      string
+		component Component
+		wantErr   string
+	}{
+		{
+			name:      "should fail without name",
+			component: Component{FileName: "flagger.yaml"},
+			wantErr:   "component requires a name and a file name",
+		},
+		{
+			name:      "should fail without manifests",
+			component: Component{Name: "flagger", FileName: "flagger.yaml"},
+			wantErr:   "component flagger requires either a manifests url or a chart",
+		},
+		{
+			name:      "should fail if already registered",
+			component: Component{Name: certManager, FileName: "cert-manager.yaml", Chart: &ComponentChart{}},
+			wantErr:   "component cert-manager is already registered",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, RegisterComponent(tt.component), tt.wantErr)
+		})
+	}
+
+	t.Run("should register built-in components in installation order", func(t *testing.T) {
+		assert.Equal(t, []string{none, certManager, externalSecrets, capi, policyAgentController, tfController}, ComponentsExtra)
+	})
+}
+
+func TestInstallComponent(t *testing.T) {
+	wgeObject, err := createWGEHelmReleaseFakeObject("1.0.0")
+	assert.NoError(t, err)
+
+	t.Run("should render chart components", func(t *testing.T) {
+		config := MakeTestConfig(t, Config{})
+		step := NewInstallComponentStep(certManagerComponent, config)
+		assert.Equal(t, "install cert-manager", step.Name)
+
+		out, err := step.Execute(&config)
+		assert.NoError(t, err)
+		assert.Equal(t, []StepOutput{
+			{
+				Name: certManagerFileName,
+				Type: typeFile,
+				Value: fileContent{
+					Name:      certManagerFileName,
+					Content:   certManagerFakeFile,
+					CommitMsg: certManagerCommitMsg,
+				},
+			},
+		}, out)
+	})
+
+	t.Run("should use input or default values and enable the component in WGE", func(t *testing.T) {
+		config := MakeTestConfig(t, Config{
+			ModesConfig: ModesConfig{
+				Silent: true,
+			},
+			WgeConfig: WgeConfig{
+				RequestedVersion: "1.0.0",
+			},
+		}, &wgeObject)
+		step := NewInstallComponentStep(capiComponent, config)
+		assert.Empty(t, step.Input)
+
+		out, err := step.Step([]StepInput{{Name: inCapiInfrastructureProvider, Value: "aws"}}, &config)
+		assert.NoError(t, err)
+		assert.Len(t, out, 2)
+
+		manifests := out[0].Value.(fileContent).Content
+		assert.Contains(t, manifests, "core: cluster-api")
+		assert.Contains(t, manifests, "infrastructure: aws")
+
+		out, err = step.Execute(&config)
+		assert.NoError(t, err)
+		assert.Contains(t, out[0].Value.(fileContent).Content, "infrastructure: docker")
+		assert.Contains(t, out[1].Value.(fileContent).Content, "capiEnabled: true")
+		assert.Equal(t, capiCommitMsg, out[1].Value.(fileContent).CommitMsg)
+	})
+
+	t.Run("should enable the component in the rendered WGE values in dry-run", func(t *testing.T) {
+		config := MakeTestConfig(t, Config{
+			ModesConfig: ModesConfig{
+				Silent: true,
+				DryRun: true,
+			},
+			WgeConfig: WgeConfig{
+				RequestedVersion: "1.0.0",
+			},
+		})
+
+		// the WGE HelmRelease rendered by a previous step is not in the cluster
+		_, err := installWge([]StepInput{}, &config)
+		assert.NoError(t, err)
+
+		out, err := NewInstallComponentStep(capiComponent, config).Execute(&config)
+		assert.NoError(t, err)
+		assert.Len(t, out, 2)
+		assert.Contains(t, out[1].Value.(fileContent).Content, "capiEnabled: true")
+		assert.Contains(t, out[1].Value.(fileContent).Content, "gitopssets-controller:")
+	})
+
+	t.Run("should not install existing components", func(t *testing.T) {
+		config := MakeTestConfig(t, Config{
+			ComponentsExtra: ComponentsExtraConfig{
+				Existing: []string{externalSecrets},
+			},
+		})
+		step := NewInstallComponentStep(externalSecretsComponent, config)
+		assert.Equal(t, "existing External Secrets Operator installation", step.Name)
+
+		out, err := step.Execute(&config)
+		assert.NoError(t, err)
+		assert.Empty(t, out)
+	})
+}
+
+func TestInstallExtraComponents_chartComponents(t *testing.T) {
+	config := MakeTestConfig(t, Config{
+		ModesConfig: ModesConfig{
+			DryRun: true,
+		},
+		ComponentsExtra: ComponentsExtraConfig{
+			Requested: []string{externalSecrets, certManager},
+		},
+	})
+
+	_, err := installExtraComponents([]StepInput{}, &config)
+	assert.NoError(t, err)
+
+	planned := []string{}
+	for _, action := range config.Plan {
+		planned = append(planned, action.Step)
+	}
+	assert.Equal(t, []string{"install cert-manager", "install cert-manager", "install External Secrets Operator", "install External Secrets Operator"}, planned)
+
+	config.ComponentsExtra.Requested = []string{"flagger"}
+	_, err = installExtraComponents([]StepInput{}, &config)
+	assert.EqualError(t, err, "unsupported component selected: flagger")
+}
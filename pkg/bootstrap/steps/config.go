@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"k8s.io/client-go/kubernetes"
 	k8s_client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -55,6 +56,8 @@ const (
 	confirmInput         = "confirm"
 	typeSecret           = "secret"
 	typeFile             = "file"
+	typeRemoveSecret     = "removeSecret"
+	typeRemoveFile       = "removeFile"
 )
 
 // ConfigBuilder contains all the different configuration options that a user can introduce
@@ -70,8 +73,16 @@ type ConfigBuilder struct {
 	privateKeyPasswordChanged bool
 	silent                    bool
 	export                    bool
+	declarative               bool
+	dryRun                    bool
+	planFormat                string
+	keepFlux                  bool
 	gitUsername               string
 	gitToken                  string
+	githubAppID               int64
+	githubAppInstallationID   int64
+	githubAppPrivateKeyPath   string
+	supportBundlePath         string
 	repoURL                   string
 	repoBranch                string
 	repoPath                  string
@@ -122,6 +133,26 @@ func (c *ConfigBuilder) WithGitAuthentication(privateKeyPath, privateKeyPassword
 	return c
 }
 
+// WithGitToken authenticates to the git repository over https with a token that doesn't need
+// a username, like a GitHub fine-grained personal access token.
+func (c *ConfigBuilder) WithGitToken(token string) *ConfigBuilder {
+	if token != "" {
+		c.gitToken = token
+		if c.gitUsername == "" {
+			c.gitUsername = gitTokenUsername
+		}
+	}
+	return c
+}
+
+// WithGitHubApp authenticates to the git repository as a GitHub App installation.
+func (c *ConfigBuilder) WithGitHubApp(appID, installationID int64, privateKeyPath string) *ConfigBuilder {
+	c.githubAppID = appID
+	c.githubAppInstallationID = installationID
+	c.githubAppPrivateKeyPath = privateKeyPath
+	return c
+}
+
 func (c *ConfigBuilder) WithGitRepository(repoURL, branch, repoPath string) *ConfigBuilder {
 	c.repoURL = repoURL
 	c.repoBranch = branch
@@ -162,6 +193,26 @@ func (c *ConfigBuilder) WithExport(export bool) *ConfigBuilder {
 	return c
 }
 
+// WithKeepFlux keeps Flux installed when uninstalling.
+func (c *ConfigBuilder) WithKeepFlux(keepFlux bool) *ConfigBuilder {
+	c.keepFlux = keepFlux
+	return c
+}
+
+// WithDryRun plans the bootstrap without mutating the cluster or the git repository. The plan
+// is written in the given format, text or json.
+func (c *ConfigBuilder) WithDryRun(dryRun bool, format string) *ConfigBuilder {
+	c.dryRun = dryRun
+	c.planFormat = format
+	return c
+}
+
+// WithSupportBundlePath sets where to write the support bundle when the verification fails.
+func (c *ConfigBuilder) WithSupportBundlePath(path string) *ConfigBuilder {
+	c.supportBundlePath = path
+	return c
+}
+
 func (c *ConfigBuilder) WithInReader(inReader io.Reader) *ConfigBuilder {
 	c.inReader = inReader
 	return c
@@ -176,6 +227,8 @@ func (c *ConfigBuilder) WithOutWriter(outWriter io.Writer) *ConfigBuilder {
 // configuration values as well as other required structs like clients
 type Config struct {
 	KubernetesClient k8s_client.Client
+	// KubernetesClientSet is used for pod logs and service proxies, not supported by KubernetesClient
+	KubernetesClientSet kubernetes.Interface
 	// TODO move me to a better package
 	GitClient utils.GitClient
 	// TODO move me to a better package
@@ -200,6 +253,12 @@ type Config struct {
 	GitUsername string
 	GitToken    string
 
+	// SupportBundlePath is where to write the support bundle when the verification fails
+	SupportBundlePath string
+
+	// GitHubApp is the GitHub App to authenticate to the git repository, nil when not used
+	GitHubApp *GitHubAppConfig
+
 	// GitRepository contains the configuration for the git repo
 	GitRepository GitRepositoryConfig
 
@@ -214,6 +273,16 @@ type Config struct {
 
 	BootstrapFlux   bool
 	ComponentsExtra ComponentsExtraConfig
+
+	// KeepFlux indicates to not uninstall Flux when uninstalling WGE
+	KeepFlux bool
+
+	// PlanFormat is the format to write the plan to in dry-run mode
+	PlanFormat string
+	// Plan holds the actions planned by the steps in dry-run mode
+	Plan []PlannedAction
+	// renderedWGEValues holds the values of the last WGE HelmRelease rendered, as json
+	renderedWGEValues []byte
 }
 
 // Builds creates a valid config so boostrap could be executed. It uses values introduced
@@ -225,11 +294,23 @@ func (cb *ConfigBuilder) Build() (Config, error) {
 		return Config{}, fmt.Errorf("input cannot be nil")
 	}
 
+	if cb.dryRun && cb.export {
+		return Config{}, fmt.Errorf("dry-run and export modes cannot be used together")
+	}
+
+	if cb.dryRun && cb.planFormat != "" && cb.planFormat != PlanFormatText && cb.planFormat != PlanFormatJSON {
+		return Config{}, fmt.Errorf("unsupported plan format: %s", cb.planFormat)
+	}
+
 	l.Actionf("creating client to cluster")
 	kubeHttp, err := utils.GetKubernetesHttp(cb.kubeconfig)
 	if err != nil {
 		return Config{}, fmt.Errorf("failed to get kubernetes client. error: %s", err)
 	}
+	kubeClientSet, err := utils.GetKubernetesClientSet(cb.kubeconfig)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to get kubernetes client. error: %s", err)
+	}
 	l.Successf("created client to cluster: %s", kubeHttp.ClusterName)
 
 	// validate ssh keys
@@ -255,6 +336,11 @@ func (cb *ConfigBuilder) Build() (Config, error) {
 		return Config{}, fmt.Errorf("error creating git repository configuration: %v", err)
 	}
 
+	githubApp, err := cb.githubAppConfig(gitRepositoryConfig)
+	if err != nil {
+		return Config{}, fmt.Errorf("error creating github app configuration: %v", err)
+	}
+
 	wgeConfig, err := NewWgeConfig(cb.wgeVersion, kubeHttp.Client, fluxConfig.IsInstalled)
 	if err != nil {
 		return Config{}, fmt.Errorf("cannot create WGE configuration: %v", err)
@@ -267,24 +353,29 @@ func (cb *ConfigBuilder) Build() (Config, error) {
 
 	//TODO we should do validations in case invalid values and throw an error early
 	return Config{
-		KubernetesClient: kubeHttp.Client,
-		GitClient:        &utils.GoGitClient{},
-		FluxClient:       &utils.CmdFluxClient{},
-		InReader:         cb.inReader,
-		OutWriter:        cb.outWriter,
-		WgeConfig:        wgeConfig,
-		ClusterUserAuth:  clusterUserAuthConfig,
-		GitRepository:    gitRepositoryConfig,
-		Logger:           cb.logger,
+		KubernetesClient:    kubeHttp.Client,
+		KubernetesClientSet: kubeClientSet,
+		GitClient:           &utils.GoGitClient{},
+		FluxClient:          &utils.CmdFluxClient{},
+		InReader:            cb.inReader,
+		OutWriter:           cb.outWriter,
+		WgeConfig:           wgeConfig,
+		ClusterUserAuth:     clusterUserAuthConfig,
+		GitRepository:       gitRepositoryConfig,
+		Logger:              cb.logger,
 		ModesConfig: ModesConfig{
-			Silent: cb.silent,
-			Export: cb.export,
+			Silent:      cb.silent,
+			Export:      cb.export,
+			Declarative: cb.declarative,
+			DryRun:      cb.dryRun,
 		},
 		PrivateKeyPath:            cb.privateKeyPath,
 		PrivateKeyPassword:        cb.privateKeyPassword,
 		PrivateKeyPasswordChanged: cb.privateKeyPasswordChanged,
 		GitUsername:               cb.gitUsername,
 		GitToken:                  cb.gitToken,
+		GitHubApp:                 githubApp,
+		SupportBundlePath:         cb.supportBundlePath,
 		AuthType:                  cb.authType,
 		InstallOIDC:               cb.installOIDC,
 		DiscoveryURL:              cb.discoveryURL,
@@ -294,10 +385,55 @@ func (cb *ConfigBuilder) Build() (Config, error) {
 		ComponentsExtra:           componentsExtraConfig,
 		FluxConfig:                fluxConfig,
 		BootstrapFlux:             cb.bootstrapFlux,
+		PlanFormat:                cb.planFormat,
+		KeepFlux:                  cb.keepFlux,
 	}, nil
 
 }
 
+// githubAppConfig creates the GitHub App configuration if requested. The git operations of the bootstrap
+// authenticate with an installation token of the app.
+func (cb *ConfigBuilder) githubAppConfig(repo GitRepositoryConfig) (*GitHubAppConfig, error) {
+	if cb.githubAppID == 0 && cb.githubAppInstallationID == 0 && cb.githubAppPrivateKeyPath == "" {
+		return nil, nil
+	}
+
+	if cb.githubAppID == 0 || cb.githubAppInstallationID == 0 || cb.githubAppPrivateKeyPath == "" {
+		return nil, fmt.Errorf("github app authentication requires the app id, installation id and private key")
+	}
+
+	if cb.privateKeyPath != "" || cb.gitToken != "" {
+		return nil, fmt.Errorf("github app authentication cannot be used with ssh keys or git tokens")
+	}
+
+	if repo.Url == "" || repo.Scheme != httpsScheme {
+		return nil, fmt.Errorf("github app authentication requires an https repository url")
+	}
+
+	privateKey, err := os.ReadFile(cb.githubAppPrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read github app private key: %v", err)
+	}
+
+	apiURL, err := utils.GitHubAPIURL(repo.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := utils.GetGitHubAppInstallationToken(apiURL, cb.githubAppID, cb.githubAppInstallationID, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	cb.gitUsername = utils.GitHubAppTokenUsername
+	cb.gitToken = token
+
+	return &GitHubAppConfig{
+		AppID:          cb.githubAppID,
+		InstallationID: cb.githubAppInstallationID,
+		PrivateKey:     privateKey,
+	}, nil
+}
+
 type fileContent struct {
 	Name      string
 	Content   string
@@ -323,6 +459,7 @@ type valuesFile struct {
 type ValuesWGEConfig struct {
 	CAPI map[string]interface{} `json:"capi,omitempty"`
 	OIDC map[string]interface{} `json:"oidc,omitempty"`
+	Git  map[string]interface{} `json:"git,omitempty"`
 }
 
 // ClusterController store the wge values cluster controller field
@@ -0,0 +1,212 @@
+package steps
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ConfigFileAPIVersion = "gitops.weave.works/v1alpha1"
+	ConfigFileKind       = "BootstrapConfig"
+)
+
+// envVarReference matches ${NAME} references to environment variables, so
+// secrets like passwords don't need to be checked into git.
+var envVarReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ConfigFile is the declarative configuration of a Weave GitOps Enterprise
+// bootstrap. It is meant to be checked into git and used to reconcile clusters
+// to it, re-running the bootstrap only applies what is missing.
+type ConfigFile struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Spec       ConfigFileSpec `json:"spec"`
+}
+
+// ConfigFileSpec holds the values of a bootstrap configuration file.
+type ConfigFileSpec struct {
+	// Version is the Weave GitOps Enterprise version, upgraded when it
+	// differs from the installed one.
+	Version string `json:"version"`
+	// AdminPassword is the password of the dashboard admin user.
+	AdminPassword string `json:"adminPassword,omitempty"`
+	// BootstrapFlux bootstraps Flux when it is not installed.
+	BootstrapFlux bool                    `json:"bootstrapFlux,omitempty"`
+	Git           ConfigFileGitRepository `json:"git,omitempty"`
+	OIDC          *ConfigFileOIDC         `json:"oidc,omitempty"`
+	// ComponentsExtra are the extra components to install, like the
+	// policy-agent or the tf-controller.
+	ComponentsExtra []string `json:"componentsExtra,omitempty"`
+}
+
+// ConfigFileGitRepository holds the Flux git repository and the credentials
+// to push to it.
+type ConfigFileGitRepository struct {
+	URL    string              `json:"url,omitempty"`
+	Branch string              `json:"branch,omitempty"`
+	Path   string              `json:"path,omitempty"`
+	SSH    *ConfigFileGitSSH   `json:"ssh,omitempty"`
+	HTTPS  *ConfigFileGitHTTPS `json:"https,omitempty"`
+	// GitHubApp authenticates as a GitHub App installation over https.
+	GitHubApp *ConfigFileGitHubApp `json:"githubApp,omitempty"`
+}
+
+type ConfigFileGitSSH struct {
+	PrivateKeyPath     string `json:"privateKeyPath"`
+	PrivateKeyPassword string `json:"privateKeyPassword,omitempty"`
+}
+
+type ConfigFileGitHTTPS struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type ConfigFileGitHubApp struct {
+	AppID          int64  `json:"appID"`
+	InstallationID int64  `json:"installationID"`
+	PrivateKeyPath string `json:"privateKeyPath"`
+}
+
+type ConfigFileOIDC struct {
+	DiscoveryURL string `json:"discoveryURL"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+// LoadConfigFile reads a bootstrap configuration file, expanding the
+// environment variables it references.
+func LoadConfigFile(path string) (ConfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ConfigFile{}, fmt.Errorf("cannot read config file: %v", err)
+	}
+
+	return ParseConfigFile(data)
+}
+
+// ParseConfigFile parses and validates a bootstrap configuration file.
+func ParseConfigFile(data []byte) (ConfigFile, error) {
+	missing := []string{}
+	expanded := envVarReference.ReplaceAllFunc(data, func(ref []byte) []byte {
+		name := string(envVarReference.FindSubmatch(ref)[1])
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return []byte(value)
+	})
+	if len(missing) > 0 {
+		return ConfigFile{}, fmt.Errorf("environment variables referenced by the config file are not set: %s", strings.Join(missing, ", "))
+	}
+
+	var file ConfigFile
+	if err := yaml.UnmarshalStrict(expanded, &file); err != nil {
+		return ConfigFile{}, fmt.Errorf("invalid config file: %v", err)
+	}
+
+	if err := file.validate(); err != nil {
+		return ConfigFile{}, fmt.Errorf("invalid config file: %v", err)
+	}
+
+	return file, nil
+}
+
+func (f ConfigFile) validate() error {
+	if f.APIVersion != ConfigFileAPIVersion || f.Kind != ConfigFileKind {
+		return fmt.Errorf("expected apiVersion %s and kind %s", ConfigFileAPIVersion, ConfigFileKind)
+	}
+
+	if f.Spec.Version == "" {
+		return fmt.Errorf("spec.version is required")
+	}
+
+	authMethods := 0
+	for _, set := range []bool{f.Spec.Git.SSH != nil, f.Spec.Git.HTTPS != nil, f.Spec.Git.GitHubApp != nil} {
+		if set {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		return fmt.Errorf("only one of spec.git.ssh, spec.git.https and spec.git.githubApp can be set")
+	}
+
+	if app := f.Spec.Git.GitHubApp; app != nil && (app.AppID == 0 || app.InstallationID == 0 || app.PrivateKeyPath == "") {
+		return fmt.Errorf("spec.git.githubApp requires appID, installationID and privateKeyPath")
+	}
+
+	if f.Spec.OIDC != nil && (f.Spec.OIDC.DiscoveryURL == "" || f.Spec.OIDC.ClientID == "" || f.Spec.OIDC.ClientSecret == "") {
+		return fmt.Errorf("spec.oidc requires discoveryURL, clientID and clientSecret")
+	}
+
+	for _, component := range f.Spec.ComponentsExtra {
+		if !slices.Contains(ComponentsExtra, component) {
+			return fmt.Errorf("unsupported component in spec.componentsExtra: %s", component)
+		}
+	}
+
+	return nil
+}
+
+// WithConfigFile configures the bootstrap from a configuration file. Values
+// already set, for example from flags, take precedence over the file. The
+// bootstrap then runs in declarative mode and never asks for input.
+func (c *ConfigBuilder) WithConfigFile(file ConfigFile) *ConfigBuilder {
+	spec := file.Spec
+
+	c.silent = true
+	c.declarative = true
+	c.bootstrapFlux = c.bootstrapFlux || spec.BootstrapFlux
+
+	c.wgeVersion = valueOrDefault(c.wgeVersion, spec.Version)
+	c.password = valueOrDefault(c.password, spec.AdminPassword)
+	c.repoURL = valueOrDefault(c.repoURL, spec.Git.URL)
+	c.repoBranch = valueOrDefault(c.repoBranch, spec.Git.Branch)
+	c.repoPath = valueOrDefault(c.repoPath, spec.Git.Path)
+
+	if ssh := spec.Git.SSH; ssh != nil {
+		c.privateKeyPath = valueOrDefault(c.privateKeyPath, ssh.PrivateKeyPath)
+		if !c.privateKeyPasswordChanged {
+			c.privateKeyPassword = ssh.PrivateKeyPassword
+			c.privateKeyPasswordChanged = true
+		}
+	}
+
+	if https := spec.Git.HTTPS; https != nil {
+		c.gitUsername = valueOrDefault(c.gitUsername, https.Username)
+		c.gitToken = valueOrDefault(c.gitToken, https.Password)
+	}
+
+	if app := spec.Git.GitHubApp; app != nil && c.githubAppID == 0 {
+		c.WithGitHubApp(app.AppID, app.InstallationID, app.PrivateKeyPath)
+	}
+
+	if oidc := spec.OIDC; oidc != nil {
+		c.WithOIDCConfig(
+			valueOrDefault(c.discoveryURL, oidc.DiscoveryURL),
+			valueOrDefault(c.clientID, oidc.ClientID),
+			valueOrDefault(c.clientSecret, oidc.ClientSecret),
+			false,
+		)
+	} else if c.discoveryURL == "" && c.clientID == "" && c.clientSecret == "" {
+		c.installOIDC = confirmNo
+		c.PromptedForDiscoveryURL = false
+	}
+
+	if len(c.componentsExtra) == 0 {
+		c.componentsExtra = spec.ComponentsExtra
+	}
+
+	return c
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,169 @@
+package steps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigFile = `apiVersion: gitops.weave.works/v1alpha1
+kind: BootstrapConfig
+spec:
+  version: 0.35.0
+  adminPassword: ${TEST_ADMIN_PASSWORD}
+  bootstrapFlux: true
+  git:
+    url: ssh://git@github.com/example/fleet
+    branch: main
+    path: clusters/management
+    ssh:
+      privateKeyPath: /tmp/id_rsa
+  oidc:
+    discoveryURL: https://dex.example.com/.well-known/openid-configuration
+    clientID: weave-gitops
+    clientSecret: ${TEST_CLIENT_SECRET}
+  componentsExtra:
+    - policy-agent
+    - tf-controller
+`
+
+func TestParseConfigFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		data    string
+		want    ConfigFileSpec
+		wantErr string
+	}{
+		{
+			name: "should parse config file expanding environment variables",
+			env: map[string]string{
+				"TEST_ADMIN_PASSWORD": "admin123",
+				"TEST_CLIENT_SECRET":  "secret",
+			},
+			data: testConfigFile,
+			want: ConfigFileSpec{
+				Version:       "0.35.0",
+				AdminPassword: "admin123",
+				BootstrapFlux: true,
+				Git: ConfigFileGitRepository{
+					URL:    "ssh://git@github.com/example/fleet",
+					Branch: "main",
+					Path:   "clusters/management",
+					SSH: &ConfigFileGitSSH{
+						PrivateKeyPath: "/tmp/id_rsa",
+					},
+				},
+				OIDC: &ConfigFileOIDC{
+					DiscoveryURL: "https://dex.example.com/.well-known/openid-configuration",
+					ClientID:     "weave-gitops",
+					ClientSecret: "secret",
+				},
+				ComponentsExtra: []string{policyAgentController, tfController},
+			},
+		},
+		{
+			name: "should fail if environment variables are not set",
+			env: map[string]string{
+				"TEST_ADMIN_PASSWORD": "admin123",
+			},
+			data:    testConfigFile,
+			wantErr: "environment variables referenced by the config file are not set: TEST_CLIENT_SECRET",
+		},
+		{
+			name: "should fail on unknown fields",
+			data: `apiVersion: gitops.weave.works/v1alpha1
+kind: BootstrapConfig
+spec:
+  version: 0.35.0
+  componentExtra: [policy-agent]
+`,
+			wantErr: `invalid config file: error unmarshaling JSON: while decoding JSON: json: unknown field "componentExtra"`,
+		},
+		{
+			name: "should fail without version",
+			data: `apiVersion: gitops.weave.works/v1alpha1
+kind: BootstrapConfig
+spec:
+  bootstrapFlux: true
+`,
+			wantErr: "invalid config file: spec.version is required",
+		},
+		{
+			name: "should fail on unsupported components",
+			data: `apiVersion: gitops.weave.works/v1alpha1
+kind: BootstrapConfig
+spec:
+  version: 0.35.0
+  componentsExtra: [flagger]
+`,
+			wantErr: "invalid config file: unsupported component in spec.componentsExtra: flagger",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got, err := ParseConfigFile([]byte(tt.data))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Spec)
+		})
+	}
+}
+
+func TestConfigBuilder_WithConfigFile(t *testing.T) {
+	file := ConfigFile{
+		APIVersion: ConfigFileAPIVersion,
+		Kind:       ConfigFileKind,
+		Spec: ConfigFileSpec{
+			Version:       "0.35.0",
+			AdminPassword: "admin123",
+			Git: ConfigFileGitRepository{
+				URL:    "https://github.com/example/fleet",
+				Branch: "main",
+				Path:   "clusters/management",
+				HTTPS: &ConfigFileGitHTTPS{
+					Username: "git",
+					Password: "token",
+				},
+			},
+			ComponentsExtra: []string{tfController},
+		},
+	}
+
+	t.Run("should use values from the config file in declarative mode", func(t *testing.T) {
+		c := NewConfigBuilder().
+			WithOIDCConfig("", "", "", true).
+			WithConfigFile(file)
+
+		assert.True(t, c.silent)
+		assert.True(t, c.declarative)
+		assert.Equal(t, "0.35.0", c.wgeVersion)
+		assert.Equal(t, "admin123", c.password)
+		assert.Equal(t, "https://github.com/example/fleet", c.repoURL)
+		assert.Equal(t, "git", c.gitUsername)
+		assert.Equal(t, "token", c.gitToken)
+		assert.Equal(t, []string{tfController}, c.componentsExtra)
+		assert.Equal(t, confirmNo, c.installOIDC)
+		assert.False(t, c.PromptedForDiscoveryURL)
+	})
+
+	t.Run("should prefer values from flags", func(t *testing.T) {
+		c := NewConfigBuilder().
+			WithVersion("0.36.0").
+			WithGitRepository("", "develop", "").
+			WithComponentsExtra([]string{policyAgentController}).
+			WithConfigFile(file)
+
+		assert.Equal(t, "0.36.0", c.wgeVersion)
+		assert.Equal(t, "develop", c.repoBranch)
+		assert.Equal(t, "clusters/management", c.repoPath)
+		assert.Equal(t, []string{policyAgentController}, c.componentsExtra)
+	})
+}
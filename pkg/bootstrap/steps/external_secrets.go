@@ -0,0 +1,31 @@
+package steps
+
+const (
+	externalSecrets = "external-secrets"
+)
+
+const (
+	externalSecretsChartURL        = "https://charts.external-secrets.io"
+	externalSecretsChartVersion    = "0.9.x"
+	externalSecretsFileName        = "external-secrets.yaml"
+	externalSecretsCommitMsg       = "Add External Secrets Operator HelmRelease"
+	externalSecretsRemoveCommitMsg = "Remove External Secrets Operator HelmRelease"
+)
+
+// externalSecretsComponent installs the External Secrets Operator, used by the dashboard secrets views
+var externalSecretsComponent = Component{
+	Name:            externalSecrets,
+	DisplayName:     "External Secrets Operator",
+	FileName:        externalSecretsFileName,
+	CommitMsg:       externalSecretsCommitMsg,
+	RemoveCommitMsg: externalSecretsRemoveCommitMsg,
+	Chart: &ComponentChart{
+		RepositoryURL:   externalSecretsChartURL,
+		Chart:           externalSecrets,
+		Version:         externalSecretsChartVersion,
+		TargetNamespace: externalSecrets,
+		DefaultValues: map[string]interface{}{
+			"installCRDs": true,
+		},
+	},
+}
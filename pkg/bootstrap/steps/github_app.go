@@ -0,0 +1,152 @@
+package steps
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// gitTokenUsername is the username for tokens that don't need one, like GitHub fine-grained personal access tokens
+	gitTokenUsername = "git"
+
+	fluxSystemSecretName            = "flux-system"
+	fluxSystemKustomizationFileName = "flux-system/kustomization.yaml"
+	githubAppFluxCommitMsg          = "Configure Flux to authenticate as a GitHub App"
+	// githubAppMinFluxVersion is the first Flux version that authenticates to GitHub as a GitHub App
+	githubAppMinFluxVersion = "2.5.0"
+
+	// githubAppFluxKustomization patches the flux-system GitRepository created by flux bootstrap to
+	// authenticate with the GitHub App credentials of the flux-system secret.
+	githubAppFluxKustomization = `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+- gotk-components.yaml
+- gotk-sync.yaml
+patches:
+- patch: |
+    - op: add
+      path: /spec/provider
+      value: github
+  target:
+    kind: GitRepository
+    name: flux-system
+`
+)
+
+// git providers supported by WGE
+const (
+	gitProviderGitHub = "github"
+	gitProviderGitLab = "gitlab"
+)
+
+// GitHubAppConfig holds the GitHub App used to authenticate to the git repository
+type GitHubAppConfig struct {
+	AppID          int64
+	InstallationID int64
+	PrivateKey     []byte
+}
+
+// githubAppFluxOutputs replaces the flux-system secret created by flux bootstrap with the GitHub App
+// credentials, so Flux refreshes the installation tokens, and configures the GitRepository to use them.
+// It requires Flux v2.5 or later, checked by checkGitHubAppFluxVersion before bootstrapping.
+func githubAppFluxOutputs(c *Config) []StepOutput {
+	secret := v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fluxSystemSecretName,
+			Namespace: WGEDefaultNamespace,
+		},
+	}
+	githubAppSecret := *secret.DeepCopy()
+	githubAppSecret.Data = map[string][]byte{
+		"githubAppID":             []byte(fmt.Sprint(c.GitHubApp.AppID)),
+		"githubAppInstallationID": []byte(fmt.Sprint(c.GitHubApp.InstallationID)),
+		"githubAppPrivateKey":     c.GitHubApp.PrivateKey,
+	}
+
+	return []StepOutput{
+		{
+			Name:  fluxSystemSecretName,
+			Type:  typeRemoveSecret,
+			Value: secret,
+		},
+		{
+			Name:  fluxSystemSecretName,
+			Type:  typeSecret,
+			Value: githubAppSecret,
+		},
+		{
+			Name: fluxSystemKustomizationFileName,
+			Type: typeFile,
+			Value: fileContent{
+				Name:      fluxSystemKustomizationFileName,
+				Content:   githubAppFluxKustomization,
+				CommitMsg: githubAppFluxCommitMsg,
+			},
+		},
+	}
+}
+
+// getFluxVersion returns the output of flux --version. It is a variable to be replaced in tests.
+var getFluxVersion = func() (string, error) {
+	var runner runner.CLIRunner
+	out, err := runner.Run("flux", "--version")
+	return string(out), err
+}
+
+// checkGitHubAppFluxVersion fails if the flux CLI, whose version flux bootstrap installs, cannot
+// authenticate as a GitHub App.
+func checkGitHubAppFluxVersion() error {
+	out, err := getFluxVersion()
+	if err != nil {
+		return fmt.Errorf("cannot get flux version: %v", err)
+	}
+	return validateGitHubAppFluxVersion(out)
+}
+
+// validateGitHubAppFluxVersion checks the output of flux --version, like 'flux version 2.5.1',
+// against the minimum version for GitHub App authentication.
+func validateGitHubAppFluxVersion(versionOutput string) error {
+	fields := strings.Fields(versionOutput)
+	if len(fields) == 0 {
+		return fmt.Errorf("cannot get flux version: empty output")
+	}
+	version, err := semver.NewVersion(fields[len(fields)-1])
+	if err != nil {
+		return fmt.Errorf("cannot parse flux version '%s': %v", fields[len(fields)-1], err)
+	}
+	if version.LessThan(semver.MustParse(githubAppMinFluxVersion)) {
+		return fmt.Errorf("github app authentication requires flux v%s or later, found v%s", githubAppMinFluxVersion, version)
+	}
+	return nil
+}
+
+// gitProviderValues returns the WGE git provider configuration for the repository. WGE uses it to
+// open pull requests, it is left to the chart defaults for unknown providers.
+func gitProviderValues(c *Config) map[string]interface{} {
+	repoURL, err := url.Parse(c.GitRepository.Url)
+	if err != nil || repoURL.Hostname() == "" {
+		return nil
+	}
+
+	hostname := repoURL.Hostname()
+	var provider string
+	switch {
+	case c.GitHubApp != nil, strings.Contains(hostname, gitProviderGitHub):
+		provider = gitProviderGitHub
+	case strings.Contains(hostname, gitProviderGitLab):
+		provider = gitProviderGitLab
+	default:
+		return nil
+	}
+
+	return map[string]interface{}{
+		"type":     provider,
+		"hostname": hostname,
+	}
+}
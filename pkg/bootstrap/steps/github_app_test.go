@@ -0,0 +1,131 @@
+package steps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestConfigureFluxCreds_githubApp(t *testing.T) {
+	fluxVersion := "flux version 2.5.1"
+	defaultGetFluxVersion := getFluxVersion
+	getFluxVersion = func() (string, error) {
+		return fluxVersion, nil
+	}
+	defer func() { getFluxVersion = defaultGetFluxVersion }()
+
+	config := MakeTestConfig(t, Config{
+		ModesConfig: ModesConfig{
+			DryRun: true,
+		},
+		GitRepository: GitRepositoryConfig{
+			Url:    "https://github.com/example/fleet",
+			Scheme: httpsScheme,
+			Branch: "main",
+			Path:   "clusters/management",
+		},
+		GitUsername: "x-access-token",
+		GitToken:    "ghs_installation_token",
+		GitHubApp: &GitHubAppConfig{
+			AppID:          7,
+			InstallationID: 42,
+			PrivateKey:     []byte("private-key"),
+		},
+	})
+
+	out, err := configureFluxCreds([]StepInput{}, &config)
+	assert.NoError(t, err)
+	assert.Len(t, config.Plan, 1)
+	assert.Equal(t, actionBootstrap, config.Plan[0].Action)
+
+	assert.Len(t, out, 3)
+	assert.Equal(t, typeRemoveSecret, out[0].Type)
+	assert.Equal(t, typeSecret, out[1].Type)
+	assert.Equal(t, map[string][]byte{
+		"githubAppID":             []byte("7"),
+		"githubAppInstallationID": []byte("42"),
+		"githubAppPrivateKey":     []byte("private-key"),
+	}, out[1].Value.(v1.Secret).Data)
+	assert.Equal(t, fileContent{
+		Name:      fluxSystemKustomizationFileName,
+		Content:   githubAppFluxKustomization,
+		CommitMsg: githubAppFluxCommitMsg,
+	}, out[2].Value)
+
+	fluxVersion = "flux version 2.4.0"
+	_, err = configureFluxCreds([]StepInput{}, &config)
+	assert.EqualError(t, err, "github app authentication requires flux v2.5.0 or later, found v2.4.0")
+}
+
+func TestGitProviderValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   map[string]interface{}
+	}{
+		{
+			name:   "should configure github",
+			config: Config{GitRepository: GitRepositoryConfig{Url: "ssh://git@github.com/example/fleet"}},
+			want:   map[string]interface{}{"type": "github", "hostname": "github.com"},
+		},
+		{
+			name:   "should configure gitlab",
+			config: Config{GitRepository: GitRepositoryConfig{Url: "https://gitlab.example.com/example/fleet"}},
+			want:   map[string]interface{}{"type": "gitlab", "hostname": "gitlab.example.com"},
+		},
+		{
+			name: "should configure github enterprise for github apps",
+			config: Config{
+				GitRepository: GitRepositoryConfig{Url: "https://git.example.com/example/fleet"},
+				GitHubApp:     &GitHubAppConfig{},
+			},
+			want: map[string]interface{}{"type": "github", "hostname": "git.example.com"},
+		},
+		{
+			name:   "should leave unknown providers to defaults",
+			config: Config{GitRepository: GitRepositoryConfig{Url: "https://git.example.com/example/fleet"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, gitProviderValues(&tt.config))
+		})
+	}
+}
+
+func TestConfigBuilder_gitAuthentication(t *testing.T) {
+	t.Run("should default the username of git tokens", func(t *testing.T) {
+		builder := NewConfigBuilder().WithGitToken("github_pat_token")
+		assert.Equal(t, gitTokenUsername, builder.gitUsername)
+		assert.Equal(t, "github_pat_token", builder.gitToken)
+	})
+
+	tests := []struct {
+		name    string
+		builder *ConfigBuilder
+		wantErr string
+	}{
+		{
+			name:    "should require the whole github app",
+			builder: NewConfigBuilder().WithGitHubApp(7, 0, ""),
+			wantErr: "github app authentication requires the app id, installation id and private key",
+		},
+		{
+			name:    "should not mix github app and tokens",
+			builder: NewConfigBuilder().WithGitToken("token").WithGitHubApp(7, 42, "app.pem"),
+			wantErr: "github app authentication cannot be used with ssh keys or git tokens",
+		},
+		{
+			name:    "should require https repositories",
+			builder: NewConfigBuilder().WithGitHubApp(7, 42, "app.pem"),
+			wantErr: "github app authentication requires an https repository url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.githubAppConfig(GitRepositoryConfig{})
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
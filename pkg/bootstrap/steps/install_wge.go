@@ -22,12 +22,14 @@ import (
 const (
 	wgeInstallMsg = "installing v%s ... It may take a few minutes."
 	wgeExistsMsg  = "Weave GitOps Enterprise is already installed in namespace %s"
+	wgeUpgradeMsg = "upgrading v%s to v%s ... It may take a few minutes."
 	versionMsg    = "select one of the following"
 )
 
 const (
 	wgeHelmRepoCommitMsg              = "Add WGE HelmRepository YAML file"
 	wgeHelmReleaseCommitMsg           = "Add WGE HelmRelease YAML file"
+	wgeHelmReleaseUpgradeCommitMsg    = "Upgrade WGE HelmRelease YAML file"
 	wgeChartName                      = "mccp"
 	wgeHelmRepositoryName             = "weave-gitops-enterprise-charts"
 	WgeHelmReleaseName                = "weave-gitops-enterprise"
@@ -92,11 +94,28 @@ func NewWgeConfig(requestedWgeVersion string, client client.Client, fluxInstalle
 	}, nil
 }
 
+// version returns the version to configure WGE with, the existing one unless another one is requested
+func (w WgeConfig) version() string {
+	if w.RequestedVersion != "" {
+		return w.RequestedVersion
+	}
+	return w.ExistingVersion
+}
+
 // NewInstallWGEStep creates step to install Weave GitOps Enterprise out of the existing configuration
-func NewInstallWGEStep(config WgeConfig, logger logger.Logger) (BootstrapStep, error) {
+func NewInstallWGEStep(config WgeConfig, modes ModesConfig, logger logger.Logger) (BootstrapStep, error) {
 
 	// check if WGE is already installed
 	if config.ExistingVersion != "" {
+		// declarative bootstrap reconciles the existing installation to the requested version
+		if modes.Declarative && config.RequestedVersion != "" && config.RequestedVersion != config.ExistingVersion {
+			return BootstrapStep{
+				Name:  "Upgrade Weave GitOps Enterprise",
+				Input: []StepInput{},
+				Step:  upgradeWge,
+			}, nil
+		}
+
 		logger.Actionf(wgeExistsMsg, WGEDefaultNamespace)
 		return BootstrapStep{
 			Name:  "Existing WGE installation found",
@@ -137,6 +156,78 @@ func installWge(input []StepInput, c *Config) ([]StepOutput, error) {
 	}
 	c.Logger.Actionf("rendered HelmRepository file")
 
+	wgeValues := defaultWGEValues(c)
+	if err := setWGEValues(c, wgeValues); err != nil {
+		return []StepOutput{}, err
+	}
+
+	wgeHelmRelease, err := constructWGEhelmRelease(wgeValues, requestedVersion)
+	if err != nil {
+		return []StepOutput{}, err
+	}
+	c.Logger.Actionf("rendered HelmRelease file")
+
+	helmrepoFile := fileContent{
+		Name:      wgeHelmrepoFileName,
+		Content:   wgeHelmRepository,
+		CommitMsg: wgeHelmRepoCommitMsg,
+	}
+	helmreleaseFile := fileContent{
+		Name:      wgeHelmReleaseFileName,
+		Content:   wgeHelmRelease,
+		CommitMsg: wgeHelmReleaseCommitMsg,
+	}
+
+	return []StepOutput{
+		{
+			Name:  wgeHelmrepoFileName,
+			Type:  typeFile,
+			Value: helmrepoFile,
+		},
+		{
+			Name:  wgeHelmReleaseFileName,
+			Type:  typeFile,
+			Value: helmreleaseFile,
+		},
+	}, nil
+}
+
+// upgradeWge upgrades weave gitops enterprise chart keeping the existing values.
+func upgradeWge(input []StepInput, c *Config) ([]StepOutput, error) {
+	c.Logger.Actionf(wgeUpgradeMsg, c.WgeConfig.ExistingVersion, c.WgeConfig.RequestedVersion)
+
+	wgeValues, err := getWGEValues(c)
+	if err != nil {
+		return []StepOutput{}, err
+	}
+
+	if err := setWGEValues(c, wgeValues); err != nil {
+		return []StepOutput{}, err
+	}
+
+	wgeHelmRelease, err := constructWGEhelmRelease(wgeValues, c.WgeConfig.RequestedVersion)
+	if err != nil {
+		return []StepOutput{}, err
+	}
+	c.Logger.Actionf("rendered HelmRelease file")
+
+	helmreleaseFile := fileContent{
+		Name:      wgeHelmReleaseFileName,
+		Content:   wgeHelmRelease,
+		CommitMsg: wgeHelmReleaseUpgradeCommitMsg,
+	}
+
+	return []StepOutput{
+		{
+			Name:  wgeHelmReleaseFileName,
+			Type:  typeFile,
+			Value: helmreleaseFile,
+		},
+	}, nil
+}
+
+// defaultWGEValues returns the values of the WGE HelmRelease of a new installation.
+func defaultWGEValues(c *Config) valuesFile {
 	gitOpsSetsValues := map[string]interface{}{
 		"enabled": true,
 		"controllerManager": map[string]interface{}{
@@ -163,7 +254,10 @@ func installWge(input []StepInput, c *Config) ([]StepOutput, error) {
 			},
 		}}
 
-	wgeValues := valuesFile{
+	return valuesFile{
+		Config: ValuesWGEConfig{
+			Git: gitProviderValues(c),
+		},
 		Service: defaultServiceValues(),
 		Ingress: defaultIngressValues(),
 		TLS: map[string]interface{}{
@@ -173,36 +267,62 @@ func installWge(input []StepInput, c *Config) ([]StepOutput, error) {
 		EnablePipelines:   true,
 		ClusterController: clusterControllerValues,
 	}
+}
 
-	wgeHelmRelease, err := constructWGEhelmRelease(wgeValues, requestedVersion)
-	if err != nil {
-		return []StepOutput{}, err
+// getWGEValues returns the values of the WGE HelmRelease to update. A dry-run doesn't apply the
+// HelmRelease rendered by the previous steps, and may plan the bootstrap of a cluster where WGE is
+// not installed yet, so it uses the values rendered by the previous steps instead of the cluster.
+func getWGEValues(c *Config) (valuesFile, error) {
+	var valuesBytes []byte
+	switch {
+	case c.ModesConfig.DryRun && c.renderedWGEValues != nil:
+		valuesBytes = c.renderedWGEValues
+	case c.ModesConfig.DryRun && c.WgeConfig.ExistingVersion == "":
+		return defaultWGEValues(c), nil
+	default:
+		var err error
+		valuesBytes, err = utils.GetHelmReleaseValues(c.KubernetesClient, WgeHelmReleaseName, WGEDefaultNamespace)
+		if err != nil {
+			return valuesFile{}, err
+		}
 	}
-	c.Logger.Actionf("rendered HelmRelease file")
 
-	helmrepoFile := fileContent{
-		Name:      wgeHelmrepoFileName,
-		Content:   wgeHelmRepository,
-		CommitMsg: wgeHelmRepoCommitMsg,
+	var wgeValues valuesFile
+	if err := json.Unmarshal(valuesBytes, &wgeValues); err != nil {
+		return valuesFile{}, err
 	}
-	helmreleaseFile := fileContent{
-		Name:      wgeHelmReleaseFileName,
-		Content:   wgeHelmRelease,
-		CommitMsg: wgeHelmReleaseCommitMsg,
+	return wgeValues, nil
+}
+
+// setWGEValues keeps the values of the rendered WGE HelmRelease for the next steps of a dry-run.
+func setWGEValues(c *Config, wgeValues valuesFile) error {
+	valuesBytes, err := json.Marshal(wgeValues)
+	if err != nil {
+		return err
 	}
+	c.renderedWGEValues = valuesBytes
+	return nil
+}
 
-	return []StepOutput{
-		{
-			Name:  wgeHelmrepoFileName,
-			Type:  typeFile,
-			Value: helmrepoFile,
-		},
-		{
-			Name:  wgeHelmReleaseFileName,
-			Type:  typeFile,
-			Value: helmreleaseFile,
-		},
-	}, nil
+// wgeDomain returns the domain WGE is reachable at with wgeValues, the first ingress host.
+func wgeDomain(wgeValues valuesFile) (string, error) {
+	ingressBytes, err := json.Marshal(wgeValues.Ingress)
+	if err != nil {
+		return "", err
+	}
+	var ingress struct {
+		Hosts []struct {
+			Host string `json:"host"`
+		} `json:"hosts"`
+	}
+	if err := json.Unmarshal(ingressBytes, &ingress); err != nil {
+		return "", err
+	}
+	//TODO this would only work for host-based ingress  but not path-based so it is limited
+	if len(ingress.Hosts) == 0 || ingress.Hosts[0].Host == "" {
+		return "localhost:8000", nil
+	}
+	return ingress.Hosts[0].Host, nil
 }
 
 func constructWgeHelmRepository() (string, error) {
@@ -3,10 +3,12 @@ package steps
 import (
 	"testing"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -168,11 +170,44 @@ func TestInstallWge_Execute(t *testing.T) {
 			}, fluxSystemGitRepository(), fluxSystemKustomization()),
 			wantOutput: []StepOutput{},
 		},
+		{
+			name: "should upgrade weave gitops enterprise to the requested version in declarative mode",
+			config: MakeTestConfig(t, Config{
+				WgeConfig: WgeConfig{
+					ExistingVersion:  "1.0.0",
+					RequestedVersion: "1.1.0",
+					AllowedVersions:  []string{"1.0.0", "1.1.0", "1.2.0"},
+				},
+				ModesConfig: ModesConfig{
+					Silent:      true,
+					Declarative: true,
+				},
+				GitUsername: "test",
+				GitToken:    "abc",
+				GitRepository: GitRepositoryConfig{
+					Url:    "https://test.com.git",
+					Branch: "main",
+					Path:   "/",
+					Scheme: "https",
+				},
+			}, fluxSystemGitRepository(), fluxSystemKustomization(), wgeHelmRelease(t, "1.0.0")),
+			wantOutput: []StepOutput{
+				{
+					Name: wgeHelmReleaseFileName,
+					Type: typeFile,
+					Value: fileContent{
+						Name:      wgeHelmReleaseFileName,
+						Content:   upgradedHelmRelease(t, "1.1.0"),
+						CommitMsg: wgeHelmReleaseUpgradeCommitMsg,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			step, err := NewInstallWGEStep(tt.config.WgeConfig, tt.config.Logger)
+			step, err := NewInstallWGEStep(tt.config.WgeConfig, tt.config.ModesConfig, tt.config.Logger)
 			assert.NoError(t, err)
 			gotOutputs, err := step.Execute(&tt.config)
 			if tt.wantErr != "" {
@@ -228,3 +263,15 @@ func fluxSystemKustomization() *kustomizev1.Kustomization {
 		},
 	}
 }
+
+func wgeHelmRelease(t *testing.T, version string) *helmv2.HelmRelease {
+	hr, err := createWGEHelmReleaseFakeObject(version)
+	assert.NoError(t, err)
+	return &hr
+}
+
+func upgradedHelmRelease(t *testing.T, version string) string {
+	content, err := utils.CreateHelmReleaseYamlString(*wgeHelmRelease(t, version))
+	assert.NoError(t, err)
+	return content
+}
@@ -6,4 +6,10 @@ type ModesConfig struct {
 	Silent bool
 	// Export instruct to generate resources but to do not mutate any system but to write resources to stdout.
 	Export bool
+	// Declarative instruct to reconcile the cluster to a configuration file: it never asks the user, uses default
+	// values for missing inputs or fails if there are none, and updates what is already configured when it differs.
+	Declarative bool
+	// DryRun instruct to plan the actions that the bootstrap would take, compared with the current state,
+	// without mutating any system.
+	DryRun bool
 }
@@ -31,6 +31,8 @@ const (
 
 	oidcConfigExistWarningMsg  = "OIDC is already configured on the cluster. To reset configurations please remove secret '%s' in namespace '%s' and run 'bootstrap auth --type=oidc' command again"
 	oidcConfigExistContinueMsg = "OIDC is already configured on the cluster. Configurations in secret '%s' in namespace '%s'"
+	oidcConfigUnchangedMsg     = "OIDC configurations in secret '%s' in namespace '%s' are unchanged"
+	oidcConfigUpdateMsg        = "updating OIDC configurations in secret '%s' in namespace '%s'"
 	oidcCommitMsg              = "Add OIDC values in WGE HelmRelease yaml file"
 )
 
@@ -128,15 +130,33 @@ func createOIDCConfig(input []StepInput, c *Config) ([]StepOutput, error) {
 
 	// check existing oidc configuration
 	if existing := isExistingOIDCConfig(input, c); existing {
-		if continueWithExistingConfigs != confirmYes {
-			c.Logger.Warningf(oidcConfigExistWarningMsg, oidcSecretName, WGEDefaultNamespace)
-		} else {
-			c.Logger.Warningf(oidcConfigExistContinueMsg, oidcSecretName, WGEDefaultNamespace)
+		if !c.ModesConfig.Declarative {
+			if continueWithExistingConfigs != confirmYes {
+				c.Logger.Warningf(oidcConfigExistWarningMsg, oidcSecretName, WGEDefaultNamespace)
+			} else {
+				c.Logger.Warningf(oidcConfigExistContinueMsg, oidcSecretName, WGEDefaultNamespace)
+			}
+			return []StepOutput{}, nil
 		}
-		return []StepOutput{}, nil
+
+		// declarative mode updates the existing configuration when the config file changes it
+		changed, err := isOIDCConfigChanged(c)
+		if err != nil {
+			return []StepOutput{}, err
+		}
+		if !changed {
+			c.Logger.Successf(oidcConfigUnchangedMsg, oidcSecretName, WGEDefaultNamespace)
+			return []StepOutput{}, nil
+		}
+		c.Logger.Actionf(oidcConfigUpdateMsg, oidcSecretName, WGEDefaultNamespace)
+	}
+
+	wgeValues, err := getWGEValues(c)
+	if err != nil {
+		return []StepOutput{}, err
 	}
 
-	domain, err := utils.GetHelmReleaseProperty(c.KubernetesClient, WgeHelmReleaseName, WGEDefaultNamespace, utils.HelmDomainProperty)
+	domain, err := wgeDomain(wgeValues)
 	if err != nil {
 		return []StepOutput{}, fmt.Errorf("error resolving domain: %v", err)
 	}
@@ -158,16 +178,6 @@ func createOIDCConfig(input []StepInput, c *Config) ([]StepOutput, error) {
 	}
 
 	c.Logger.Waitingf(oidcInstallInfoMsg)
-	valuesBytes, err := utils.GetHelmReleaseValues(c.KubernetesClient, WgeHelmReleaseName, WGEDefaultNamespace)
-	if err != nil {
-		return []StepOutput{}, err
-	}
-	var wgeValues valuesFile
-
-	err = json.Unmarshal(valuesBytes, &wgeValues)
-	if err != nil {
-		return []StepOutput{}, err
-	}
 
 	c.Logger.Actionf("configuring oidc values")
 	wgeValues.Config.OIDC = map[string]interface{}{
@@ -177,7 +187,11 @@ func createOIDCConfig(input []StepInput, c *Config) ([]StepOutput, error) {
 		"clientCredentialsSecret": oidcSecretName,
 	}
 
-	wgeHelmRelease, err := constructWGEhelmRelease(wgeValues, c.WgeConfig.RequestedVersion)
+	if err := setWGEValues(c, wgeValues); err != nil {
+		return []StepOutput{}, err
+	}
+
+	wgeHelmRelease, err := constructWGEhelmRelease(wgeValues, c.WgeConfig.version())
 	if err != nil {
 		return []StepOutput{}, err
 	}
@@ -252,6 +266,30 @@ func isExistingOIDCConfig(input []StepInput, c *Config) bool {
 	return err == nil
 }
 
+// isOIDCConfigChanged checks whether the OIDC configuration, if any, differs from the one of the OIDC
+// secret on management cluster
+func isOIDCConfigChanged(c *Config) (bool, error) {
+	if c.DiscoveryURL == "" && c.ClientID == "" && c.ClientSecret == "" {
+		return false, nil
+	}
+
+	secret, err := utils.GetSecret(c.KubernetesClient, oidcSecretName, WGEDefaultNamespace)
+	if err != nil {
+		return false, err
+	}
+
+	if string(secret.Data["clientID"]) != c.ClientID || string(secret.Data["clientSecret"]) != c.ClientSecret {
+		return true, nil
+	}
+
+	issuerURL, err := getIssuer(c.DiscoveryURL)
+	if err != nil {
+		return false, fmt.Errorf("error resolving issuer: %v", err)
+	}
+
+	return string(secret.Data["issuerURL"]) != issuerURL, nil
+}
+
 func canAskForConfig(input []StepInput, c *Config) bool {
 	if c.InstallOIDC != "y" {
 		return false
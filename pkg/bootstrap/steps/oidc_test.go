@@ -230,6 +230,120 @@ func TestCreateOIDCConfig(t *testing.T) {
 	}
 }
 
+func TestCreateOIDCConfig_Declarative(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{"issuer": "https://example.com/issuer"}`)
+	}))
+	defer mockServer.Close()
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      WgeHelmReleaseName,
+			Namespace: WGEDefaultNamespace,
+		},
+		Spec: helmv2.HelmReleaseSpec{
+			Values: &v1.JSON{
+				Raw: []byte(`{"ingress":{"hosts":[{"host":""}]},"config":{"oidc":{"enabled":true,"issuerURL":"https://example.com/issuer","clientCredentialsSecret":"oidc-auth"}}}`),
+			},
+		},
+	}
+	existingSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      oidcSecretName,
+			Namespace: WGEDefaultNamespace,
+		},
+		Data: map[string][]byte{
+			"issuerURL":    []byte("https://example.com/issuer"),
+			"clientID":     []byte("client-id"),
+			"clientSecret": []byte("client-secret"),
+			"redirectURL":  []byte("http://localhost:8000/oauth2/callback"),
+		},
+	}
+
+	tests := []struct {
+		name         string
+		clientSecret string
+		wantSecret   map[string][]byte
+	}{
+		{
+			name:         "should keep existing configuration if unchanged",
+			clientSecret: "client-secret",
+		},
+		{
+			name:         "should update existing configuration if changed",
+			clientSecret: "new-client-secret",
+			wantSecret: map[string][]byte{
+				"issuerURL":    []byte("https://example.com/issuer"),
+				"clientID":     []byte("client-id"),
+				"clientSecret": []byte("new-client-secret"),
+				"redirectURL":  []byte("http://localhost:8000/oauth2/callback"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := MakeTestConfig(t, Config{
+				ModesConfig: ModesConfig{
+					Silent:      true,
+					Declarative: true,
+				},
+				InstallOIDC:  confirmYes,
+				DiscoveryURL: mockServer.URL,
+				ClientID:     "client-id",
+				ClientSecret: tt.clientSecret,
+			}, hr, existingSecret)
+
+			out, err := createOIDCConfig([]StepInput{}, &config)
+			assert.NoError(t, err)
+
+			if tt.wantSecret == nil {
+				assert.Empty(t, out)
+				return
+			}
+
+			assert.Equal(t, 2, len(out))
+			secret, ok := out[0].Value.(corev1.Secret)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantSecret, secret.Data)
+			assert.Equal(t, wgeHelmReleaseFileName, out[1].Name)
+		})
+	}
+}
+
+func TestCreateOIDCConfig_DryRun(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintln(w, `{"issuer": "https://example.com/issuer"}`)
+	}))
+	defer mockServer.Close()
+
+	// a dry-run of a fresh bootstrap plans the configuration without WGE in the cluster
+	config := MakeTestConfig(t, Config{
+		ModesConfig: ModesConfig{
+			Silent: true,
+			DryRun: true,
+		},
+		WgeConfig: WgeConfig{
+			RequestedVersion: "1.0.0",
+		},
+		InstallOIDC:  confirmYes,
+		DiscoveryURL: mockServer.URL,
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+	})
+
+	out, err := createOIDCConfig([]StepInput{}, &config)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(out))
+
+	secret, ok := out[0].Value.(corev1.Secret)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("http://localhost:8000/oauth2/callback"), secret.Data["redirectURL"])
+
+	helmRelease := out[1].Value.(fileContent).Content
+	assert.Contains(t, helmRelease, "issuerURL: https://example.com/issuer")
+	assert.Contains(t, helmRelease, "gitopssets-controller:")
+}
+
 func TestGetIssuer(t *testing.T) {
 	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		_, _ = fmt.Fprintln(w, `{"issuer": "https://example.com/issuer"}`)
@@ -0,0 +1,272 @@
+package steps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"text/tabwriter"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	k8s_client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// plan formats
+const (
+	PlanFormatText = "text"
+	PlanFormatJSON = "json"
+)
+
+// planned actions
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionUnchanged = "unchanged"
+	actionBootstrap = "bootstrap"
+	actionDelete    = "delete"
+	actionUninstall = "uninstall"
+)
+
+// PlannedAction is an action that the bootstrap would take against the cluster or the git repository.
+// They are collected instead of applied in dry-run mode.
+type PlannedAction struct {
+	// Step is the name of the step taking the action.
+	Step string `json:"step"`
+	// Action is one of create, update, unchanged, delete, bootstrap or uninstall.
+	Action string `json:"action"`
+	// Kind is the kind of the resource the action applies to.
+	Kind string `json:"kind"`
+	// Name is the namespaced name of the resource, or the repository for Flux.
+	Name string `json:"name"`
+	// Details explains how the action would be applied, for example the file committed.
+	Details string `json:"details,omitempty"`
+}
+
+// planOutputs compares the outputs of a step with the current state of the cluster to plan the actions
+// that would apply them.
+func planOutputs(stepName string, outputs []StepOutput, c *Config) error {
+	for _, output := range outputs {
+		switch output.Type {
+		case typeSecret:
+			secret, ok := output.Value.(v1.Secret)
+			if !ok {
+				return fmt.Errorf("unexpected internal error casting secret")
+			}
+			action, err := planSecret(c.KubernetesClient, secret)
+			if err != nil {
+				return err
+			}
+			c.Plan = append(c.Plan, PlannedAction{
+				Step:   stepName,
+				Action: action,
+				Kind:   "Secret",
+				Name:   fmt.Sprintf("%s/%s", secret.Namespace, secret.Name),
+			})
+		case typeFile:
+			file, ok := output.Value.(fileContent)
+			if !ok {
+				return fmt.Errorf("unexpected internal error casting file")
+			}
+			actions, err := planFile(c.KubernetesClient, stepName, file, c.GitRepository.Path)
+			if err != nil {
+				return err
+			}
+			c.Plan = append(c.Plan, actions...)
+		case typeRemoveSecret:
+			secret, ok := output.Value.(v1.Secret)
+			if !ok {
+				return fmt.Errorf("unexpected internal error casting secret")
+			}
+			if _, err := utils.GetSecret(c.KubernetesClient, secret.Name, secret.Namespace); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("cannot get secret %s/%s: %v", secret.Namespace, secret.Name, err)
+			}
+			c.Plan = append(c.Plan, PlannedAction{
+				Step:   stepName,
+				Action: actionDelete,
+				Kind:   "Secret",
+				Name:   fmt.Sprintf("%s/%s", secret.Namespace, secret.Name),
+			})
+		case typeRemoveFile:
+			file, ok := output.Value.(fileContent)
+			if !ok {
+				return fmt.Errorf("unexpected internal error casting file")
+			}
+			c.Plan = append(c.Plan, PlannedAction{
+				Step:    stepName,
+				Action:  actionDelete,
+				Kind:    "File",
+				Name:    file.Name,
+				Details: fmt.Sprintf("remove from %s, flux prunes its resources", c.GitRepository.Path),
+			})
+		default:
+			return fmt.Errorf("unsupported param type: %s", output.Type)
+		}
+	}
+	return nil
+}
+
+// planSecret returns whether a secret would be created, updated or is unchanged.
+func planSecret(client k8s_client.Client, secret v1.Secret) (string, error) {
+	existing, err := utils.GetSecret(client, secret.Name, secret.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return actionCreate, nil
+		}
+		return "", fmt.Errorf("cannot get secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+	if reflect.DeepEqual(existing.Data, secret.Data) {
+		return actionUnchanged, nil
+	}
+	return actionUpdate, nil
+}
+
+// planFile returns the actions to apply the resources of a file committed to the repository, they are
+// created if they don't exist in the cluster, unchanged if the cluster already has their content or
+// updated otherwise.
+func planFile(client k8s_client.Client, stepName string, file fileContent, repoPath string) ([]PlannedAction, error) {
+	actions := []PlannedAction{}
+	details := fmt.Sprintf("commit %s to %s", file.Name, repoPath)
+
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader([]byte(file.Content)), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("cannot decode file %s: %v", file.Name, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		action := actionUpdate
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		err := client.Get(context.Background(), k8s_client.ObjectKeyFromObject(obj), existing)
+		if err != nil {
+			if !apierrors.IsNotFound(err) && !apimeta.IsNoMatchError(err) {
+				return nil, fmt.Errorf("cannot get %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			}
+			action = actionCreate
+		} else if isAppliedContent(obj, existing) {
+			action = actionUnchanged
+		}
+
+		name := obj.GetName()
+		if obj.GetNamespace() != "" {
+			name = fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
+		}
+
+		actions = append(actions, PlannedAction{
+			Step:    stepName,
+			Action:  action,
+			Kind:    obj.GetKind(),
+			Name:    name,
+			Details: details,
+		})
+	}
+
+	return actions, nil
+}
+
+// isAppliedContent returns whether the existing object has the content of the desired one: its labels,
+// annotations and every field but status. Fields set by the cluster, like defaults, are ignored.
+func isAppliedContent(desired, existing *unstructured.Unstructured) bool {
+	content := map[string]interface{}{}
+	for field, value := range desired.Object {
+		if field != "metadata" && field != "status" {
+			content[field] = value
+		}
+	}
+	if !isSubset(content, existing.Object) {
+		return false
+	}
+
+	for field, values := range map[string]map[string]string{
+		"labels":      desired.GetLabels(),
+		"annotations": desired.GetAnnotations(),
+	} {
+		existingValues, _, _ := unstructured.NestedStringMap(existing.Object, "metadata", field)
+		for k, v := range values {
+			if existingValues[k] != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// isSubset returns whether every field set in desired has the same value in existing.
+func isSubset(desired, existing interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		e, ok := existing.(map[string]interface{})
+		if !ok {
+			return len(d) == 0 && existing == nil
+		}
+		for k, v := range d {
+			if !isSubset(v, e[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		e, ok := existing.([]interface{})
+		if !ok || len(d) != len(e) {
+			return len(d) == 0 && existing == nil
+		}
+		for i := range d {
+			if !isSubset(d[i], e[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		// numbers are decoded as int64 or float64 depending on the source
+		return fmt.Sprint(desired) == fmt.Sprint(existing)
+	}
+}
+
+// PrintPlan writes the planned actions in the given format.
+func PrintPlan(writer io.Writer, plan []PlannedAction, format string) error {
+	switch format {
+	case PlanFormatJSON:
+		if plan == nil {
+			plan = []PlannedAction{}
+		}
+		data, err := json.MarshalIndent(map[string][]PlannedAction{"actions": plan}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshalling plan: %v", err)
+		}
+		_, err = fmt.Fprintln(writer, string(data))
+		return err
+	case PlanFormatText, "":
+		if len(plan) == 0 {
+			_, err := fmt.Fprintln(writer, "no changes: the cluster is up to date")
+			return err
+		}
+		w := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "STEP\tACTION\tKIND\tNAME\tDETAILS")
+		for _, a := range plan {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", a.Step, a.Action, a.Kind, a.Name, a.Details)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unsupported plan format: %s", format)
+	}
+}
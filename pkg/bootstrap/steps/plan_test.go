@@ -0,0 +1,155 @@
+package steps
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPlanOutputs(t *testing.T) {
+	secret := v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-user-auth",
+			Namespace: "flux-system",
+		},
+		Data: map[string][]byte{
+			"username": []byte("wego-admin"),
+		},
+	}
+	changedSecret := secret.DeepCopy()
+	changedSecret.Data = map[string][]byte{
+		"username": []byte("admin"),
+	}
+
+	appliedHelmRelease := wgeHelmRelease(t, "0.35.0")
+	appliedHelmRelease.Spec.Chart.Spec.SourceRef.Kind = "HelmRepository"
+
+	helmReleaseFile := StepOutput{
+		Name: "wge",
+		Type: typeFile,
+		Value: fileContent{
+			Name:    "wge-hr.yaml",
+			Content: upgradedHelmRelease(t, "0.35.0") + "\n---\n" + fluxSystemGitRepositoryYaml,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		outputs []StepOutput
+		want    []PlannedAction
+	}{
+		{
+			name: "should plan to create missing secret",
+			outputs: []StepOutput{
+				{Name: "secret", Type: typeSecret, Value: secret},
+			},
+			want: []PlannedAction{
+				{Step: "test", Action: actionCreate, Kind: "Secret", Name: "flux-system/cluster-user-auth"},
+			},
+		},
+		{
+			name:    "should plan to update changed secret",
+			objects: []runtime.Object{changedSecret},
+			outputs: []StepOutput{
+				{Name: "secret", Type: typeSecret, Value: secret},
+			},
+			want: []PlannedAction{
+				{Step: "test", Action: actionUpdate, Kind: "Secret", Name: "flux-system/cluster-user-auth"},
+			},
+		},
+		{
+			name:    "should plan unchanged secret",
+			objects: []runtime.Object{secret.DeepCopy()},
+			outputs: []StepOutput{
+				{Name: "secret", Type: typeSecret, Value: secret},
+			},
+			want: []PlannedAction{
+				{Step: "test", Action: actionUnchanged, Kind: "Secret", Name: "flux-system/cluster-user-auth"},
+			},
+		},
+		{
+			name:    "should plan to create or update the resources of files",
+			objects: []runtime.Object{wgeHelmRelease(t, "0.34.0")},
+			outputs: []StepOutput{helmReleaseFile},
+			want: []PlannedAction{
+				{Step: "test", Action: actionUpdate, Kind: "HelmRelease", Name: "flux-system/weave-gitops-enterprise", Details: "commit wge-hr.yaml to clusters/management"},
+				{Step: "test", Action: actionCreate, Kind: "GitRepository", Name: "flux-system/flux-system", Details: "commit wge-hr.yaml to clusters/management"},
+			},
+		},
+		{
+			name:    "should plan unchanged resources of files",
+			objects: []runtime.Object{appliedHelmRelease},
+			outputs: []StepOutput{
+				{
+					Name: "wge",
+					Type: typeFile,
+					Value: fileContent{
+						Name:    "wge-hr.yaml",
+						Content: upgradedHelmRelease(t, "0.35.0"),
+					},
+				},
+			},
+			want: []PlannedAction{
+				{Step: "test", Action: actionUnchanged, Kind: "HelmRelease", Name: "flux-system/weave-gitops-enterprise", Details: "commit wge-hr.yaml to clusters/management"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := MakeTestConfig(t, Config{
+				ModesConfig: ModesConfig{
+					DryRun: true,
+				},
+				GitRepository: GitRepositoryConfig{
+					Path: "clusters/management",
+				},
+			}, tt.objects...)
+
+			err := planOutputs("test", tt.outputs, &config)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, config.Plan)
+		})
+	}
+}
+
+func TestPrintPlan(t *testing.T) {
+	plan := []PlannedAction{
+		{Step: "git credentials", Action: actionBootstrap, Kind: "Flux", Name: "ssh://git@github.com/example/fleet", Details: "flux bootstrap git --branch main --path clusters/management"},
+		{Step: "install WGE", Action: actionCreate, Kind: "HelmRelease", Name: "flux-system/weave-gitops-enterprise", Details: "commit wge-hr.yaml to clusters/management"},
+	}
+
+	t.Run("should print a table", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, PrintPlan(&buf, plan, PlanFormatText))
+		assert.Equal(t, `STEP             ACTION     KIND         NAME                                 DETAILS
+git credentials  bootstrap  Flux         ssh://git@github.com/example/fleet   flux bootstrap git --branch main --path clusters/management
+install WGE      create     HelmRelease  flux-system/weave-gitops-enterprise  commit wge-hr.yaml to clusters/management
+`, buf.String())
+	})
+
+	t.Run("should print json", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, PrintPlan(&buf, plan[:1], PlanFormatJSON))
+		assert.JSONEq(t, `{"actions":[{"step":"git credentials","action":"bootstrap","kind":"Flux","name":"ssh://git@github.com/example/fleet","details":"flux bootstrap git --branch main --path clusters/management"}]}`, buf.String())
+	})
+
+	t.Run("should print no changes", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, PrintPlan(&buf, nil, PlanFormatText))
+		assert.Equal(t, "no changes: the cluster is up to date\n", buf.String())
+	})
+}
+
+const fluxSystemGitRepositoryYaml = `apiVersion: source.toolkit.fluxcd.io/v1
+kind: GitRepository
+metadata:
+  name: flux-system
+  namespace: flux-system
+spec:
+  url: https://example.com/owner/repo
+`
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	k8syaml "sigs.k8s.io/yaml"
 )
 
@@ -131,6 +132,12 @@ func (s BootstrapStep) Execute(c *Config) ([]StepOutput, error) {
 		return []StepOutput{}, fmt.Errorf("cannot execute '%s': %v", s.Name, err)
 	}
 
+	if c.ModesConfig.DryRun {
+		if err := planOutputs(s.Name, outputs, c); err != nil {
+			return []StepOutput{}, fmt.Errorf("cannot plan output '%s': %v", s.Name, err)
+		}
+	}
+
 	err = defaultOutputStep(outputs, c)
 	if err != nil {
 		return []StepOutput{}, fmt.Errorf("cannot process output '%s': %v", s.Name, err)
@@ -144,7 +151,11 @@ func defaultInputStep(inputs []StepInput, c *Config, stdin io.Reader) ([]StepInp
 	for _, input := range inputs {
 		// process updates
 		if input.IsUpdate {
-			if !input.SupportUpdate {
+			if c.ModesConfig.Declarative {
+				// scenario 0 - declarative mode never asks: the step applies the values of the config file,
+				// updating the existing ones when they differ, or keeps them when the config file has none.
+				continue
+			} else if !input.SupportUpdate {
 				// scenario a - dont support update. we show the message saying that it will use existing value.
 				c.Logger.Warningf(input.UpdateMsg)
 				continue
@@ -160,6 +171,20 @@ func defaultInputStep(inputs []StepInput, c *Config, stdin io.Reader) ([]StepInp
 			continue
 		}
 
+		// we never ask the user in declarative mode but use the default value
+		if c.ModesConfig.Declarative {
+			if input.Enabled != nil && !input.Enabled(inputs, c) {
+				continue
+			}
+			value, err := declarativeValue(input)
+			if err != nil {
+				return []StepInput{}, err
+			}
+			input.Value = value
+			processedInputs = append(processedInputs, input)
+			continue
+		}
+
 		// we ask the user for input in any other condition
 		switch input.Type {
 		case stringInput:
@@ -250,10 +275,21 @@ func defaultInputStep(inputs []StepInput, c *Config, stdin io.Reader) ([]StepInp
 	return processedInputs, nil
 }
 
+// declarativeValue resolves the value of an input without asking the user. It fails
+// for inputs without default value that can't be empty.
+func declarativeValue(input StepInput) (string, error) {
+	value, _ := input.DefaultValue.(string)
+	if value == "" && (input.Type != passwordInput || input.Required) {
+		return "", fmt.Errorf("missing value for input '%s': set it in the config file", input.Name)
+	}
+	return value, nil
+}
+
 func defaultOutputStep(params []StepOutput, c *Config) error {
 
 	// if export we dont process at the level of the step but at the end of the workflow
-	if c.ModesConfig.Export {
+	// if dry-run the outputs are planned but not applied
+	if c.ModesConfig.Export || c.ModesConfig.DryRun {
 		return nil
 	}
 
@@ -268,7 +304,17 @@ func defaultOutputStep(params []StepOutput, c *Config) error {
 			namespace := secret.ObjectMeta.Namespace
 			data := secret.Data
 			c.Logger.Actionf("creating secret: %s/%s", namespace, name)
-			if err := utils.CreateSecret(c.KubernetesClient, name, namespace, data); err != nil {
+			err := utils.CreateSecret(c.KubernetesClient, name, namespace, data)
+			if apierrors.IsAlreadyExists(err) && c.ModesConfig.Declarative {
+				// declarative mode updates the existing secret to the values of the config file
+				c.Logger.Actionf("updating secret: %s/%s", namespace, name)
+				if err := utils.UpdateSecret(c.KubernetesClient, name, namespace, data); err != nil {
+					return err
+				}
+				c.Logger.Successf("updated secret %s/%s", secret.Namespace, secret.Name)
+				continue
+			}
+			if err != nil {
 				return err
 			}
 			c.Logger.Successf("created secret %s/%s", secret.Namespace, secret.Name)
@@ -297,6 +343,52 @@ func defaultOutputStep(params []StepOutput, c *Config) error {
 			}
 			c.Logger.Successf("file committed to repo: %s", file.Name)
 
+			c.Logger.Waitingf("reconciling changes")
+			if err := c.FluxClient.ReconcileFlux(); err != nil {
+				return err
+			}
+			c.Logger.Successf("changes are reconciled successfully!")
+		case typeRemoveSecret:
+			secret, ok := param.Value.(v1.Secret)
+			if !ok {
+				panic("unexpected internal error casting secret")
+			}
+			c.Logger.Actionf("deleting secret: %s/%s", secret.Namespace, secret.Name)
+			if err := utils.DeleteSecret(c.KubernetesClient, secret.Name, secret.Namespace); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return err
+				}
+			}
+			c.Logger.Successf("deleted secret %s/%s", secret.Namespace, secret.Name)
+		case typeRemoveFile:
+			c.Logger.Actionf("remove file from repo: %s", param.Name)
+			file, ok := param.Value.(fileContent)
+			if !ok {
+				panic("unexpected internal error casting file")
+			}
+			c.Logger.Actionf("cloning flux git repo: %s/%s", WGEDefaultNamespace, WGEDefaultRepoName)
+			pathInRepo, err := c.GitClient.CloneRepo(c.KubernetesClient, WGEDefaultRepoName, WGEDefaultNamespace, c.GitRepository.Scheme, c.PrivateKeyPath, c.PrivateKeyPassword, c.GitUsername, c.GitToken)
+			if err != nil {
+				return fmt.Errorf("cannot clone repo: %v", err)
+			}
+			defer func() {
+				err = utils.CleanupRepo()
+				if err != nil {
+					c.Logger.Failuref("failed to cleanup repo!")
+				}
+			}()
+			c.Logger.Successf("cloned flux git repo: %s/%s", WGEDefaultRepoName, WGEDefaultRepoName)
+
+			removed, err := c.GitClient.RemoveFileFromRepo(file.Name, pathInRepo, file.CommitMsg, c.GitRepository.Scheme, c.PrivateKeyPath, c.PrivateKeyPassword, c.GitUsername, c.GitToken)
+			if err != nil {
+				return err
+			}
+			if !removed {
+				c.Logger.Warningf("file not found in repo: %s", file.Name)
+				continue
+			}
+			c.Logger.Successf("file removed from repo: %s", file.Name)
+
 			c.Logger.Waitingf("reconciling changes")
 			if err := c.FluxClient.ReconcileFlux(); err != nil {
 				return err
@@ -19,6 +19,7 @@ func Test_defaultInputStep(t *testing.T) {
 		config     Config
 		userInputs []string
 		want       []StepInput
+		wantErr    string
 	}{
 		{
 			name: "should return introduced value if does not exist",
@@ -99,6 +100,53 @@ func Test_defaultInputStep(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should use default value without asking in declarative mode",
+			inputs: []StepInput{
+				{
+					Name:         inBranch,
+					Msg:          gitRepoBranchMsg,
+					Type:         stringInput,
+					DefaultValue: defaultBranch,
+				},
+				{
+					Name:          "cluster_user_password",
+					Msg:           "introduce cluster user password",
+					Type:          stringInput,
+					IsUpdate:      true,
+					SupportUpdate: true,
+					UpdateMsg:     "cluster user found. do you want to update",
+				},
+			},
+			config: MakeTestConfig(t, Config{
+				ModesConfig: ModesConfig{
+					Silent:      true,
+					Declarative: true,
+				},
+			}),
+			want: []StepInput{
+				{
+					Name:         inBranch,
+					Msg:          gitRepoBranchMsg,
+					Type:         stringInput,
+					DefaultValue: defaultBranch,
+					Value:        defaultBranch,
+				},
+			},
+		},
+		{
+			name: "should fail without default value in declarative mode",
+			inputs: []StepInput{
+				getRepoURL,
+			},
+			config: MakeTestConfig(t, Config{
+				ModesConfig: ModesConfig{
+					Silent:      true,
+					Declarative: true,
+				},
+			}),
+			wantErr: "missing value for input 'repoURL': set it in the config file",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -106,6 +154,10 @@ func Test_defaultInputStep(t *testing.T) {
 			defer stdin.Close()
 
 			got, err := defaultInputStep(tt.inputs, &tt.config, stdin)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
 			assert.NoError(t, err)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
 				t.Fatalf("not expected inputs:\n%s", diff)
@@ -150,6 +202,43 @@ func Test_defaultOutputStep(t *testing.T) {
 				assert.Nil(t, gotSecret, "not expected secret")
 			},
 		},
+		{
+			name: "should update existing secret in declarative mode",
+			outputs: []StepOutput{
+				{
+					Name: adminSecretName,
+					Type: typeSecret,
+					Value: v1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      adminSecretName,
+							Namespace: WGEDefaultNamespace,
+						},
+						Data: map[string][]byte{
+							"password": []byte("new-password"),
+						},
+					},
+				},
+			},
+			config: MakeTestConfig(t, Config{
+				ModesConfig: ModesConfig{
+					Silent:      true,
+					Declarative: true,
+				},
+			}, &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      adminSecretName,
+					Namespace: WGEDefaultNamespace,
+				},
+				Data: map[string][]byte{
+					"password": []byte("old-password"),
+				},
+			}),
+			assertFunc: func(t *testing.T, outputs []StepOutput, config Config) {
+				gotSecret, err := bootstrap_utils.GetSecret(config.KubernetesClient, adminSecretName, WGEDefaultNamespace)
+				assert.NoError(t, err)
+				assert.Equal(t, "new-password", string(gotSecret.Data["password"]))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -19,9 +19,9 @@ var (
 )
 
 // NewCheckUIDomainStep creates step to verify WGE after bootstrapping.
-// It also returns whether is required to execute for example in the case of export mode that will not be.
+// It also returns whether is required to execute for example in the case of export or dry-run modes that will not be.
 func NewCheckUIDomainStep(config ModesConfig) (step BootstrapStep, err error) {
-	if config.Export {
+	if config.Export || config.DryRun {
 		return checkUIDomainStepNotRequired, nil
 	}
 	return checkUIDomainStep, nil
@@ -32,7 +32,7 @@ func checkUIDomain(input []StepInput, c *Config) ([]StepOutput, error) {
 	if err := c.FluxClient.ReconcileHelmRelease(WgeHelmReleaseName); err != nil {
 		return []StepOutput{}, err
 	}
-	c.Logger.Successf(portforwardMsg, c.WgeConfig.RequestedVersion)
+	c.Logger.Successf(portforwardMsg, c.WgeConfig.version())
 	c.Logger.Actionf(credsMsg)
 	c.Logger.Println(portforwardCmdMsg, WGEDefaultNamespace)
 	return []StepOutput{}, nil
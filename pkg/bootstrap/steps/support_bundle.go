@@ -0,0 +1,217 @@
+package steps
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	corev1 "k8s.io/api/core/v1"
+	k8s_client "sigs.k8s.io/controller-runtime/pkg/client"
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	supportBundleFileNameFormat = "wge-support-bundle-%s.tar.gz"
+	supportBundleLogLines       = int64(500)
+	redactedValue               = "**REDACTED**"
+)
+
+// sensitiveValueKey matches the helm values keys that could hold credentials
+var sensitiveValueKey = regexp.MustCompile(`(?i)(password|secret|token|key|credential|cert)`)
+
+// helmReleaseStatus is the support bundle summary of a HelmRelease
+type helmReleaseStatus struct {
+	Name      string                   `json:"name"`
+	Namespace string                   `json:"namespace"`
+	Chart     string                   `json:"chart"`
+	Version   string                   `json:"version"`
+	Status    helmv2.HelmReleaseStatus `json:"status"`
+}
+
+// CollectSupportBundle writes a tarball to troubleshoot the installation: health check results, HelmRelease statuses,
+// pod logs and events of the WGE namespace and the sanitized WGE values. It returns the path of the tarball.
+// Collection errors are added to the bundle instead of failing, to get as much information as possible.
+func CollectSupportBundle(c *Config, results []HealthCheckResult) (string, error) {
+	path := c.SupportBundlePath
+	if path == "" {
+		path = fmt.Sprintf(supportBundleFileNameFormat, time.Now().Format("20060102-150405"))
+	}
+	c.Logger.Actionf("collecting support bundle to %s", path)
+
+	file, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("cannot create support bundle: %v", err)
+	}
+	defer file.Close()
+
+	if err := writeSupportBundle(file, c, results); err != nil {
+		return "", fmt.Errorf("cannot write support bundle: %v", err)
+	}
+	return path, nil
+}
+
+func writeSupportBundle(writer io.Writer, c *Config, results []HealthCheckResult) error {
+	gz := gzip.NewWriter(writer)
+	tw := tar.NewWriter(gz)
+
+	files := map[string][]byte{
+		"health-checks.txt": healthCheckReport(results),
+		"helmreleases.yaml": collectOrError(func() ([]byte, error) { return collectHelmReleases(c.KubernetesClient) }),
+		"events.yaml":       collectOrError(func() ([]byte, error) { return collectEvents(c.KubernetesClient) }),
+		"values.yaml":       collectOrError(func() ([]byte, error) { return collectSanitizedValues(c.KubernetesClient) }),
+	}
+	logs, err := collectPodLogs(c)
+	if err != nil {
+		files["logs/error.txt"] = []byte(err.Error())
+	}
+	for name, content := range logs {
+		files[name] = content
+	}
+
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(files[name])),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func collectOrError(collect func() ([]byte, error)) []byte {
+	content, err := collect()
+	if err != nil {
+		return []byte(fmt.Sprintf("error: %v\n", err))
+	}
+	return content
+}
+
+func healthCheckReport(results []HealthCheckResult) []byte {
+	var report strings.Builder
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(&report, "FAILED %s: %v\n", result.Name, result.Err)
+			continue
+		}
+		fmt.Fprintf(&report, "OK     %s: %s\n", result.Name, result.Message)
+	}
+	return []byte(report.String())
+}
+
+func collectHelmReleases(client k8s_client.Client) ([]byte, error) {
+	releases := helmv2.HelmReleaseList{}
+	if err := client.List(context.Background(), &releases); err != nil {
+		return nil, err
+	}
+
+	statuses := []helmReleaseStatus{}
+	for _, hr := range releases.Items {
+		statuses = append(statuses, helmReleaseStatus{
+			Name:      hr.Name,
+			Namespace: hr.Namespace,
+			Chart:     hr.Spec.Chart.Spec.Chart,
+			Version:   hr.Spec.Chart.Spec.Version,
+			Status:    hr.Status,
+		})
+	}
+	return k8syaml.Marshal(statuses)
+}
+
+func collectEvents(client k8s_client.Client) ([]byte, error) {
+	events := corev1.EventList{}
+	if err := client.List(context.Background(), &events, k8s_client.InNamespace(WGEDefaultNamespace)); err != nil {
+		return nil, err
+	}
+	return k8syaml.Marshal(events.Items)
+}
+
+func collectSanitizedValues(client k8s_client.Client) ([]byte, error) {
+	valuesBytes, err := utils.GetHelmReleaseValues(client, WgeHelmReleaseName, WGEDefaultNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	if len(valuesBytes) > 0 {
+		if err := json.Unmarshal(valuesBytes, &values); err != nil {
+			return nil, err
+		}
+	}
+	return k8syaml.Marshal(sanitizeValues(values))
+}
+
+// sanitizeValues redacts the values of keys that could hold credentials, whatever their type
+func sanitizeValues(values map[string]interface{}) map[string]interface{} {
+	sanitized := map[string]interface{}{}
+	for key, value := range values {
+		if sensitiveValueKey.MatchString(key) {
+			sanitized[key] = redactedValue
+			continue
+		}
+		switch v := value.(type) {
+		case map[string]interface{}:
+			sanitized[key] = sanitizeValues(v)
+		case []interface{}:
+			items := []interface{}{}
+			for _, item := range v {
+				if m, ok := item.(map[string]interface{}); ok {
+					item = sanitizeValues(m)
+				}
+				items = append(items, item)
+			}
+			sanitized[key] = items
+		default:
+			sanitized[key] = value
+		}
+	}
+	return sanitized
+}
+
+func collectPodLogs(c *Config) (map[string][]byte, error) {
+	pods := corev1.PodList{}
+	if err := c.KubernetesClient.List(context.Background(), &pods, k8s_client.InNamespace(WGEDefaultNamespace)); err != nil {
+		return nil, fmt.Errorf("cannot list pods: %v", err)
+	}
+
+	tailLines := supportBundleLogLines
+	logs := map[string][]byte{}
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			name := fmt.Sprintf("logs/%s/%s.log", pod.Name, container.Name)
+			logs[name] = collectOrError(func() ([]byte, error) {
+				return c.KubernetesClientSet.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+					Container: container.Name,
+					TailLines: &tailLines,
+				}).DoRaw(context.Background())
+			})
+		}
+	}
+	return logs, nil
+}
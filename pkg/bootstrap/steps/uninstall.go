@@ -0,0 +1,253 @@
+package steps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// user messages
+const (
+	uninstallConfirmMsg          = "uninstall removes Weave GitOps Enterprise, its extra components and credentials from the cluster and the git repository, do you want to continue"
+	uninstallWithFluxConfirmMsg  = "uninstall removes Weave GitOps Enterprise, its extra components, credentials and Flux from the cluster and the git repository, do you want to continue"
+	uninstallCancelledMsg        = "uninstall cancelled"
+	uninstallFluxInfoMsg         = "uninstalling flux ..."
+	uninstallHelmReleasesWaitMsg = "waiting for the helm releases to be uninstalled ..."
+	uninstallFluxConfirmMsg      = "flux is uninstalled successfully"
+)
+
+const (
+	inConfirmUninstall = "confirmUninstall"
+
+	wgeHelmReleaseRemoveCommitMsg    = "Remove WGE HelmRelease YAML file"
+	wgeHelmRepositoryRemoveCommitMsg = "Remove WGE HelmRepository YAML file"
+)
+
+var (
+	uninstallStepNotRequired = BootstrapStep{
+		Name: "uninstallStepNotRequired",
+		Step: doNothingStep,
+	}
+
+	// helmReleasesRemovalTimeout bounds the wait for helm-controller to uninstall the removed HelmReleases
+	helmReleasesRemovalTimeout      = 5 * time.Minute
+	helmReleasesRemovalPollInterval = 5 * time.Second
+)
+
+// NewConfirmUninstallStep asks the user to confirm the uninstall. It is not asked in silent or dry-run modes.
+func NewConfirmUninstallStep(config Config) BootstrapStep {
+	inputs := []StepInput{}
+
+	if !config.ModesConfig.Silent && !config.ModesConfig.DryRun {
+		msg := uninstallWithFluxConfirmMsg
+		if config.KeepFlux || !config.FluxConfig.IsInstalled {
+			msg = uninstallConfirmMsg
+		}
+		inputs = append(inputs, StepInput{
+			Name:         inConfirmUninstall,
+			Type:         confirmInput,
+			Msg:          msg,
+			DefaultValue: confirmNo,
+		})
+	}
+
+	return BootstrapStep{
+		Name:  "confirm uninstall",
+		Input: inputs,
+		Step:  confirmUninstall,
+	}
+}
+
+func confirmUninstall(input []StepInput, c *Config) ([]StepOutput, error) {
+	for _, param := range input {
+		if param.Name == inConfirmUninstall {
+			confirm, ok := param.Value.(string)
+			if !ok || confirm != confirmYes {
+				return []StepOutput{}, fmt.Errorf(uninstallCancelledMsg)
+			}
+		}
+	}
+	return []StepOutput{}, nil
+}
+
+// NewUninstallExtraComponentsStep removes the extra components found in the cluster from the repo.
+func NewUninstallExtraComponentsStep(config ComponentsExtraConfig) BootstrapStep {
+	if len(config.Existing) == 0 {
+		return uninstallStepNotRequired
+	}
+
+	return BootstrapStep{
+		Name: "uninstall extra components",
+		Step: uninstallExtraComponents,
+	}
+}
+
+func uninstallExtraComponents(input []StepInput, c *Config) ([]StepOutput, error) {
+	outputs := []StepOutput{}
+	for _, component := range c.ComponentsExtra.Existing {
+		registered, ok := GetComponent(component)
+		if !ok {
+			continue
+		}
+		outputs = append(outputs, StepOutput{
+			Name:  registered.FileName,
+			Type:  typeRemoveFile,
+			Value: fileContent{Name: registered.FileName, CommitMsg: registered.RemoveCommitMsg},
+		})
+	}
+	return outputs, nil
+}
+
+// NewUninstallOIDCStep removes the OIDC secret. OIDC values are removed with the WGE HelmRelease.
+func NewUninstallOIDCStep() BootstrapStep {
+	return BootstrapStep{
+		Name: "uninstall OIDC",
+		Step: uninstallOIDC,
+	}
+}
+
+func uninstallOIDC(input []StepInput, c *Config) ([]StepOutput, error) {
+	_, err := utils.GetSecret(c.KubernetesClient, oidcSecretName, WGEDefaultNamespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return []StepOutput{}, nil
+		}
+		return []StepOutput{}, fmt.Errorf("cannot get secret %s/%s: %v", WGEDefaultNamespace, oidcSecretName, err)
+	}
+
+	return []StepOutput{
+		{
+			Name:  oidcSecretName,
+			Type:  typeRemoveSecret,
+			Value: secretToRemove(oidcSecretName),
+		},
+	}, nil
+}
+
+// NewUninstallWGEStep removes the WGE HelmRelease and HelmRepository from the repo
+// and the admin credentials from the cluster.
+func NewUninstallWGEStep(config WgeConfig, auth ClusterUserAuthConfig) BootstrapStep {
+	if config.ExistingVersion == "" && !auth.ExistCredentials {
+		return uninstallStepNotRequired
+	}
+
+	return BootstrapStep{
+		Name: "uninstall Weave GitOps Enterprise",
+		Step: uninstallWge,
+	}
+}
+
+func uninstallWge(input []StepInput, c *Config) ([]StepOutput, error) {
+	outputs := []StepOutput{}
+
+	if c.WgeConfig.ExistingVersion != "" {
+		outputs = append(outputs,
+			StepOutput{
+				Name:  wgeHelmReleaseFileName,
+				Type:  typeRemoveFile,
+				Value: fileContent{Name: wgeHelmReleaseFileName, CommitMsg: wgeHelmReleaseRemoveCommitMsg},
+			},
+			StepOutput{
+				Name:  wgeHelmrepoFileName,
+				Type:  typeRemoveFile,
+				Value: fileContent{Name: wgeHelmrepoFileName, CommitMsg: wgeHelmRepositoryRemoveCommitMsg},
+			},
+		)
+	}
+
+	if c.ClusterUserAuth.ExistCredentials {
+		outputs = append(outputs, StepOutput{
+			Name:  adminSecretName,
+			Type:  typeRemoveSecret,
+			Value: secretToRemove(adminSecretName),
+		})
+	}
+
+	return outputs, nil
+}
+
+// NewUninstallFluxStep uninstalls flux unless it is kept or not installed.
+func NewUninstallFluxStep(config Config) BootstrapStep {
+	if config.KeepFlux || !config.FluxConfig.IsInstalled {
+		return uninstallStepNotRequired
+	}
+
+	return BootstrapStep{
+		Name: "uninstall flux",
+		Step: uninstallFlux,
+	}
+}
+
+func uninstallFlux(input []StepInput, c *Config) ([]StepOutput, error) {
+	if c.ModesConfig.DryRun {
+		c.Plan = append(c.Plan, PlannedAction{
+			Step:    "uninstall flux",
+			Action:  actionUninstall,
+			Kind:    "Flux",
+			Name:    WGEDefaultNamespace,
+			Details: "flux uninstall",
+		})
+		return []StepOutput{}, nil
+	}
+
+	if err := waitForHelmReleasesRemoval(c); err != nil {
+		return []StepOutput{}, err
+	}
+
+	c.Logger.Actionf(uninstallFluxInfoMsg)
+	if err := c.FluxClient.UninstallFlux(); err != nil {
+		return []StepOutput{}, err
+	}
+	c.Logger.Successf(uninstallFluxConfirmMsg)
+	return []StepOutput{}, nil
+}
+
+// waitForHelmReleasesRemoval waits for helm-controller to uninstall the HelmReleases of WGE and the
+// extra components, so Flux isn't removed before them leaving orphaned workloads and finalizers.
+func waitForHelmReleasesRemoval(c *Config) error {
+	names := append([]string{}, c.ComponentsExtra.Existing...)
+	if c.WgeConfig.ExistingVersion != "" {
+		names = append(names, WgeHelmReleaseName)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	c.Logger.Waitingf(uninstallHelmReleasesWaitMsg)
+	remaining := []string{}
+	err := wait.PollUntilContextTimeout(context.Background(), helmReleasesRemovalPollInterval, helmReleasesRemovalTimeout, true, func(ctx context.Context) (bool, error) {
+		remaining = []string{}
+		for _, name := range names {
+			err := c.KubernetesClient.Get(ctx, client.ObjectKey{Name: name, Namespace: WGEDefaultNamespace}, &helmv2.HelmRelease{})
+			if err == nil {
+				remaining = append(remaining, name)
+				continue
+			}
+			if !apierrors.IsNotFound(err) {
+				return false, err
+			}
+		}
+		return len(remaining) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("helm releases %v are not uninstalled, flux is kept to uninstall them: %v", remaining, err)
+	}
+	return nil
+}
+
+func secretToRemove(name string) v1.Secret {
+	return v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: WGEDefaultNamespace,
+		},
+	}
+}
@@ -0,0 +1,204 @@
+package steps
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/stretchr/testify/assert"
+	bootstrap_utils "github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	"github.com/weaveworks/weave-gitops-enterprise/test/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUninstallSteps(t *testing.T) {
+	adminSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: adminSecretName, Namespace: WGEDefaultNamespace},
+	}
+	oidcSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: oidcSecretName, Namespace: WGEDefaultNamespace},
+	}
+
+	config := MakeTestConfig(t, Config{
+		ModesConfig: ModesConfig{
+			Silent: true,
+		},
+		WgeConfig: WgeConfig{
+			ExistingVersion: "0.35.0",
+		},
+		ClusterUserAuth: ClusterUserAuthConfig{
+			ExistCredentials: true,
+		},
+		ComponentsExtra: ComponentsExtraConfig{
+			Existing: []string{tfController},
+		},
+	}, adminSecret, oidcSecret)
+
+	t.Run("should remove extra components files", func(t *testing.T) {
+		outputs, err := NewUninstallExtraComponentsStep(config.ComponentsExtra).Execute(&config)
+		assert.NoError(t, err)
+		assert.Equal(t, []StepOutput{
+			{
+				Name:  tfFileName,
+				Type:  typeRemoveFile,
+				Value: fileContent{Name: tfFileName, CommitMsg: tfRemoveCommitMsg},
+			},
+		}, outputs)
+	})
+
+	t.Run("should remove oidc secret", func(t *testing.T) {
+		outputs, err := NewUninstallOIDCStep().Execute(&config)
+		assert.NoError(t, err)
+		assert.Len(t, outputs, 1)
+
+		_, err = bootstrap_utils.GetSecret(config.KubernetesClient, oidcSecretName, WGEDefaultNamespace)
+		assert.True(t, apierrors.IsNotFound(err), "oidc secret should be deleted")
+
+		// re-running is a no-op
+		outputs, err = NewUninstallOIDCStep().Execute(&config)
+		assert.NoError(t, err)
+		assert.Empty(t, outputs)
+	})
+
+	t.Run("should remove wge files and admin credentials", func(t *testing.T) {
+		outputs, err := NewUninstallWGEStep(config.WgeConfig, config.ClusterUserAuth).Execute(&config)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{wgeHelmReleaseFileName, wgeHelmrepoFileName, adminSecretName}, outputNames(outputs))
+
+		_, err = bootstrap_utils.GetSecret(config.KubernetesClient, adminSecretName, WGEDefaultNamespace)
+		assert.True(t, apierrors.IsNotFound(err), "admin secret should be deleted")
+	})
+
+	t.Run("should not be required when nothing is installed", func(t *testing.T) {
+		assert.Equal(t, uninstallStepNotRequired.Name, NewUninstallExtraComponentsStep(ComponentsExtraConfig{}).Name)
+		assert.Equal(t, uninstallStepNotRequired.Name, NewUninstallWGEStep(WgeConfig{}, ClusterUserAuthConfig{}).Name)
+	})
+}
+
+func TestNewUninstallFluxStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		wantName string
+	}{
+		{
+			name:     "should uninstall flux",
+			config:   Config{FluxConfig: FluxConfig{IsInstalled: true}},
+			wantName: "uninstall flux",
+		},
+		{
+			name:     "should keep flux",
+			config:   Config{FluxConfig: FluxConfig{IsInstalled: true}, KeepFlux: true},
+			wantName: uninstallStepNotRequired.Name,
+		},
+		{
+			name:     "should skip flux not installed",
+			config:   Config{},
+			wantName: uninstallStepNotRequired.Name,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantName, NewUninstallFluxStep(tt.config).Name)
+		})
+	}
+}
+
+// uninstallFluxClient records whether flux is uninstalled
+type uninstallFluxClient struct {
+	fakeFluxClient
+	uninstalled *bool
+}
+
+func (f uninstallFluxClient) UninstallFlux() error {
+	*f.uninstalled = true
+	return nil
+}
+
+func TestUninstallFlux(t *testing.T) {
+	defer func(timeout, interval time.Duration) {
+		helmReleasesRemovalTimeout, helmReleasesRemovalPollInterval = timeout, interval
+	}(helmReleasesRemovalTimeout, helmReleasesRemovalPollInterval)
+	helmReleasesRemovalTimeout = 500 * time.Millisecond
+	helmReleasesRemovalPollInterval = 10 * time.Millisecond
+
+	newConfig := func(uninstalled *bool) Config {
+		wgeRelease := &helmv2.HelmRelease{
+			ObjectMeta: metav1.ObjectMeta{Name: WgeHelmReleaseName, Namespace: WGEDefaultNamespace},
+		}
+		config := MakeTestConfig(t, Config{
+			WgeConfig: WgeConfig{
+				ExistingVersion: "0.35.0",
+			},
+		}, wgeRelease)
+		config.FluxClient = uninstallFluxClient{uninstalled: uninstalled}
+		return config
+	}
+
+	t.Run("should uninstall flux once the helm releases are uninstalled", func(t *testing.T) {
+		uninstalled := false
+		config := newConfig(&uninstalled)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			assert.NoError(t, config.KubernetesClient.Delete(context.Background(), &helmv2.HelmRelease{
+				ObjectMeta: metav1.ObjectMeta{Name: WgeHelmReleaseName, Namespace: WGEDefaultNamespace},
+			}))
+		}()
+
+		_, err := uninstallFlux([]StepInput{}, &config)
+		assert.NoError(t, err)
+		assert.True(t, uninstalled)
+	})
+
+	t.Run("should keep flux while the helm releases are not uninstalled", func(t *testing.T) {
+		uninstalled := false
+		config := newConfig(&uninstalled)
+
+		_, err := uninstallFlux([]StepInput{}, &config)
+		assert.ErrorContains(t, err, "helm releases [weave-gitops-enterprise] are not uninstalled")
+		assert.False(t, uninstalled)
+	})
+}
+
+func TestConfirmUninstall(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:  "should continue when confirmed",
+			input: "y\n",
+		},
+		{
+			name:    "should cancel when not confirmed",
+			input:   "n\n",
+			wantErr: "cannot execute 'confirm uninstall': uninstall cancelled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := MakeTestConfig(t, Config{
+				InReader: &utils.MockReader{Inputs: []string{tt.input}},
+			})
+			_, err := NewConfirmUninstallStep(config).Execute(&config)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func outputNames(outputs []StepOutput) []string {
+	names := []string{}
+	for _, output := range outputs {
+		names = append(names, output.Name)
+	}
+	return names
+}
@@ -0,0 +1,235 @@
+package steps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	k8s_client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// user messages
+const (
+	verifyStepName         = "verifying installation"
+	healthCheckPassedMsg   = "%s: %s"
+	healthCheckFailedMsg   = "%s: %v"
+	healthChecksFailedMsg  = "%d health checks failed, support bundle written to %s"
+	supportBundleFailedMsg = "%d health checks failed, cannot write support bundle: %v"
+)
+
+const (
+	clustersServiceName = "clusters-service"
+	// clustersServicePort is the name of the clusters-service port, it serves https unless tls is disabled
+	clustersServicePort     = "https"
+	clustersServiceProbeURL = "/v1/featureflags"
+	wgeInstanceLabel        = "app.kubernetes.io/instance"
+	oidcDiscoveryPath       = "/.well-known/openid-configuration"
+	healthCheckTimeout      = 30 * time.Second
+)
+
+// HealthCheck verifies a part of the WGE installation. Check returns a short description of the healthy state.
+type HealthCheck struct {
+	Name  string
+	Check func(c *Config) (string, error)
+}
+
+// HealthCheckResult is the result of running a HealthCheck.
+type HealthCheckResult struct {
+	Name    string
+	Message string
+	Err     error
+}
+
+// HealthChecks verify WGE after bootstrapping, in order of dependency.
+var HealthChecks = []HealthCheck{
+	{Name: "entitlement", Check: checkEntitlementHealth},
+	{Name: "weave gitops release", Check: checkWGEHelmRelease},
+	{Name: "weave gitops pods", Check: checkWGEPods},
+	{Name: "clusters-service api", Check: checkClustersServiceAPI},
+	{Name: "oidc discovery", Check: checkOIDCDiscovery},
+}
+
+var verifyStepNotRequired = BootstrapStep{
+	Name: "verifyStepNotRequired",
+	Step: doNothingStep,
+}
+
+// NewVerifyStep creates the step to verify WGE is healthy after bootstrapping. In case of failure,
+// it collects a support bundle. It is not required in export or dry-run modes.
+func NewVerifyStep(config ModesConfig) BootstrapStep {
+	if config.Export || config.DryRun {
+		return verifyStepNotRequired
+	}
+	return BootstrapStep{
+		Name: verifyStepName,
+		Step: verifyInstallation,
+	}
+}
+
+func verifyInstallation(input []StepInput, c *Config) ([]StepOutput, error) {
+	results := RunHealthChecks(c)
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			c.Logger.Failuref(healthCheckFailedMsg, result.Name, result.Err)
+			continue
+		}
+		c.Logger.Successf(healthCheckPassedMsg, result.Name, result.Message)
+	}
+	if failed == 0 {
+		return []StepOutput{}, nil
+	}
+
+	bundlePath, err := CollectSupportBundle(c, results)
+	if err != nil {
+		return []StepOutput{}, fmt.Errorf(supportBundleFailedMsg, failed, err)
+	}
+	return []StepOutput{}, fmt.Errorf(healthChecksFailedMsg, failed, bundlePath)
+}
+
+// RunHealthChecks runs all the health checks, it doesn't stop on failure to report the whole state.
+func RunHealthChecks(c *Config) []HealthCheckResult {
+	results := []HealthCheckResult{}
+	for _, check := range HealthChecks {
+		msg, err := check.Check(c)
+		results = append(results, HealthCheckResult{
+			Name:    check.Name,
+			Message: msg,
+			Err:     err,
+		})
+	}
+	return results
+}
+
+func checkEntitlementHealth(c *Config) (string, error) {
+	if err := verifyEntitlementSecret(c.KubernetesClient); err != nil {
+		return "", err
+	}
+	return entitlementCheckConfirmMsg, nil
+}
+
+func checkWGEHelmRelease(c *Config) (string, error) {
+	hr := helmv2.HelmRelease{}
+	err := c.KubernetesClient.Get(context.Background(), types.NamespacedName{
+		Name:      WgeHelmReleaseName,
+		Namespace: WGEDefaultNamespace,
+	}, &hr)
+	if err != nil {
+		return "", fmt.Errorf("cannot get helm release %s: %v", WgeHelmReleaseName, err)
+	}
+
+	ready := apimeta.FindStatusCondition(hr.Status.Conditions, meta.ReadyCondition)
+	if ready == nil || ready.Status != "True" {
+		reason := "not reconciled yet"
+		if ready != nil {
+			reason = ready.Message
+		}
+		return "", fmt.Errorf("helm release %s is not ready: %s", WgeHelmReleaseName, reason)
+	}
+	return fmt.Sprintf("helm release %s is ready", hr.Status.LastAppliedRevision), nil
+}
+
+func checkWGEPods(c *Config) (string, error) {
+	pods := corev1.PodList{}
+	err := c.KubernetesClient.List(context.Background(), &pods,
+		k8s_client.InNamespace(WGEDefaultNamespace),
+		k8s_client.MatchingLabels{wgeInstanceLabel: WgeHelmReleaseName},
+	)
+	if err != nil {
+		return "", fmt.Errorf("cannot list pods: %v", err)
+	}
+	if len(pods.Items) == 0 {
+		return "", fmt.Errorf("no pods found for %s", WgeHelmReleaseName)
+	}
+
+	notReady := []string{}
+	for _, pod := range pods.Items {
+		if !isPodReady(pod) {
+			notReady = append(notReady, pod.Name)
+		}
+	}
+	if len(notReady) > 0 {
+		return "", fmt.Errorf("pods are not ready: %s", strings.Join(notReady, ", "))
+	}
+	return fmt.Sprintf("%d pods are ready", len(pods.Items)), nil
+}
+
+func isPodReady(pod corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// checkClustersServiceAPI calls a public endpoint of the clusters-service api through the kubernetes api server proxy,
+// so it works without exposing the dashboard.
+func checkClustersServiceAPI(c *Config) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	scheme, err := clustersServiceScheme(c)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.KubernetesClientSet.CoreV1().Services(WGEDefaultNamespace).
+		ProxyGet(scheme, clustersServiceName, clustersServicePort, clustersServiceProbeURL, nil).
+		DoRaw(ctx)
+	if err != nil {
+		return "", fmt.Errorf("clusters-service api is not answering: %v", err)
+	}
+	return "clusters-service api is answering", nil
+}
+
+// clustersServiceScheme returns the scheme clusters-service serves with the values of the WGE HelmRelease,
+// https unless tls is disabled like the chart defaults.
+func clustersServiceScheme(c *Config) (string, error) {
+	valuesBytes, err := utils.GetHelmReleaseValues(c.KubernetesClient, WgeHelmReleaseName, WGEDefaultNamespace)
+	if err != nil {
+		return "", fmt.Errorf("cannot get helm release values: %v", err)
+	}
+	var wgeValues valuesFile
+	if err := json.Unmarshal(valuesBytes, &wgeValues); err != nil {
+		return "", fmt.Errorf("cannot read helm release values: %v", err)
+	}
+	if enabled, ok := wgeValues.TLS["enabled"].(bool); ok && !enabled {
+		return "http", nil
+	}
+	return "https", nil
+}
+
+func checkOIDCDiscovery(c *Config) (string, error) {
+	secret, err := utils.GetSecret(c.KubernetesClient, oidcSecretName, WGEDefaultNamespace)
+	if apierrors.IsNotFound(err) {
+		return "oidc is not configured", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("cannot get oidc secret: %v", err)
+	}
+
+	discoveryURL := strings.TrimSuffix(string(secret.Data["issuerURL"]), "/") + oidcDiscoveryPath
+	client := http.Client{Timeout: healthCheckTimeout}
+	resp, err := client.Get(discoveryURL)
+	if err != nil {
+		return "", fmt.Errorf("cannot reach oidc discovery url: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("oidc discovery url %s returned %s", discoveryURL, resp.Status)
+	}
+	return fmt.Sprintf("oidc discovery url %s is reachable", discoveryURL), nil
+}
@@ -0,0 +1,278 @@
+package steps
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// fakeProxyResponse is the response of the clusters-service api through the api server proxy
+type fakeProxyResponse struct {
+	err error
+}
+
+func (r fakeProxyResponse) DoRaw(context.Context) ([]byte, error) {
+	return []byte("{}"), r.err
+}
+
+func (r fakeProxyResponse) Stream(context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader("{}")), r.err
+}
+
+// newFakeClientSet returns a clientset proxying to the clusters-service api when it is called with
+// the https port and scheme, unless it returns proxyErr.
+func newFakeClientSet(scheme string, proxyErr error) *fake.Clientset {
+	clientSet := fake.NewSimpleClientset()
+	clientSet.PrependProxyReactor("services", func(action k8stesting.Action) (bool, rest.ResponseWrapper, error) {
+		proxy := action.(k8stesting.ProxyGetAction)
+		if proxy.GetScheme() != scheme || proxy.GetPort() != "https" {
+			return true, fakeProxyResponse{err: fmt.Errorf("no endpoint for %s:%s", proxy.GetScheme(), proxy.GetPort())}, nil
+		}
+		return true, fakeProxyResponse{err: proxyErr}, nil
+	})
+	return clientSet
+}
+
+func makeWGEPod(name string, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: WGEDefaultNamespace,
+			Labels: map[string]string{
+				wgeInstanceLabel: WgeHelmReleaseName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "clusters-service"}},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+		},
+	}
+}
+
+func TestHealthChecks(t *testing.T) {
+	wgeObject, err := createWGEHelmReleaseFakeObject("1.0.0")
+	assert.NoError(t, err)
+	readyWGEObject := *wgeObject.DeepCopy()
+	readyWGEObject.Status.LastAppliedRevision = "1.0.0"
+	readyWGEObject.Status.Conditions = []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue}}
+	// tls is enabled unless the values disable it
+	tlsWGEObject := *wgeObject.DeepCopy()
+	tlsWGEObject.Spec.Values.Raw = []byte(`{}`)
+
+	oidcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != oidcDiscoveryPath {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer oidcServer.Close()
+
+	oidcSecret := func(issuerURL string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: oidcSecretName, Namespace: WGEDefaultNamespace},
+			Data:       map[string][]byte{"issuerURL": []byte(issuerURL)},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		check     func(c *Config) (string, error)
+		objects   []runtime.Object
+		clientSet *fake.Clientset
+		wantMsg   string
+		wantErr   string
+	}{
+		{
+			name:    "release is ready",
+			check:   checkWGEHelmRelease,
+			objects: []runtime.Object{&readyWGEObject},
+			wantMsg: "helm release 1.0.0 is ready",
+		},
+		{
+			name:    "release is not reconciled",
+			check:   checkWGEHelmRelease,
+			objects: []runtime.Object{&wgeObject},
+			wantErr: "helm release weave-gitops-enterprise is not ready: not reconciled yet",
+		},
+		{
+			name:    "pods are ready",
+			check:   checkWGEPods,
+			objects: []runtime.Object{makeWGEPod("clusters-service-1", corev1.ConditionTrue)},
+			wantMsg: "1 pods are ready",
+		},
+		{
+			name:    "pods are not ready",
+			check:   checkWGEPods,
+			objects: []runtime.Object{makeWGEPod("clusters-service-1", corev1.ConditionFalse)},
+			wantErr: "pods are not ready: clusters-service-1",
+		},
+		{
+			name:    "no pods",
+			check:   checkWGEPods,
+			wantErr: "no pods found for weave-gitops-enterprise",
+		},
+		{
+			name:      "api is answering with tls",
+			check:     checkClustersServiceAPI,
+			objects:   []runtime.Object{&tlsWGEObject},
+			clientSet: newFakeClientSet("https", nil),
+			wantMsg:   "clusters-service api is answering",
+		},
+		{
+			name:      "api is answering without tls",
+			check:     checkClustersServiceAPI,
+			objects:   []runtime.Object{&wgeObject},
+			clientSet: newFakeClientSet("http", nil),
+			wantMsg:   "clusters-service api is answering",
+		},
+		{
+			name:      "api is called with the tls scheme",
+			check:     checkClustersServiceAPI,
+			objects:   []runtime.Object{&tlsWGEObject},
+			clientSet: newFakeClientSet("http", nil),
+			wantErr:   "clusters-service api is not answering: no endpoint for https:https",
+		},
+		{
+			name:      "api is not answering",
+			check:     checkClustersServiceAPI,
+			objects:   []runtime.Object{&wgeObject},
+			clientSet: newFakeClientSet("http", fmt.Errorf("service unavailable")),
+			wantErr:   "clusters-service api is not answering: service unavailable",
+		},
+		{
+			name:    "oidc is not configured",
+			check:   checkOIDCDiscovery,
+			wantMsg: "oidc is not configured",
+		},
+		{
+			name:    "oidc discovery is reachable",
+			check:   checkOIDCDiscovery,
+			objects: []runtime.Object{oidcSecret(oidcServer.URL + "/")},
+			wantMsg: fmt.Sprintf("oidc discovery url %s%s is reachable", oidcServer.URL, oidcDiscoveryPath),
+		},
+		{
+			name:    "oidc discovery is not found",
+			check:   checkOIDCDiscovery,
+			objects: []runtime.Object{oidcSecret(oidcServer.URL + "/dex")},
+			wantErr: fmt.Sprintf("oidc discovery url %s/dex%s returned 404 Not Found", oidcServer.URL, oidcDiscoveryPath),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := MakeTestConfig(t, Config{KubernetesClientSet: tt.clientSet}, tt.objects...)
+			msg, err := tt.check(&config)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMsg, msg)
+		})
+	}
+}
+
+func TestVerifyInstallation(t *testing.T) {
+	t.Run("should not verify in dry-run mode", func(t *testing.T) {
+		step := NewVerifyStep(ModesConfig{DryRun: true})
+		assert.Equal(t, verifyStepNotRequired.Name, step.Name)
+	})
+
+	t.Run("should collect support bundle on failure", func(t *testing.T) {
+		wgeObject, err := createWGEHelmReleaseFakeObject("1.0.0")
+		assert.NoError(t, err)
+		wgeObject.Spec.Values.Raw = []byte(`{"config":{"oidc":{"clientSecret":"secret","enabled":true}}}`)
+
+		bundlePath := filepath.Join(t.TempDir(), "bundle.tar.gz")
+		config := MakeTestConfig(t, Config{
+			KubernetesClientSet: newFakeClientSet("https", nil),
+			SupportBundlePath:   bundlePath,
+		}, &wgeObject, makeWGEPod("clusters-service-1", corev1.ConditionFalse))
+
+		_, err = NewVerifyStep(config.ModesConfig).Execute(&config)
+		assert.ErrorContains(t, err, "support bundle written to "+bundlePath)
+
+		files := readSupportBundle(t, bundlePath)
+		assert.Contains(t, files["health-checks.txt"], "FAILED weave gitops pods: pods are not ready: clusters-service-1")
+		assert.Contains(t, files["health-checks.txt"], "OK     clusters-service api: clusters-service api is answering")
+		assert.Contains(t, files["helmreleases.yaml"], "name: weave-gitops-enterprise")
+		assert.Contains(t, files["values.yaml"], "clientSecret: '**REDACTED**'")
+		assert.Contains(t, files["values.yaml"], "enabled: true")
+		assert.Contains(t, files, "events.yaml")
+		assert.Contains(t, files, "logs/clusters-service-1/clusters-service.log")
+	})
+}
+
+func TestSanitizeValues(t *testing.T) {
+	values := map[string]interface{}{
+		"config": map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"clientSecret": "secret",
+				"enabled":      true,
+			},
+		},
+		"passwords": []interface{}{"first", "second"},
+		"secret": map[string]interface{}{
+			"name": "git-credentials",
+		},
+		"extraVolumes": []interface{}{
+			map[string]interface{}{"name": "tls", "token": "token"},
+		},
+		"replicas": float64(2),
+	}
+
+	assert.Equal(t, map[string]interface{}{
+		"config": map[string]interface{}{
+			"oidc": map[string]interface{}{
+				"clientSecret": redactedValue,
+				"enabled":      true,
+			},
+		},
+		"passwords": redactedValue,
+		"secret":    redactedValue,
+		"extraVolumes": []interface{}{
+			map[string]interface{}{"name": "tls", "token": redactedValue},
+		},
+		"replicas": float64(2),
+	}, sanitizeValues(values))
+}
+
+func readSupportBundle(t *testing.T, path string) map[string]string {
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	assert.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		assert.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+	return files
+}
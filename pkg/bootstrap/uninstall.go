@@ -0,0 +1,33 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/bootstrap/steps"
+)
+
+// Uninstall initiated by the command runs the WGE uninstall workflow. It removes what bootstrap
+// created in the cluster and the git repository, in reverse order.
+func Uninstall(config steps.Config) error {
+	if config.ModesConfig.Export {
+		return fmt.Errorf("export mode is not supported by uninstall")
+	}
+
+	var workflow = []steps.BootstrapStep{
+		steps.NewConfirmUninstallStep(config),
+	}
+
+	// git credentials are only required to remove files from the flux repository
+	if config.FluxConfig.IsInstalled {
+		workflow = append(workflow, steps.NewBootstrapFlux(config))
+	}
+
+	workflow = append(workflow,
+		steps.NewUninstallExtraComponentsStep(config.ComponentsExtra),
+		steps.NewUninstallOIDCStep(),
+		steps.NewUninstallWGEStep(config.WgeConfig, config.ClusterUserAuth),
+		steps.NewUninstallFluxStep(config),
+	)
+
+	return execute(config, workflow)
+}
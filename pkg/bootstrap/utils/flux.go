@@ -22,6 +22,7 @@ const (
 type FluxClient interface {
 	ReconcileFlux() error
 	ReconcileHelmRelease(hrName string) error
+	UninstallFlux() error
 }
 
 type CmdFluxClient struct{}
@@ -145,6 +146,18 @@ func (fc CmdFluxClient) ReconcileHelmRelease(hrName string) error {
 	return nil
 }
 
+// UninstallFlux removes flux components and custom resources from the cluster
+func (fc CmdFluxClient) UninstallFlux() error {
+	var runner runner.CLIRunner
+	out, err := runner.Run("flux", "uninstall", "--silent")
+	if err != nil {
+		// adding an error message, err is meaningless
+		return fmt.Errorf("failed to uninstall flux: %s", string(out))
+	}
+
+	return nil
+}
+
 // GetHelmReleaseProperty extract a property from a specific helmrelease values file
 func GetHelmReleaseProperty(client k8s_client.Client, releaseName string, namespace string, property string) (string, error) {
 	helmrelease := &helmv2.HelmRelease{}
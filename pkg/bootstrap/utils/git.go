@@ -29,6 +29,7 @@ const (
 type GitClient interface {
 	CloneRepo(kubeClient k8s_client.Client, repoName string, namespace string, authType string, privateKeyPath string, privateKeyPassword string, username string, token string) (string, error)
 	CreateFileToRepo(filename, filecontent, path, commitmsg, authType, privateKeyPath, privateKeyPassword, username, token string) error
+	RemoveFileFromRepo(filename, path, commitmsg, authType, privateKeyPath, privateKeyPassword, username, token string) (bool, error)
 }
 
 // GetGitRepositoryObject get the default source git repository object to be used in cloning
@@ -170,6 +171,53 @@ func (c *GoGitClient) CreateFileToRepo(filename, filecontent, path, commitmsg, a
 	return nil
 }
 
+// RemoveFileFromRepo remove a file from the repo. It returns false when the file is not in the repo.
+func (c *GoGitClient) RemoveFileFromRepo(filename, path, commitmsg, authType, privateKeyPath, privateKeyPassword, username, token string) (bool, error) {
+	repo, err := git.PlainOpen(workingDir)
+	if err != nil {
+		return false, err
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(filepath.Join(workingDir, path, filename)); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if _, err := worktree.Remove(filepath.Join(path, filename)); err != nil {
+		return false, err
+	}
+
+	if _, err := worktree.Commit(commitmsg, &git.CommitOptions{
+		Author: &object.Signature{
+			Name:  fluxGitUserName,
+			Email: fluxGitEmail,
+			When:  time.Now(),
+		},
+	}); err != nil {
+		return false, err
+	}
+
+	authMethod, err := getGitAuthMethod(authType, privateKeyPath, privateKeyPassword, username, token)
+	if err != nil {
+		return false, err
+	}
+
+	if err := repo.Push(&git.PushOptions{
+		Auth: authMethod,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // CleanupRepo delete the temp repo.
 func CleanupRepo() error {
 	return os.RemoveAll(workingDir)
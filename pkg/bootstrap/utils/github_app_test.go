@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/assert"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func TestGitHubAPIURL(t *testing.T) {
+	apiURL, err := GitHubAPIURL("https://github.com/example/fleet")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.github.com", apiURL)
+
+	apiURL, err = GitHubAPIURL("https://github.example.com/example/fleet")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://github.example.com/api/v3", apiURL)
+}
+
+func TestGetGitHubAppInstallationToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/app/installations/42/access_tokens" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		token, err := jwt.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), &jwt.RegisteredClaims{}, func(*jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		})
+		if err != nil || token.Claims.(*jwt.RegisteredClaims).Issuer != "7" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"token": "ghs_installation_token"}`))
+	}))
+	defer server.Close()
+
+	token, err := GetGitHubAppInstallationToken(server.URL, 7, 42, privateKey)
+	assert.NoError(t, err)
+	assert.Equal(t, "ghs_installation_token", token)
+
+	_, err = GetGitHubAppInstallationToken(server.URL, 7, 43, privateKey)
+	assert.EqualError(t, err, "cannot create GitHub App installation token: 404 Not Found")
+
+	_, err = GetGitHubAppInstallationToken(server.URL, 7, 42, []byte("invalid"))
+	assert.Error(t, err)
+}
@@ -8,12 +8,34 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	k8s_client "sigs.k8s.io/controller-runtime/pkg/client"
 	k8s_config "sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
 // GetKubernetesHttp creates a kuberentes client from the default kubeconfig.
 func GetKubernetesHttp(kubeconfig string) (*kube.KubeHTTP, error) {
+	config, err := getKubernetesConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return kube.NewKubeHTTPClientWithConfig(config, config.Host)
+}
+
+// GetKubernetesClientSet creates a typed kubernetes client from the default kubeconfig. It is used
+// for the subresources the controller-runtime client doesn't support, like pod logs or service proxies.
+func GetKubernetesClientSet(kubeconfig string) (kubernetes.Interface, error) {
+	config, err := getKubernetesConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(config)
+}
+
+func getKubernetesConfig(kubeconfig string) (*rest.Config, error) {
 	if kubeconfig != "" {
 		err := flag.CommandLine.Set("kubeconfig", kubeconfig)
 		if err != nil {
@@ -23,12 +45,7 @@ func GetKubernetesHttp(kubeconfig string) (*kube.KubeHTTP, error) {
 		k8s_config.RegisterFlags(flag.CommandLine)
 	}
 
-	config, err := k8s_config.GetConfig()
-	if err != nil {
-		return nil, err
-	}
-
-	return kube.NewKubeHTTPClientWithConfig(config, config.Host)
+	return k8s_config.GetConfig()
 }
 
 // GetSecret get secret values from kubernetes.
@@ -64,6 +81,18 @@ func CreateSecret(client k8s_client.Client, name string, namespace string, data
 	return nil
 }
 
+// UpdateSecret updates the data of an existing kubernetes secret.
+func UpdateSecret(client k8s_client.Client, name string, namespace string, data map[string][]byte) error {
+	secret, err := GetSecret(client, name, namespace)
+	if err != nil {
+		return err
+	}
+
+	secret.Data = data
+
+	return client.Update(context.Background(), secret)
+}
+
 // DeleteSecret delete a kubernetes secret.
 func DeleteSecret(client k8s_client.Client, name string, namespace string) error {
 	secret := &corev1.Secret{
@@ -0,0 +1,159 @@
+package connector
+
+import (
+	"fmt"
+	"os"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Access profiles define the permissions of the ServiceAccount that WGE uses to access the remote cluster.
+const (
+	// AccessProfileAdmin grants full access to the cluster, like cluster-admin.
+	AccessProfileAdmin = "admin"
+	// AccessProfileExplorer grants read-only access to the resources WGE displays. It doesn't allow impersonation,
+	// which would grant the permissions of any user of the cluster.
+	AccessProfileExplorer = "explorer"
+	// AccessProfileFluxOperator extends the explorer profile to operate Flux resources: sync, suspend and resume,
+	// and to impersonate the users and groups of ClusterConnectionOptions for user requests.
+	AccessProfileFluxOperator = "flux-operator"
+	// AccessProfileCustom uses the rules of ClusterConnectionOptions.RulesFile.
+	AccessProfileCustom = "custom"
+)
+
+// AccessProfiles are the supported access profiles, the first one is the default.
+var AccessProfiles = []string{AccessProfileAdmin, AccessProfileExplorer, AccessProfileFluxOperator, AccessProfileCustom}
+
+var (
+	readVerbs    = []string{"get", "list", "watch"}
+	operateVerbs = []string{"get", "list", "watch", "patch", "update"}
+
+	fluxAPIGroups = []string{
+		"source.toolkit.fluxcd.io",
+		"kustomize.toolkit.fluxcd.io",
+		"helm.toolkit.fluxcd.io",
+		"notification.toolkit.fluxcd.io",
+		"image.toolkit.fluxcd.io",
+		"infra.contrib.fluxcd.io",
+	}
+
+	// explorerRules are the resources WGE collects and displays: workloads, Flux and WGE objects, and RBAC to filter
+	// them by user permissions. Secrets are not included.
+	explorerRules = []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"namespaces", "pods", "services", "configmaps", "events", "serviceaccounts", "persistentvolumeclaims"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments", "replicasets", "statefulsets", "daemonsets"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{"batch"},
+			Resources: []string{"jobs", "cronjobs"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{"rbac.authorization.k8s.io"},
+			Resources: []string{"roles", "rolebindings", "clusterroles", "clusterrolebindings"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{"apiextensions.k8s.io"},
+			Resources: []string{"customresourcedefinitions"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: fluxAPIGroups,
+			Resources: []string{"*"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{"gitops.weave.works", "templates.weave.works", "pac.weave.works", "pipelines.weave.works"},
+			Resources: []string{"*"},
+			Verbs:     readVerbs,
+		},
+	}
+
+	adminRules = []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"*"},
+			Resources: []string{"*"},
+			Verbs:     []string{"*"},
+		},
+		{
+			NonResourceURLs: []string{"*"},
+			Verbs:           []string{"*"},
+		},
+	}
+)
+
+// clusterRoleRules returns the rules of the ClusterRole to create for the access profile of the options.
+func clusterRoleRules(options ClusterConnectionOptions) ([]rbacv1.PolicyRule, error) {
+	switch options.AccessProfile {
+	case "", AccessProfileAdmin:
+		return adminRules, nil
+	case AccessProfileExplorer:
+		return explorerRules, nil
+	case AccessProfileFluxOperator:
+		rules := append(explorerRules[:len(explorerRules):len(explorerRules)], rbacv1.PolicyRule{
+			APIGroups: fluxAPIGroups,
+			Resources: []string{"*"},
+			Verbs:     operateVerbs,
+		})
+		return append(rules, impersonationRules(options)...), nil
+	case AccessProfileCustom:
+		return loadRulesFile(options.RulesFile)
+	default:
+		return nil, fmt.Errorf("unsupported access profile %q, supported profiles: %v", options.AccessProfile, AccessProfiles)
+	}
+}
+
+// impersonationRules allow WGE to act on behalf of the users of the UI, so their own permissions apply.
+// They are restricted to the users and groups of the options, impersonating anyone else would escalate
+// to their permissions.
+func impersonationRules(options ClusterConnectionOptions) []rbacv1.PolicyRule {
+	rules := []rbacv1.PolicyRule{}
+	if len(options.ImpersonateUsers) > 0 {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			Resources:     []string{"users"},
+			ResourceNames: options.ImpersonateUsers,
+			Verbs:         []string{"impersonate"},
+		})
+	}
+	if len(options.ImpersonateGroups) > 0 {
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{""},
+			Resources:     []string{"groups"},
+			ResourceNames: options.ImpersonateGroups,
+			Verbs:         []string{"impersonate"},
+		})
+	}
+	return rules
+}
+
+// loadRulesFile reads a list of RBAC policy rules from a yaml file, as in the rules of a ClusterRole.
+func loadRulesFile(path string) ([]rbacv1.PolicyRule, error) {
+	if path == "" {
+		return nil, fmt.Errorf("access profile %s requires a rules file", AccessProfileCustom)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read rules file: %w", err)
+	}
+
+	var rules []rbacv1.PolicyRule
+	if err := yaml.UnmarshalStrict(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid rules file %s: %w", path, err)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("rules file %s has no rules", path)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,175 @@
+package connector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClusterRoleRules(t *testing.T) {
+	rulesFile := filepath.Join(t.TempDir(), "rules.yaml")
+	assert.NoError(t, os.WriteFile(rulesFile, []byte(`
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["get", "list"]
+`), 0600))
+
+	var tests = []struct {
+		name          string
+		options       ClusterConnectionOptions
+		expectedRules []rbacv1.PolicyRule
+		expectedError string
+	}{
+		{
+			"default profile is admin",
+			ClusterConnectionOptions{},
+			adminRules,
+			"",
+		},
+		{
+			"explorer profile is read-only",
+			ClusterConnectionOptions{AccessProfile: AccessProfileExplorer},
+			explorerRules,
+			"",
+		},
+		{
+			"flux operator profile operates flux resources",
+			ClusterConnectionOptions{AccessProfile: AccessProfileFluxOperator},
+			append(append([]rbacv1.PolicyRule{}, explorerRules...), rbacv1.PolicyRule{
+				APIGroups: fluxAPIGroups,
+				Resources: []string{"*"},
+				Verbs:     operateVerbs,
+			}),
+			"",
+		},
+		{
+			"flux operator profile impersonates the users and groups of the options",
+			ClusterConnectionOptions{
+				AccessProfile:     AccessProfileFluxOperator,
+				ImpersonateUsers:  []string{"alice"},
+				ImpersonateGroups: []string{"platform-team"},
+			},
+			append(append([]rbacv1.PolicyRule{}, explorerRules...),
+				rbacv1.PolicyRule{
+					APIGroups: fluxAPIGroups,
+					Resources: []string{"*"},
+					Verbs:     operateVerbs,
+				},
+				rbacv1.PolicyRule{
+					APIGroups:     []string{""},
+					Resources:     []string{"users"},
+					ResourceNames: []string{"alice"},
+					Verbs:         []string{"impersonate"},
+				},
+				rbacv1.PolicyRule{
+					APIGroups:     []string{""},
+					Resources:     []string{"groups"},
+					ResourceNames: []string{"platform-team"},
+					Verbs:         []string{"impersonate"},
+				},
+			),
+			"",
+		},
+		{
+			"custom profile reads rules file",
+			ClusterConnectionOptions{AccessProfile: AccessProfileCustom, RulesFile: rulesFile},
+			[]rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods"},
+					Verbs:     []string{"get", "list"},
+				},
+			},
+			"",
+		},
+		{
+			"custom profile requires rules file",
+			ClusterConnectionOptions{AccessProfile: AccessProfileCustom},
+			nil,
+			"access profile custom requires a rules file",
+		},
+		{
+			"unsupported profile",
+			ClusterConnectionOptions{AccessProfile: "viewer"},
+			nil,
+			"unsupported access profile \"viewer\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := clusterRoleRules(tt.options)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRules, rules)
+		})
+	}
+
+	t.Run("explorer profile does not grant secrets access", func(t *testing.T) {
+		for _, rule := range explorerRules {
+			assert.NotContains(t, rule.Resources, "secrets")
+		}
+	})
+
+	t.Run("explorer profile cannot escalate", func(t *testing.T) {
+		options := ClusterConnectionOptions{
+			AccessProfile:     AccessProfileExplorer,
+			ImpersonateUsers:  []string{"alice"},
+			ImpersonateGroups: []string{"platform-team"},
+		}
+		rules, err := clusterRoleRules(options)
+		assert.NoError(t, err)
+
+		for _, rule := range rules {
+			for _, verb := range []string{"impersonate", "*", "bind", "escalate", "create", "update", "patch", "delete"} {
+				assert.NotContains(t, rule.Verbs, verb)
+			}
+		}
+	})
+}
+
+func TestCreateOrUpdateClusterRole(t *testing.T) {
+	options := ClusterConnectionOptions{
+		ClusterRoleName:   "test-cluster-role",
+		GitopsClusterName: types.NamespacedName{Namespace: corev1.NamespaceDefault},
+	}
+
+	t.Run("updates the rules of managed cluster roles", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newClusterRole("test-cluster-role", corev1.NamespaceDefault, adminRules))
+
+		err := createOrUpdateClusterRole(context.Background(), client, explorerRules, options)
+		assert.NoError(t, err)
+
+		clusterRole, err := client.RbacV1().ClusterRoles().Get(context.Background(), "test-cluster-role", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, explorerRules, clusterRole.Rules)
+	})
+
+	t.Run("does not update cluster roles not managed by weave-gitops", func(t *testing.T) {
+		client := fake.NewSimpleClientset(&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster-role"}})
+
+		err := createOrUpdateClusterRole(context.Background(), client, explorerRules, options)
+		assert.EqualError(t, err, "cluster role test-cluster-role already exists and is not managed by weave-gitops")
+	})
+
+	t.Run("does not delete cluster roles not managed by weave-gitops", func(t *testing.T) {
+		client := fake.NewSimpleClientset(&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}})
+
+		err := deleteClusterRole(context.Background(), client, "cluster-admin")
+		assert.NoError(t, err)
+
+		_, err = client.RbacV1().ClusterRoles().Get(context.Background(), "cluster-admin", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,366 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// GitopsCluster modes of bulk connections, defining what happens with the GitopsClusters of the connected clusters.
+const (
+	// GitopsClusterModeExisting connects clusters whose GitopsClusters already exist in the management cluster.
+	GitopsClusterModeExisting = "existing"
+	// GitopsClusterModeCreate creates the GitopsClusters that don't exist in the management cluster.
+	GitopsClusterModeCreate = "create"
+	// GitopsClusterModePullRequest doesn't look up the GitopsClusters, as they are added to git by the caller
+	// with the manifests of the results.
+	GitopsClusterModePullRequest = "pull-request"
+)
+
+// DefaultBulkConcurrency is the number of clusters connected at the same time if not set.
+const DefaultBulkConcurrency = 5
+
+// BulkConnectionOptions holds the options to connect the clusters of many kubeconfig contexts at once.
+type BulkConnectionOptions struct {
+	// ClusterConnectionOptions are the options shared by all the connections: service account, cluster
+	// role and binding names, access profile, token expiration and namespace of the GitopsClusters.
+	// ConfigPath is the kubeconfig of the management cluster.
+	ClusterConnectionOptions
+
+	// KubeconfigPath is a kubeconfig with a context for each cluster, or a directory of kubeconfig files.
+	KubeconfigPath string
+
+	// ClusterNames maps the context names to GitopsCluster names. If set, only the mapped contexts are
+	// connected, otherwise all the contexts are connected to GitopsClusters named after them.
+	ClusterNames map[string]string
+
+	// GitopsClusterMode is one of the GitopsCluster modes, defaults to GitopsClusterModeExisting.
+	GitopsClusterMode string
+
+	// Concurrency is the number of clusters connected at the same time, defaults to DefaultBulkConcurrency.
+	Concurrency int
+}
+
+// BulkConnectionResult is the outcome of the connection of one context.
+type BulkConnectionResult struct {
+	// Context is the name of the context in the kubeconfig.
+	Context string
+	// KubeconfigPath is the kubeconfig file of the context.
+	KubeconfigPath string
+	// GitopsCluster is the GitopsCluster the context is connected to.
+	GitopsCluster *gitopsv1alpha1.GitopsCluster
+	// Error is the reason the connection failed, nil if connected.
+	Error error
+}
+
+// bulkContext is a context of the kubeconfig files to connect.
+type bulkContext struct {
+	path        string
+	name        string
+	clusterName string
+	config      clientcmd.ClientConfig
+}
+
+// bulkConnector connects the contexts using the clients of the management cluster.
+type bulkConnector struct {
+	hubClient kubernetes.Interface
+	dynClient dynamic.Interface
+	scheme    *runtime.Scheme
+	newClient func(*rest.Config) (kubernetes.Interface, error)
+	options   BulkConnectionOptions
+}
+
+// LoadClusterNames reads the mapping of context names to GitopsCluster names from a yaml file.
+func LoadClusterNames(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read cluster names file: %w", err)
+	}
+
+	clusterNames := map[string]string{}
+	if err := yaml.UnmarshalStrict(data, &clusterNames); err != nil {
+		return nil, fmt.Errorf("invalid cluster names file %s: %w", path, err)
+	}
+
+	return clusterNames, nil
+}
+
+// BulkConnectClusters connects the clusters of the contexts in the kubeconfig files concurrently. The
+// outcome of each connection is returned in the results, the error is only for failures that prevent
+// connecting any cluster.
+func BulkConnectClusters(ctx context.Context, options *BulkConnectionOptions) ([]BulkConnectionResult, error) {
+	switch options.GitopsClusterMode {
+	case "", GitopsClusterModeExisting, GitopsClusterModeCreate, GitopsClusterModePullRequest:
+	default:
+		return nil, fmt.Errorf("unsupported GitopsCluster mode %q", options.GitopsClusterMode)
+	}
+
+	contexts, err := loadBulkContexts(options.KubeconfigPath, options.ClusterNames)
+	if err != nil {
+		return nil, err
+	}
+
+	pathOpts := clientcmd.NewDefaultPathOptions()
+	pathOpts.LoadingRules.ExplicitPath = options.ConfigPath
+	hubClusterConfig, err := configForContext(ctx, pathOpts, "")
+	if err != nil {
+		return nil, err
+	}
+	hubKubernetesClient, err := kubernetes.NewForConfig(hubClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	dynClient, scheme, err := getDynClientAndScheme(hubClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	connector := &bulkConnector{
+		hubClient: hubKubernetesClient,
+		dynClient: dynClient,
+		scheme:    scheme,
+		newClient: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+		options: *options,
+	}
+
+	return connector.connectClusters(ctx, contexts), nil
+}
+
+// loadBulkContexts reads the contexts of a kubeconfig file, or of the files of a directory, sorted by
+// file and context name. Only the contexts in the cluster names are returned if any.
+func loadBulkContexts(path string, clusterNames map[string]string) ([]bulkContext, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read kubeconfig: %w", err)
+	}
+
+	paths := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read kubeconfig directory: %w", err)
+		}
+		paths = nil
+		for _, entry := range entries {
+			if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			paths = append(paths, filepath.Join(path, entry.Name()))
+		}
+	}
+
+	contexts := []bulkContext{}
+	seen := map[string]string{}
+	clusters := map[string]string{}
+	for _, kubeconfigPath := range paths {
+		config, err := clientcmd.LoadFromFile(kubeconfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load kubeconfig %s: %w", kubeconfigPath, err)
+		}
+
+		names := make([]string, 0, len(config.Contexts))
+		for name := range config.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			clusterName := name
+			if len(clusterNames) > 0 {
+				mapped, ok := clusterNames[name]
+				if !ok {
+					continue
+				}
+				clusterName = mapped
+			}
+			if other, ok := seen[name]; ok {
+				return nil, fmt.Errorf("context %s is defined in kubeconfigs %s and %s", name, other, kubeconfigPath)
+			}
+			seen[name] = kubeconfigPath
+			if other, ok := clusters[clusterName]; ok {
+				return nil, fmt.Errorf("contexts %s and %s are connected to the same GitopsCluster %s", other, name, clusterName)
+			}
+			clusters[clusterName] = name
+
+			contexts = append(contexts, bulkContext{
+				path:        kubeconfigPath,
+				name:        name,
+				clusterName: clusterName,
+				config:      clientcmd.NewNonInteractiveClientConfig(*config, name, &clientcmd.ConfigOverrides{}, nil),
+			})
+		}
+	}
+
+	for name := range clusterNames {
+		if _, ok := seen[name]; !ok {
+			return nil, fmt.Errorf("context %s of the cluster names not found in %s", name, path)
+		}
+	}
+	if len(contexts) == 0 {
+		return nil, fmt.Errorf("no contexts found in %s", path)
+	}
+
+	return contexts, nil
+}
+
+// connectClusters connects the contexts with at most Concurrency connections at the same time. The
+// results are in the order of the contexts.
+func (c *bulkConnector) connectClusters(ctx context.Context, contexts []bulkContext) []BulkConnectionResult {
+	concurrency := c.options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	results := make([]BulkConnectionResult, len(contexts))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, current := range contexts {
+		wg.Add(1)
+		go func(i int, current bulkContext) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			gitopsCluster, err := c.connectCluster(ctx, current)
+			results[i] = BulkConnectionResult{
+				Context:        current.name,
+				KubeconfigPath: current.path,
+				GitopsCluster:  gitopsCluster,
+				Error:          err,
+			}
+		}(i, current)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// connectCluster connects a context to its GitopsCluster, as ConnectCluster does.
+func (c *bulkConnector) connectCluster(ctx context.Context, bulkContext bulkContext) (*gitopsv1alpha1.GitopsCluster, error) {
+	lgr := log.FromContext(ctx).WithValues("context", bulkContext.name)
+
+	clusterName := types.NamespacedName{Name: bulkContext.clusterName, Namespace: c.options.GitopsClusterName.Namespace}
+	if errs := validation.IsDNS1123Subdomain(clusterName.Name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid GitopsCluster name %q, map the context to a valid name: %s", clusterName.Name, strings.Join(errs, ", "))
+	}
+
+	gitopsCluster, err := c.gitopsCluster(ctx, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	spokeClusterConfig, err := bulkContext.config.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load context %s: %w", bulkContext.name, err)
+	}
+	spokeKubernetesClient, err := c.newClient(spokeClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	options := c.options.ClusterConnectionOptions
+	options.GitopsClusterName = clusterName
+	options.RemoteClusterContext = bulkContext.name
+	serviceAccountToken, err := ReconcileServiceAccount(ctx, spokeKubernetesClient, options)
+	if err != nil {
+		return nil, err
+	}
+
+	newConfig, err := kubeConfigWithToken(ctx, spokeClusterConfig, bulkContext.name, serviceAccountToken)
+	if err != nil {
+		return nil, err
+	}
+	_, err = createOrUpdateGitOpsClusterSecret(ctx, c.hubClient, gitopsCluster.Spec.SecretRef.Name, clusterName.Namespace, newConfig, tokenRotationLabels(options), tokenRotationAnnotations(options, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	if options.TokenExpiration > 0 {
+		err = deleteLegacyTokenSecret(ctx, spokeKubernetesClient, options.ServiceAccountName, clusterName.Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lgr.V(logger.LogLevelInfo).Info("Successfully connected cluster", "cluster", clusterName)
+
+	return gitopsCluster, nil
+}
+
+// gitopsCluster returns the GitopsCluster to connect according to the GitopsCluster mode, creating it
+// if required.
+func (c *bulkConnector) gitopsCluster(ctx context.Context, clusterName types.NamespacedName) (*gitopsv1alpha1.GitopsCluster, error) {
+	if c.options.GitopsClusterMode == GitopsClusterModePullRequest {
+		return NewGitopsCluster(clusterName), nil
+	}
+
+	resource := gitopsv1alpha1.GroupVersion.WithResource("gitopsclusters")
+	u, err := c.dynClient.Resource(resource).Namespace(clusterName.Namespace).Get(ctx, clusterName.Name, metav1.GetOptions{})
+	if err == nil {
+		gitopsCluster, err := unstructuredToGitopsCluster(c.scheme, u)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load GitopsCluster %s: %w", clusterName, err)
+		}
+		if gitopsCluster.Spec.SecretRef == nil || gitopsCluster.Spec.SecretRef.Name == "" {
+			return nil, fmt.Errorf("failed to find referenced secret in gitopscluster %s", clusterName)
+		}
+		return gitopsCluster, nil
+	}
+	if !apierrors.IsNotFound(err) || c.options.GitopsClusterMode != GitopsClusterModeCreate {
+		return nil, fmt.Errorf("failed to get GitopsCluster %s: %w", clusterName, err)
+	}
+
+	gitopsCluster := NewGitopsCluster(clusterName)
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(gitopsCluster)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.dynClient.Resource(resource).Namespace(clusterName.Namespace).Create(ctx, &unstructured.Unstructured{Object: obj}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitopsCluster %s: %w", clusterName, err)
+	}
+	log.FromContext(ctx).V(logger.LogLevelDebug).Info("gitopscluster created successfully!", "gitopscluster", clusterName)
+
+	return gitopsCluster, nil
+}
+
+// NewGitopsCluster returns a GitopsCluster referencing the <name>-kubeconfig secret of its connection.
+func NewGitopsCluster(clusterName types.NamespacedName) *gitopsv1alpha1.GitopsCluster {
+	return &gitopsv1alpha1.GitopsCluster{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gitopsv1alpha1.GroupVersion.String(),
+			Kind:       "GitopsCluster",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterName.Name,
+			Namespace: clusterName.Namespace,
+		},
+		Spec: gitopsv1alpha1.GitopsClusterSpec{
+			SecretRef: &meta.LocalObjectReference{
+				Name: clusterName.Name + "-kubeconfig",
+			},
+		},
+	}
+}
@@ -0,0 +1,220 @@
+package connector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/stretchr/testify/assert"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+func TestLoadBulkContexts(t *testing.T) {
+	kubeconfig, err := os.ReadFile("testdata/kube-config.yaml")
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "region-a.yaml"), kubeconfig, 0600))
+	otherKubeconfig := `
+apiVersion: v1
+kind: Config
+clusters:
+  - cluster:
+      server: https://edge.example.com
+    name: edge-cluster
+contexts:
+  - context:
+      cluster: edge-cluster
+      user: edge
+    name: edge
+users:
+  - name: edge
+    user:
+      token: edge-token
+`
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "region-b.yaml"), []byte(otherKubeconfig), 0600))
+
+	duplicatesDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(duplicatesDir, "a.yaml"), kubeconfig, 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(duplicatesDir, "b.yaml"), kubeconfig, 0600))
+
+	var tests = []struct {
+		name             string
+		path             string
+		clusterNames     map[string]string
+		expectedContexts []string
+		expectedClusters []string
+		expectedError    string
+	}{
+		{
+			"all contexts of a kubeconfig",
+			"testdata/kube-config.yaml",
+			nil,
+			[]string{"hub", "spoke"},
+			[]string{"hub", "spoke"},
+			"",
+		},
+		{
+			"mapped contexts of a kubeconfig",
+			"testdata/kube-config.yaml",
+			map[string]string{"spoke": "production"},
+			[]string{"spoke"},
+			[]string{"production"},
+			"",
+		},
+		{
+			"contexts of a directory",
+			dir,
+			nil,
+			[]string{"hub", "spoke", "edge"},
+			[]string{"hub", "spoke", "edge"},
+			"",
+		},
+		{
+			"mapped context not found",
+			"testdata/kube-config.yaml",
+			map[string]string{"edge": "edge"},
+			nil,
+			nil,
+			"context edge of the cluster names not found",
+		},
+		{
+			"contexts mapped to the same cluster",
+			"testdata/kube-config.yaml",
+			map[string]string{"hub": "production", "spoke": "production"},
+			nil,
+			nil,
+			"are connected to the same GitopsCluster production",
+		},
+		{
+			"context in many kubeconfigs",
+			duplicatesDir,
+			nil,
+			nil,
+			nil,
+			"context hub is defined in kubeconfigs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contexts, err := loadBulkContexts(tt.path, tt.clusterNames)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			names := []string{}
+			clusters := []string{}
+			for _, bulkContext := range contexts {
+				names = append(names, bulkContext.name)
+				clusters = append(clusters, bulkContext.clusterName)
+			}
+			assert.Equal(t, tt.expectedContexts, names)
+			assert.Equal(t, tt.expectedClusters, clusters)
+		})
+	}
+}
+
+func TestBulkConnector_connectClusters(t *testing.T) {
+	contexts, err := loadBulkContexts("testdata/kube-config.yaml", map[string]string{"hub": "existing", "spoke": "new"})
+	assert.NoError(t, err)
+
+	newConnector := func(mode string, gitopsClusters ...runtime.Object) *bulkConnector {
+		scheme := newTestScheme(t)
+		return &bulkConnector{
+			hubClient: fake.NewSimpleClientset(),
+			dynClient: dynamicfake.NewSimpleDynamicClient(scheme, gitopsClusters...),
+			scheme:    scheme,
+			newClient: func(config *rest.Config) (kubernetes.Interface, error) {
+				client := fake.NewSimpleClientset()
+				addFakeTokenRequests(client, config.Host+"-token", nil)
+				return client, nil
+			},
+			options: BulkConnectionOptions{
+				ClusterConnectionOptions: ClusterConnectionOptions{
+					ServiceAccountName:     "weave-gitops-enterprise",
+					ClusterRoleName:        "weave-gitops-enterprise",
+					ClusterRoleBindingName: "weave-gitops-enterprise",
+					TokenExpiration:        time.Hour,
+					GitopsClusterName:      types.NamespacedName{Namespace: corev1.NamespaceDefault},
+				},
+				GitopsClusterMode: mode,
+				Concurrency:       1,
+			},
+		}
+	}
+	existingCluster := &gitopsv1alpha1.GitopsCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: gitopsv1alpha1.GitopsClusterSpec{
+			SecretRef: &meta.LocalObjectReference{Name: "existing-secret"},
+		},
+	}
+
+	t.Run("connects existing GitopsClusters", func(t *testing.T) {
+		connector := newConnector(GitopsClusterModeExisting, existingCluster)
+
+		results := connector.connectClusters(context.Background(), contexts)
+		assert.Len(t, results, 2)
+		assert.Equal(t, "hub", results[0].Context)
+		assert.NoError(t, results[0].Error)
+		assert.Equal(t, "existing-secret", results[0].GitopsCluster.Spec.SecretRef.Name)
+		assert.Equal(t, "https://hub.example.com-token", secretToken(t, connector.hubClient, "existing-secret"))
+		secret, err := connector.hubClient.CoreV1().Secrets(corev1.NamespaceDefault).Get(context.Background(), "existing-secret", metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Contains(t, secret.Annotations, TokenRenewalDeadlineAnnotation)
+		assert.Equal(t, "spoke", results[1].Context)
+		assert.ErrorContains(t, results[1].Error, "failed to get GitopsCluster default/new")
+	})
+
+	t.Run("creates missing GitopsClusters", func(t *testing.T) {
+		connector := newConnector(GitopsClusterModeCreate, existingCluster)
+
+		results := connector.connectClusters(context.Background(), contexts)
+		assert.NoError(t, results[0].Error)
+		assert.NoError(t, results[1].Error)
+		assert.Equal(t, NewGitopsCluster(types.NamespacedName{Name: "new", Namespace: corev1.NamespaceDefault}), results[1].GitopsCluster)
+		assert.Equal(t, "https://spoke.example.com-token", secretToken(t, connector.hubClient, "new-kubeconfig"))
+
+		secretName, err := getSecretNameFromCluster(context.Background(), connector.dynClient, connector.scheme, types.NamespacedName{Name: "new", Namespace: corev1.NamespaceDefault})
+		assert.NoError(t, err)
+		assert.Equal(t, "new-kubeconfig", secretName)
+	})
+
+	t.Run("pull request mode doesn't create GitopsClusters", func(t *testing.T) {
+		connector := newConnector(GitopsClusterModePullRequest)
+
+		results := connector.connectClusters(context.Background(), contexts)
+		assert.NoError(t, results[0].Error)
+		assert.NoError(t, results[1].Error)
+		assert.Equal(t, "existing-kubeconfig", results[0].GitopsCluster.Spec.SecretRef.Name)
+		assert.Equal(t, "https://hub.example.com-token", secretToken(t, connector.hubClient, "existing-kubeconfig"))
+
+		_, err := getSecretNameFromCluster(context.Background(), connector.dynClient, connector.scheme, types.NamespacedName{Name: "new", Namespace: corev1.NamespaceDefault})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid cluster names fail", func(t *testing.T) {
+		invalidContexts, err := loadBulkContexts("testdata/kube-config.yaml", map[string]string{"spoke": "Spoke_Cluster"})
+		assert.NoError(t, err)
+		connector := newConnector(GitopsClusterModePullRequest)
+
+		results := connector.connectClusters(context.Background(), invalidContexts)
+		assert.ErrorContains(t, results[0].Error, "invalid GitopsCluster name \"Spoke_Cluster\"")
+	})
+}
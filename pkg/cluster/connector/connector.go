@@ -2,6 +2,7 @@ package connector
 
 import (
 	"context"
+	"time"
 
 	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/core/logger"
@@ -37,6 +38,28 @@ type ClusterConnectionOptions struct {
 	// the remote cluster.
 	ClusterRoleBindingName string
 
+	// AccessProfile selects the rules of the ClusterRole, one of AccessProfiles.
+	// Defaults to AccessProfileAdmin.
+	AccessProfile string
+
+	// RulesFile is the path to the yaml list of policy rules for AccessProfileCustom.
+	RulesFile string
+
+	// ImpersonateUsers and ImpersonateGroups are the users and groups of the UI that WGE can
+	// impersonate with AccessProfileFluxOperator.
+	ImpersonateUsers  []string
+	ImpersonateGroups []string
+
+	// TokenExpiration is the lifetime of the time-bound ServiceAccount tokens requested with the
+	// TokenRequest API, which are rotated by the management cluster. A long-lived token Secret is
+	// created if not set.
+	TokenExpiration time.Duration
+
+	// TokenRenewalLimit is how long the management cluster renews the time-bound tokens. Once reached
+	// the connection has to be rotated with the credentials of the remote cluster. Defaults to
+	// DefaultTokenRenewalLimit.
+	TokenRenewalLimit time.Duration
+
 	// GitopsClusterName references the GitopsCluster that we want to setup the
 	// connection to.
 	// This GitopsCluster must reference a Secret, and the Secret that is
@@ -109,10 +132,16 @@ func ConnectCluster(ctx context.Context, options *ClusterConnectionOptions) erro
 	if err != nil {
 		return err
 	}
-	_, err = createOrUpdateGitOpsClusterSecret(ctx, hubKubernetesClient, secretName, options.GitopsClusterName.Namespace, newConfig)
+	_, err = createOrUpdateGitOpsClusterSecret(ctx, hubKubernetesClient, secretName, options.GitopsClusterName.Namespace, newConfig, tokenRotationLabels(*options), tokenRotationAnnotations(*options, time.Now()))
 	if err != nil {
 		return err
 	}
+	if options.TokenExpiration > 0 {
+		err = deleteLegacyTokenSecret(ctx, spokeKubernetesClient, options.ServiceAccountName, options.GitopsClusterName.Namespace)
+		if err != nil {
+			return err
+		}
+	}
 
 	lgr.V(logger.LogLevelInfo).Info("Successfully connected cluster", "cluster", options.GitopsClusterName)
 
@@ -142,7 +171,7 @@ func deleteGitOpsClusterSecret(ctx context.Context, client kubernetes.Interface,
 }
 
 // DisconnectCluster disconnects a cluster from a spoke cluster given its name and context
-// The Service account, Cluster Role binding and secret are deleted in the remote cluster and secret containing token in hub cluster is deleted
+// The Service account, Cluster Role, Cluster Role binding and secret are deleted in the remote cluster and secret containing token in hub cluster is deleted
 func DisconnectCluster(ctx context.Context, options *ClusterConnectionOptions) error {
 	lgr := log.FromContext(ctx)
 	pathOpts := clientcmd.NewDefaultPathOptions()
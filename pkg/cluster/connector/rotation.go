@@ -0,0 +1,427 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// TokenRotationLabel marks the GitopsCluster secrets with time-bound tokens to be rotated
+	TokenRotationLabel = "weave.works/token-rotation"
+	// TokenRenewalDeadlineAnnotation records until when the tokens of a GitopsCluster secret are renewed
+	TokenRenewalDeadlineAnnotation = "weave.works/token-renewal-deadline"
+
+	// DefaultTokenRenewalLimit is how long the tokens are renewed if ClusterConnectionOptions doesn't set it
+	DefaultTokenRenewalLimit = 30 * 24 * time.Hour
+
+	serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+	// tokenRotationLeaseName is the Lease electing the replica of the management cluster that rotates the tokens
+	tokenRotationLeaseName = "weave-gitops-token-rotation"
+)
+
+// tokenRotationThreshold is the fraction of the token lifetime after which the token is rotated
+const tokenRotationThreshold = 2.0 / 3.0
+
+// tokenRotationRoleName is the name of the Role and RoleBinding that allow the ServiceAccount to request
+// its own tokens, so the management cluster can rotate them with the current token. The ServiceAccount can
+// also delete the RoleBinding, so the renewal is revoked once its deadline is reached.
+func tokenRotationRoleName(serviceAccountName string) string {
+	return serviceAccountName + "-token-rotation"
+}
+
+func newTokenRotationRole(serviceAccountName, namespace string) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenRotationRoleName(serviceAccountName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": managedByLabelName,
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{""},
+				Resources:     []string{"serviceaccounts/token"},
+				ResourceNames: []string{serviceAccountName},
+				Verbs:         []string{"create"},
+			},
+			{
+				APIGroups:     []string{"rbac.authorization.k8s.io"},
+				Resources:     []string{"rolebindings"},
+				ResourceNames: []string{tokenRotationRoleName(serviceAccountName)},
+				Verbs:         []string{"delete"},
+			},
+		},
+	}
+}
+
+func newTokenRotationRoleBinding(serviceAccountName, namespace string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenRotationRoleName(serviceAccountName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": managedByLabelName,
+			},
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      serviceAccountName,
+				Namespace: namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     tokenRotationRoleName(serviceAccountName),
+		},
+	}
+}
+
+func createTokenRotationRole(ctx context.Context, client kubernetes.Interface, clusterConnectionOpts ClusterConnectionOptions) error {
+	lgr := log.FromContext(ctx)
+	serviceAccountName := clusterConnectionOpts.ServiceAccountName
+	namespace := clusterConnectionOpts.GitopsClusterName.Namespace
+
+	_, err := client.RbacV1().Roles(namespace).Create(ctx, newTokenRotationRole(serviceAccountName, namespace), metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	_, err = client.RbacV1().RoleBindings(namespace).Create(ctx, newTokenRotationRoleBinding(serviceAccountName, namespace), metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	lgr.V(logger.LogLevelDebug).Info("token rotation role reconciled successfully!", "role", tokenRotationRoleName(serviceAccountName))
+	return nil
+}
+
+func deleteTokenRotationRole(ctx context.Context, client kubernetes.Interface, serviceAccountName, namespace string) error {
+	err := client.RbacV1().RoleBindings(namespace).Delete(ctx, tokenRotationRoleName(serviceAccountName), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	err = client.RbacV1().Roles(namespace).Delete(ctx, tokenRotationRoleName(serviceAccountName), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// requestServiceAccountToken creates a time-bound token for the service account with the TokenRequest API.
+func requestServiceAccountToken(ctx context.Context, client kubernetes.Interface, serviceAccountName, namespace string, expiration time.Duration) ([]byte, error) {
+	lgr := log.FromContext(ctx)
+	expirationSeconds := int64(expiration.Seconds())
+	tokenRequest, err := client.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, serviceAccountName, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to request token for service account %s/%s: %w", namespace, serviceAccountName, err)
+	}
+	lgr.V(logger.LogLevelDebug).Info("service account token requested successfully!", "serviceaccount", serviceAccountName, "expiration", tokenRequest.Status.ExpirationTimestamp)
+
+	return []byte(tokenRequest.Status.Token), nil
+}
+
+// RotateClusterToken replaces the token of a connected cluster with a new time-bound token, using the
+// credentials of the remote cluster context, and renews it until a new renewal deadline. Connections with
+// long-lived tokens are migrated to rotated tokens and their token secret is deleted.
+func RotateClusterToken(ctx context.Context, options *ClusterConnectionOptions) error {
+	lgr := log.FromContext(ctx)
+	if options.TokenExpiration <= 0 {
+		return fmt.Errorf("token expiration is required to rotate the token")
+	}
+
+	pathOpts := clientcmd.NewDefaultPathOptions()
+	pathOpts.LoadingRules.ExplicitPath = options.ConfigPath
+
+	hubClusterConfig, err := configForContext(ctx, pathOpts, "")
+	if err != nil {
+		return err
+	}
+	spokeClusterConfig, err := configForContext(ctx, pathOpts, options.RemoteClusterContext)
+	if err != nil {
+		return err
+	}
+	secretName, err := getSecretNameForConfig(ctx, hubClusterConfig, options)
+	if err != nil {
+		return err
+	}
+
+	spokeKubernetesClient, err := kubernetes.NewForConfig(spokeClusterConfig)
+	if err != nil {
+		return err
+	}
+	err = createTokenRotationRole(ctx, spokeKubernetesClient, *options)
+	if err != nil {
+		return err
+	}
+	token, err := requestServiceAccountToken(ctx, spokeKubernetesClient, options.ServiceAccountName, options.GitopsClusterName.Namespace, options.TokenExpiration)
+	if err != nil {
+		return err
+	}
+
+	newConfig, err := kubeConfigWithToken(ctx, spokeClusterConfig, options.RemoteClusterContext, token)
+	if err != nil {
+		return err
+	}
+
+	hubKubernetesClient, err := kubernetes.NewForConfig(hubClusterConfig)
+	if err != nil {
+		return err
+	}
+	_, err = createOrUpdateGitOpsClusterSecret(ctx, hubKubernetesClient, secretName, options.GitopsClusterName.Namespace, newConfig, tokenRotationLabels(*options), tokenRotationAnnotations(*options, time.Now()))
+	if err != nil {
+		return err
+	}
+	err = deleteLegacyTokenSecret(ctx, spokeKubernetesClient, options.ServiceAccountName, options.GitopsClusterName.Namespace)
+	if err != nil {
+		return err
+	}
+
+	lgr.V(logger.LogLevelInfo).Info("Successfully rotated cluster token", "cluster", options.GitopsClusterName)
+	return nil
+}
+
+func tokenRotationLabels(options ClusterConnectionOptions) map[string]string {
+	if options.TokenExpiration <= 0 {
+		return nil
+	}
+	return map[string]string{TokenRotationLabel: "true"}
+}
+
+// tokenRotationAnnotations records the deadline until which the tokens are renewed, so a leaked token
+// can't be used to renew itself forever.
+func tokenRotationAnnotations(options ClusterConnectionOptions, now time.Time) map[string]string {
+	if options.TokenExpiration <= 0 {
+		return nil
+	}
+	renewalLimit := options.TokenRenewalLimit
+	if renewalLimit <= 0 {
+		renewalLimit = DefaultTokenRenewalLimit
+	}
+	return map[string]string{TokenRenewalDeadlineAnnotation: now.Add(renewalLimit).UTC().Format(time.RFC3339)}
+}
+
+// TokenRotator refreshes the time-bound tokens of the GitopsCluster kubeconfig secrets labelled with
+// TokenRotationLabel before they expire. It requests a new token with the current one, so it only
+// needs access to the secrets of the management cluster. Once the renewal deadline of a secret is
+// reached it revokes the renewal of its ServiceAccount instead, and stops rotating the secret.
+type TokenRotator struct {
+	client    kubernetes.Interface
+	namespace string
+	interval  time.Duration
+	log       logr.Logger
+	// newClient creates the client to the remote cluster from the secret kubeconfig
+	newClient func(config *rest.Config) (kubernetes.Interface, error)
+	now       func() time.Time
+}
+
+// NewTokenRotator creates a TokenRotator for the secrets in the namespace of the management cluster,
+// all namespaces if empty, that checks the tokens every interval.
+func NewTokenRotator(client kubernetes.Interface, namespace string, interval time.Duration, log logr.Logger) *TokenRotator {
+	return &TokenRotator{
+		client:    client,
+		namespace: namespace,
+		interval:  interval,
+		log:       log.WithName("token-rotator"),
+		newClient: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+		now: time.Now,
+	}
+}
+
+// Start rotates the tokens every interval until the context is done.
+func (r *TokenRotator) Start(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.RotateExpiringTokens(ctx); err != nil {
+			r.log.Error(err, "failed to rotate cluster tokens")
+		}
+	}, r.interval)
+}
+
+// StartWithLeaderElection rotates the tokens every interval while holding the rotation Lease of the
+// namespace, so only one replica of the management cluster rotates them.
+func (r *TokenRotator) StartWithLeaderElection(ctx context.Context, namespace, identity string) error {
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      tokenRotationLeaseName,
+				Namespace: namespace,
+			},
+			Client:     r.client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: r.Start,
+			OnStoppedLeading: func() {
+				r.log.Info("stopped leading cluster token rotation", "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create token rotation leader election: %w", err)
+	}
+
+	// the elector returns when the lease is lost, so it runs again to wait for the lease
+	wait.UntilWithContext(ctx, elector.Run, r.interval)
+	return nil
+}
+
+// RotateExpiringTokens rotates the tokens past the rotation threshold of their lifetime. A failure to
+// rotate a secret doesn't stop the rotation of the others.
+func (r *TokenRotator) RotateExpiringTokens(ctx context.Context) error {
+	secrets, err := r.client.CoreV1().Secrets(r.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: TokenRotationLabel + "=true",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list secrets to rotate: %w", err)
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if err := r.rotateSecret(ctx, secret); err != nil {
+			r.log.Error(err, "failed to rotate cluster token, run gitops connect cluster --rotate to renew it", "secret", secret.Namespace+"/"+secret.Name)
+		}
+	}
+	return nil
+}
+
+func (r *TokenRotator) rotateSecret(ctx context.Context, secret *v1.Secret) error {
+	config, err := clientcmd.Load(secret.Data["value"])
+	if err != nil {
+		return fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+	currentContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return fmt.Errorf("kubeconfig context %q not found", config.CurrentContext)
+	}
+	authInfo, ok := config.AuthInfos[currentContext.AuthInfo]
+	if !ok || authInfo.Token == "" {
+		return fmt.Errorf("kubeconfig has no token")
+	}
+
+	claims, err := parseServiceAccountToken(authInfo.Token)
+	if err != nil {
+		return err
+	}
+	issuedAt, expiresAt := claims.IssuedAt.Time, claims.ExpiresAt.Time
+	now := r.now()
+	if now.After(expiresAt) {
+		return fmt.Errorf("token expired at %s", expiresAt)
+	}
+	rotateAt := issuedAt.Add(time.Duration(float64(expiresAt.Sub(issuedAt)) * tokenRotationThreshold))
+	if now.Before(rotateAt) {
+		return nil
+	}
+
+	namespace, serviceAccountName, err := serviceAccountFromSubject(claims.Subject)
+	if err != nil {
+		return err
+	}
+	deadline, err := time.Parse(time.RFC3339, secret.Annotations[TokenRenewalDeadlineAnnotation])
+	if err != nil {
+		return fmt.Errorf("invalid token renewal deadline %q: %w", secret.Annotations[TokenRenewalDeadlineAnnotation], err)
+	}
+
+	restConfig, err := clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+	remoteClient, err := r.newClient(restConfig)
+	if err != nil {
+		return err
+	}
+
+	if now.Add(expiresAt.Sub(issuedAt)).After(deadline) {
+		return r.revokeTokenRenewal(ctx, remoteClient, secret, serviceAccountName, namespace, deadline)
+	}
+
+	token, err := requestServiceAccountToken(ctx, remoteClient, serviceAccountName, namespace, expiresAt.Sub(issuedAt))
+	if err != nil {
+		return err
+	}
+
+	authInfo.Token = string(token)
+	_, err = updateGitOpsClusterSecretConfig(ctx, r.client, secret, config)
+	if err != nil {
+		return err
+	}
+	r.log.Info("rotated cluster token", "secret", secret.Namespace+"/"+secret.Name, "serviceaccount", namespace+"/"+serviceAccountName)
+	return nil
+}
+
+// revokeTokenRenewal deletes the RoleBinding that allows the ServiceAccount to request its own tokens, so
+// no token can be renewed past the deadline, and stops rotating the secret.
+func (r *TokenRotator) revokeTokenRenewal(ctx context.Context, remoteClient kubernetes.Interface, secret *v1.Secret, serviceAccountName, namespace string, deadline time.Time) error {
+	err := remoteClient.RbacV1().RoleBindings(namespace).Delete(ctx, tokenRotationRoleName(serviceAccountName), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to revoke token renewal of service account %s/%s: %w", namespace, serviceAccountName, err)
+	}
+
+	delete(secret.Labels, TokenRotationLabel)
+	_, err = r.client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("token renewal deadline %s reached, revoked the token renewal of service account %s/%s", deadline.Format(time.RFC3339), namespace, serviceAccountName)
+}
+
+// parseServiceAccountToken reads the claims of a service account token. The token is not verified, as
+// it is only used to know when to rotate it and the remote cluster verifies it on use.
+func parseServiceAccountToken(token string) (*jwt.RegisteredClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	if err != nil {
+		return nil, fmt.Errorf("invalid service account token: %w", err)
+	}
+	if claims.IssuedAt == nil || claims.ExpiresAt == nil {
+		return nil, fmt.Errorf("service account token is not time-bound")
+	}
+	return claims, nil
+}
+
+func serviceAccountFromSubject(subject string) (string, string, error) {
+	parts := strings.Split(strings.TrimPrefix(subject, serviceAccountUsernamePrefix), ":")
+	if !strings.HasPrefix(subject, serviceAccountUsernamePrefix) || len(parts) != 2 {
+		return "", "", fmt.Errorf("token subject %q is not a service account", subject)
+	}
+	return parts[0], parts[1], nil
+}
+
+// updateGitOpsClusterSecretConfig writes the kubeconfig to an existing GitopsCluster secret
+func updateGitOpsClusterSecretConfig(ctx context.Context, client kubernetes.Interface, secret *v1.Secret, config *clientcmdapi.Config) (*v1.Secret, error) {
+	configBytes, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, err
+	}
+	secret.Data["value"] = configBytes
+	return client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+}
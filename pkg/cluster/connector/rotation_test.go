@@ -0,0 +1,312 @@
+package connector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// addFakeTokenRequests makes the fake client answer token requests with the given token, recording the requested expirations
+func addFakeTokenRequests(client *fake.Clientset, token string, expirations *[]int64) {
+	client.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		request := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		if expirations != nil {
+			*expirations = append(*expirations, *request.Spec.ExpirationSeconds)
+		}
+		request.Status.Token = token
+		return true, request, nil
+	})
+}
+
+func newTestServiceAccountToken(t *testing.T, issuedAt time.Time, lifetime time.Duration) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "system:serviceaccount:default:test-service-account",
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(issuedAt.Add(lifetime)),
+	}).SignedString([]byte("test"))
+	assert.NoError(t, err)
+	return token
+}
+
+func newTestClusterSecret(t *testing.T, name, token string, renewalDeadline time.Time) *corev1.Secret {
+	config, err := kubeConfigWithToken(context.Background(), &rest.Config{Host: "https://spoke.example.com"}, "spoke", []byte(token))
+	assert.NoError(t, err)
+	configBytes, err := clientcmd.Write(*config)
+	assert.NoError(t, err)
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: corev1.NamespaceDefault,
+			Labels:    map[string]string{TokenRotationLabel: "true"},
+			Annotations: map[string]string{
+				TokenRenewalDeadlineAnnotation: renewalDeadline.Format(time.RFC3339),
+			},
+		},
+		Data: map[string][]byte{"value": configBytes},
+	}
+}
+
+func secretToken(t *testing.T, client kubernetes.Interface, name string) string {
+	return secretTokenIn(t, client, corev1.NamespaceDefault, name)
+}
+
+func secretTokenIn(t *testing.T, client kubernetes.Interface, namespace, name string) string {
+	secret, err := client.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	config, err := clientcmd.Load(secret.Data["value"])
+	assert.NoError(t, err)
+	return config.AuthInfos[config.Contexts[config.CurrentContext].AuthInfo].Token
+}
+
+func TestReconcileServiceAccount_timeBoundToken(t *testing.T) {
+	remoteClientSet := fake.NewSimpleClientset()
+	expirations := []int64{}
+	addFakeTokenRequests(remoteClientSet, "time-bound-token", &expirations)
+
+	clusterConnectionOpts := ClusterConnectionOptions{
+		ServiceAccountName:     "test-service-account",
+		ClusterRoleName:        "test-service-account-cluster-role",
+		ClusterRoleBindingName: "test-service-account-cluster-role-binding",
+		GitopsClusterName:      types.NamespacedName{Namespace: corev1.NamespaceDefault},
+		TokenExpiration:        time.Hour,
+	}
+
+	token, err := ReconcileServiceAccount(context.Background(), remoteClientSet, clusterConnectionOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("time-bound-token"), token)
+	assert.Equal(t, []int64{3600}, expirations)
+
+	// Verify the service account can request its own tokens
+	role, err := remoteClientSet.RbacV1().Roles(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token-rotation", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, newTokenRotationRole("test-service-account", corev1.NamespaceDefault), role)
+	_, err = remoteClientSet.RbacV1().RoleBindings(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token-rotation", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	// Verify no long-lived token secret is created
+	_, err = remoteClientSet.CoreV1().Secrets(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// Verify disconnect deletes the token rotation role
+	err = deleteServiceAccountResources(context.Background(), remoteClientSet, clusterConnectionOpts)
+	assert.NoError(t, err)
+	_, err = remoteClientSet.RbacV1().Roles(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token-rotation", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestTokenRotator_RotateExpiringTokens(t *testing.T) {
+	now := time.Now()
+	expiringToken := newTestServiceAccountToken(t, now.Add(-50*time.Minute), time.Hour)
+	validToken := newTestServiceAccountToken(t, now.Add(-10*time.Minute), time.Hour)
+	expiredToken := newTestServiceAccountToken(t, now.Add(-2*time.Hour), time.Hour)
+
+	renewalDeadline := now.Add(24 * time.Hour)
+
+	hubClientSet := fake.NewSimpleClientset(
+		newTestClusterSecret(t, "expiring-kubeconfig", expiringToken, renewalDeadline),
+		newTestClusterSecret(t, "valid-kubeconfig", validToken, renewalDeadline),
+		newTestClusterSecret(t, "expired-kubeconfig", expiredToken, renewalDeadline),
+	)
+	remoteClientSet := fake.NewSimpleClientset()
+	expirations := []int64{}
+	addFakeTokenRequests(remoteClientSet, "rotated-token", &expirations)
+
+	rotator := NewTokenRotator(hubClientSet, "", time.Minute, logr.Discard())
+	rotator.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+		assert.Equal(t, expiringToken, config.BearerToken)
+		assert.Equal(t, "https://spoke.example.com", config.Host)
+		return remoteClientSet, nil
+	}
+	rotator.now = func() time.Time { return now }
+
+	err := rotator.RotateExpiringTokens(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "rotated-token", secretToken(t, hubClientSet, "expiring-kubeconfig"))
+	assert.Equal(t, []int64{3600}, expirations)
+	assert.Equal(t, validToken, secretToken(t, hubClientSet, "valid-kubeconfig"))
+	assert.Equal(t, expiredToken, secretToken(t, hubClientSet, "expired-kubeconfig"))
+}
+
+func TestTokenRotator_RotatesSecretsOutsideRuntimeNamespace(t *testing.T) {
+	now := time.Now()
+	expiringToken := newTestServiceAccountToken(t, now.Add(-50*time.Minute), time.Hour)
+
+	// gitops connect cluster writes the secret in the namespace of the GitopsCluster
+	secret := newTestClusterSecret(t, "team-a-kubeconfig", expiringToken, now.Add(24*time.Hour))
+	secret.Namespace = "team-a"
+
+	newRotator := func(hubClientSet kubernetes.Interface, namespace string) *TokenRotator {
+		remoteClientSet := fake.NewSimpleClientset()
+		addFakeTokenRequests(remoteClientSet, "rotated-token", nil)
+
+		rotator := NewTokenRotator(hubClientSet, namespace, time.Minute, logr.Discard())
+		rotator.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+			return remoteClientSet, nil
+		}
+		rotator.now = func() time.Time { return now }
+		return rotator
+	}
+
+	t.Run("rotates the secrets of all namespaces", func(t *testing.T) {
+		hubClientSet := fake.NewSimpleClientset(secret.DeepCopy())
+
+		assert.NoError(t, newRotator(hubClientSet, "").RotateExpiringTokens(context.Background()))
+		assert.Equal(t, "rotated-token", secretTokenIn(t, hubClientSet, "team-a", "team-a-kubeconfig"))
+	})
+
+	t.Run("only rotates the secrets of its namespace", func(t *testing.T) {
+		hubClientSet := fake.NewSimpleClientset(secret.DeepCopy())
+
+		assert.NoError(t, newRotator(hubClientSet, "flux-system").RotateExpiringTokens(context.Background()))
+		assert.Equal(t, expiringToken, secretTokenIn(t, hubClientSet, "team-a", "team-a-kubeconfig"))
+	})
+}
+
+func TestTokenRotator_RevokesRenewalPastDeadline(t *testing.T) {
+	now := time.Now()
+	expiringToken := newTestServiceAccountToken(t, now.Add(-50*time.Minute), time.Hour)
+
+	hubClientSet := fake.NewSimpleClientset(
+		newTestClusterSecret(t, "expiring-kubeconfig", expiringToken, now.Add(30*time.Minute)),
+	)
+	remoteClientSet := fake.NewSimpleClientset(newTokenRotationRoleBinding("test-service-account", corev1.NamespaceDefault))
+	expirations := []int64{}
+	addFakeTokenRequests(remoteClientSet, "rotated-token", &expirations)
+
+	rotator := NewTokenRotator(hubClientSet, "", time.Minute, logr.Discard())
+	rotator.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+		return remoteClientSet, nil
+	}
+	rotator.now = func() time.Time { return now }
+
+	err := rotator.RotateExpiringTokens(context.Background())
+	assert.NoError(t, err)
+
+	// Verify no token is renewed past the deadline and the service account can't renew its tokens anymore
+	assert.Equal(t, expiringToken, secretToken(t, hubClientSet, "expiring-kubeconfig"))
+	assert.Empty(t, expirations)
+	_, err = remoteClientSet.RbacV1().RoleBindings(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token-rotation", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// Verify the secret is not rotated anymore
+	secret, err := hubClientSet.CoreV1().Secrets(corev1.NamespaceDefault).Get(context.Background(), "expiring-kubeconfig", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, secret.Labels, TokenRotationLabel)
+}
+
+func TestTokenRotator_StartWithLeaderElection(t *testing.T) {
+	now := time.Now()
+	expiringToken := newTestServiceAccountToken(t, now.Add(-50*time.Minute), time.Hour)
+
+	newRotator := func(hubClientSet kubernetes.Interface) *TokenRotator {
+		remoteClientSet := fake.NewSimpleClientset()
+		addFakeTokenRequests(remoteClientSet, "rotated-token", nil)
+
+		rotator := NewTokenRotator(hubClientSet, "", 10*time.Millisecond, logr.Discard())
+		rotator.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+			return remoteClientSet, nil
+		}
+		rotator.now = func() time.Time { return now }
+		return rotator
+	}
+
+	t.Run("rotates the tokens while leading", func(t *testing.T) {
+		hubClientSet := fake.NewSimpleClientset(newTestClusterSecret(t, "expiring-kubeconfig", expiringToken, now.Add(24*time.Hour)))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			assert.NoError(t, newRotator(hubClientSet).StartWithLeaderElection(ctx, corev1.NamespaceDefault, "replica-1"))
+		}()
+
+		assert.Eventually(t, func() bool {
+			return secretToken(t, hubClientSet, "expiring-kubeconfig") == "rotated-token"
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("doesn't rotate the tokens while another replica leads", func(t *testing.T) {
+		holder := "replica-1"
+		leaseDuration := int32(60)
+		renewTime := metav1.NewMicroTime(time.Now())
+		hubClientSet := fake.NewSimpleClientset(
+			newTestClusterSecret(t, "expiring-kubeconfig", expiringToken, now.Add(24*time.Hour)),
+			&coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Name: tokenRotationLeaseName, Namespace: corev1.NamespaceDefault},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       &holder,
+					LeaseDurationSeconds: &leaseDuration,
+					AcquireTime:          &renewTime,
+					RenewTime:            &renewTime,
+				},
+			},
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			assert.NoError(t, newRotator(hubClientSet).StartWithLeaderElection(ctx, corev1.NamespaceDefault, "replica-2"))
+		}()
+
+		assert.Never(t, func() bool {
+			return secretToken(t, hubClientSet, "expiring-kubeconfig") == "rotated-token"
+		}, 500*time.Millisecond, 10*time.Millisecond)
+	})
+}
+
+func TestDeleteLegacyTokenSecret(t *testing.T) {
+	t.Run("deletes the long-lived token secret", func(t *testing.T) {
+		client := fake.NewSimpleClientset(newServiceAccountTokenSecret("test-service-account-token", "test-service-account", corev1.NamespaceDefault))
+
+		err := deleteLegacyTokenSecret(context.Background(), client, "test-service-account", corev1.NamespaceDefault)
+		assert.NoError(t, err)
+
+		_, err = client.CoreV1().Secrets(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("does not delete secrets not managed by weave-gitops", func(t *testing.T) {
+		client := fake.NewSimpleClientset(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-service-account-token", Namespace: corev1.NamespaceDefault}})
+
+		err := deleteLegacyTokenSecret(context.Background(), client, "test-service-account", corev1.NamespaceDefault)
+		assert.NoError(t, err)
+
+		_, err = client.CoreV1().Secrets(corev1.NamespaceDefault).Get(context.Background(), "test-service-account-token", metav1.GetOptions{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("ignores missing secrets", func(t *testing.T) {
+		err := deleteLegacyTokenSecret(context.Background(), fake.NewSimpleClientset(), "test-service-account", corev1.NamespaceDefault)
+		assert.NoError(t, err)
+	})
+}
+
+func TestServiceAccountFromSubject(t *testing.T) {
+	namespace, name, err := serviceAccountFromSubject("system:serviceaccount:default:weave-gitops-enterprise")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", namespace)
+	assert.Equal(t, "weave-gitops-enterprise", name)
+
+	_, _, err = serviceAccountFromSubject("admin")
+	assert.EqualError(t, err, "token subject \"admin\" is not a service account")
+}
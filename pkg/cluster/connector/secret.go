@@ -45,7 +45,8 @@ func getSecretNameFromCluster(ctx context.Context, client dynamic.Interface, sch
 }
 
 // createOrUpdateGitOpsClusterSecret updates/creates the secret with the kubeconfig data given the secret name and namespace of the secret
-func createOrUpdateGitOpsClusterSecret(ctx context.Context, client kubernetes.Interface, secretName, namespace string, config *clientcmdapi.Config) (*v1.Secret, error) {
+// The token rotation label and renewal deadline annotation are set or removed according to the labels and annotations.
+func createOrUpdateGitOpsClusterSecret(ctx context.Context, client kubernetes.Interface, secretName, namespace string, config *clientcmdapi.Config, labels, annotations map[string]string) (*v1.Secret, error) {
 	lgr := log.FromContext(ctx)
 	configBytes, err := clientcmd.Write(*config)
 	if err != nil {
@@ -74,6 +75,24 @@ func createOrUpdateGitOpsClusterSecret(ctx context.Context, client kubernetes.In
 
 	}
 
+	if _, ok := labels[TokenRotationLabel]; !ok {
+		delete(secret.Labels, TokenRotationLabel)
+	}
+	for key, value := range labels {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[key] = value
+	}
+	if _, ok := annotations[TokenRenewalDeadlineAnnotation]; !ok {
+		delete(secret.Annotations, TokenRenewalDeadlineAnnotation)
+	}
+	for key, value := range annotations {
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[key] = value
+	}
 	secret.Data["value"] = configBytes
 	updatedSecret, err := client.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
 	if err != nil {
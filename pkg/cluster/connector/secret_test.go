@@ -60,7 +60,7 @@ func TestCreateOrUpdateGitOpsClusterSecret(t *testing.T) {
 		},
 	}
 
-	secretCreated, err := createOrUpdateGitOpsClusterSecret(context.TODO(), client, "spoke-secret", "default", config)
+	secretCreated, err := createOrUpdateGitOpsClusterSecret(context.TODO(), client, "spoke-secret", "default", config, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedSecret, secretCreated, "Secret created not equal expected")
 
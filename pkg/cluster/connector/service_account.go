@@ -20,22 +20,46 @@ var managedByLabelName = "weave-gitops"
 
 // ReconcileServiceAccount accepts a client and the name for a service account.
 // A new Service account is created, if one with same name exists that will be used
-// A new cluster role and cluster role binding are created, if already existing those will be used
-// returns the token of the secret created for the service account
+// A new cluster role with the rules of the access profile is created, if already existing its rules are updated
+// A new cluster role binding is created, if already existing bound to another cluster role it is recreated
+// returns the token of the secret created for the service account, or a time-bound token if TokenExpiration is set.
+// The long-lived token secret of a previous connection is deleted with deleteLegacyTokenSecret once the time-bound
+// token is written to the GitopsCluster secret.
 func ReconcileServiceAccount(ctx context.Context, client kubernetes.Interface, clusterConnectionOpts ClusterConnectionOptions) ([]byte, error) {
 	namespace := clusterConnectionOpts.GitopsClusterName.Namespace
 
+	if clusterConnectionOpts.ClusterRoleName == "" {
+		return nil, fmt.Errorf("cluster role name is required")
+	}
+
 	err := createServiceAccount(ctx, client, clusterConnectionOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	clusterRoleName := "cluster-admin"
-	err = createClusterRoleBinding(ctx, client, clusterRoleName, clusterConnectionOpts)
+	rules, err := clusterRoleRules(clusterConnectionOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createOrUpdateClusterRole(ctx, client, rules, clusterConnectionOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createClusterRoleBinding(ctx, client, clusterConnectionOpts.ClusterRoleName, clusterConnectionOpts)
 	if err != nil {
 		return nil, err
 	}
 
+	if clusterConnectionOpts.TokenExpiration > 0 {
+		err = createTokenRotationRole(ctx, client, clusterConnectionOpts)
+		if err != nil {
+			return nil, err
+		}
+		return requestServiceAccountToken(ctx, client, clusterConnectionOpts.ServiceAccountName, namespace, clusterConnectionOpts.TokenExpiration)
+	}
+
 	secret, err := createSecret(ctx, client, clusterConnectionOpts)
 	if err != nil {
 		return nil, err
@@ -88,6 +112,17 @@ func deleteServiceAccountResources(ctx context.Context, client kubernetes.Interf
 		return err
 	}
 
+	clusterRoleName := clusterConnectionOpts.ClusterRoleName
+	err = deleteClusterRole(ctx, client, clusterRoleName)
+	if err != nil {
+		return err
+	}
+
+	err = deleteTokenRotationRole(ctx, client, serviceAccountName, namespace)
+	if err != nil {
+		return err
+	}
+
 	return nil
 
 }
@@ -177,6 +212,36 @@ func createServiceAccount(ctx context.Context, client kubernetes.Interface, clus
 
 }
 
+func createOrUpdateClusterRole(ctx context.Context, client kubernetes.Interface, rules []rbacv1.PolicyRule, clusterConnectionOpts ClusterConnectionOptions) error {
+	lgr := log.FromContext(ctx)
+	clusterRoleName := clusterConnectionOpts.ClusterRoleName
+	namespace := clusterConnectionOpts.GitopsClusterName.Namespace
+
+	clusterRoleObj := newClusterRole(clusterRoleName, namespace, rules)
+	_, err := client.RbacV1().ClusterRoles().Create(ctx, clusterRoleObj, metav1.CreateOptions{})
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		clusterRole, err := client.RbacV1().ClusterRoles().Get(ctx, clusterRoleName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if clusterRole.Labels["app.kubernetes.io/managed-by"] != managedByLabelName {
+			return fmt.Errorf("cluster role %s already exists and is not managed by %s", clusterRoleName, managedByLabelName)
+		}
+		clusterRole.Rules = rules
+		_, err = client.RbacV1().ClusterRoles().Update(ctx, clusterRole, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+		lgr.V(logger.LogLevelDebug).Info("cluster role updated successfully!", "clusterRole", clusterRoleName)
+	} else {
+		lgr.V(logger.LogLevelDebug).Info("cluster role created successfully!", "clusterRole", clusterRoleName)
+	}
+	return nil
+}
+
 func createClusterRoleBinding(ctx context.Context, client kubernetes.Interface, clusterRoleName string, clusterConnectionOpts ClusterConnectionOptions) error {
 	lgr := log.FromContext(ctx)
 	serviceAccountName := clusterConnectionOpts.ServiceAccountName
@@ -193,7 +258,21 @@ func createClusterRoleBinding(ctx context.Context, client kubernetes.Interface,
 			if err != nil {
 				return err
 			}
-			lgr.V(logger.LogLevelDebug).Info("cluster role binding already exists", "clusterRoleBinding", clusterRoleBinding.Name)
+			if clusterRoleBinding.RoleRef.Name == clusterRoleName {
+				lgr.V(logger.LogLevelDebug).Info("cluster role binding already exists", "clusterRoleBinding", clusterRoleBinding.Name)
+				return nil
+			}
+
+			// the role of a binding is immutable, so bindings created for another role are recreated
+			err = deleteClusterRoleBinding(ctx, client, clusterRoleBindingName)
+			if err != nil {
+				return err
+			}
+			_, err = client.RbacV1().ClusterRoleBindings().Create(ctx, clusterRoleBindingObj, metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			lgr.V(logger.LogLevelDebug).Info("cluster role binding recreated successfully!", "clusterrolebinding", clusterRoleBindingName, "previousClusterRole", clusterRoleBinding.RoleRef.Name)
 		}
 	} else {
 		lgr.V(logger.LogLevelDebug).Info("cluster role binding created successfully!", "clusterrolebinding", clusterRoleBindingName)
@@ -206,7 +285,7 @@ func createSecret(ctx context.Context, client kubernetes.Interface, clusterConne
 	serviceAccountName := clusterConnectionOpts.ServiceAccountName
 	namespace := clusterConnectionOpts.GitopsClusterName.Namespace
 
-	secretName := serviceAccountName + "-token"
+	secretName := legacyTokenSecretName(serviceAccountName)
 	secretObj := newServiceAccountTokenSecret(secretName, serviceAccountName, namespace)
 	secret, err := client.CoreV1().Secrets(namespace).Create(ctx, secretObj, metav1.CreateOptions{})
 	if err != nil {
@@ -218,6 +297,35 @@ func createSecret(ctx context.Context, client kubernetes.Interface, clusterConne
 
 }
 
+// legacyTokenSecretName is the name of the long-lived token secret of the service account
+func legacyTokenSecretName(serviceAccountName string) string {
+	return serviceAccountName + "-token"
+}
+
+// deleteLegacyTokenSecret deletes the long-lived token secret of the service account if managed by weave-gitops,
+// so its token can't be used anymore once the connection uses time-bound tokens.
+func deleteLegacyTokenSecret(ctx context.Context, client kubernetes.Interface, serviceAccountName, namespace string) error {
+	lgr := log.FromContext(ctx)
+	secretName := legacyTokenSecretName(serviceAccountName)
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if secret.Labels["app.kubernetes.io/managed-by"] != managedByLabelName {
+		lgr.V(logger.LogLevelDebug).Info("service account secret is not managed by weave-gitops, skipping", "secret", secretName)
+		return nil
+	}
+	err = client.CoreV1().Secrets(namespace).Delete(ctx, secretName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	lgr.V(logger.LogLevelDebug).Info("long-lived service account secret deleted successfully!", "secret", secretName)
+	return nil
+}
+
 func deleteServiceAccount(ctx context.Context, client kubernetes.Interface, serviceAccountName, namespace string) error {
 	lgr := log.FromContext(ctx)
 	err := client.CoreV1().ServiceAccounts(namespace).Delete(ctx, serviceAccountName, metav1.DeleteOptions{})
@@ -239,6 +347,32 @@ func deleteClusterRoleBinding(ctx context.Context, client kubernetes.Interface,
 	return nil
 }
 
+// deleteClusterRole deletes the cluster role of the connection if managed by weave-gitops. Connections created
+// before access profiles were bound to cluster-admin and have no cluster role to delete.
+func deleteClusterRole(ctx context.Context, client kubernetes.Interface, clusterRoleName string) error {
+	lgr := log.FromContext(ctx)
+	if clusterRoleName == "" {
+		return nil
+	}
+	clusterRole, err := client.RbacV1().ClusterRoles().Get(ctx, clusterRoleName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if clusterRole.Labels["app.kubernetes.io/managed-by"] != managedByLabelName {
+		lgr.V(logger.LogLevelDebug).Info("cluster role is not managed by weave-gitops, skipping", "clusterRole", clusterRoleName)
+		return nil
+	}
+	err = client.RbacV1().ClusterRoles().Delete(ctx, clusterRoleName, metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+	lgr.V(logger.LogLevelDebug).Info("cluster role deleted successfully!", "clusterRole", clusterRoleName)
+	return nil
+}
+
 func checkServiceAccountName(ctx context.Context, client kubernetes.Interface, options *ClusterConnectionOptions, labelSelector labels.Selector) error {
 	serviceAccountList, err := client.CoreV1().ServiceAccounts(options.GitopsClusterName.Namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector.String()})
 	if err != nil {
@@ -57,7 +57,8 @@ func TestReconcileServiceAccount(t *testing.T) {
 					},
 				},
 
-				"ClusterRoleBinding": newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "cluster-admin", "test-service-account"),
+				"ClusterRole":        newClusterRole("test-service-account-cluster-role", corev1.NamespaceDefault, adminRules),
+				"ClusterRoleBinding": newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "test-service-account-cluster-role", "test-service-account"),
 			},
 		},
 		{
@@ -101,7 +102,8 @@ func TestReconcileServiceAccount(t *testing.T) {
 						},
 					},
 				},
-				"ClusterRoleBinding": newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "cluster-admin", "test-service-account"),
+				"ClusterRole":        newClusterRole("test-service-account-cluster-role", corev1.NamespaceDefault, adminRules),
+				"ClusterRoleBinding": newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "test-service-account-cluster-role", "test-service-account"),
 			},
 		},
 		{
@@ -137,7 +139,8 @@ func TestReconcileServiceAccount(t *testing.T) {
 						},
 					},
 				},
-				"ClusterRoleBinding": newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "cluster-admin", "test-service-account"),
+				"ClusterRole":        newClusterRole("test-service-account-cluster-role", corev1.NamespaceDefault, adminRules),
+				"ClusterRoleBinding": newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "test-service-account-cluster-role", "test-service-account"),
 			},
 		},
 	}
@@ -166,6 +169,12 @@ func TestReconcileServiceAccount(t *testing.T) {
 			expectedServiceAccount := tt.expectedResources["ServiceAccount"].(*corev1.ServiceAccount)
 			assert.Equal(t, expectedServiceAccount, serviceAccount, "service account found doesn't match expected")
 
+			// Verify ClusterRole created with the admin profile rules
+			expectedClusterRole := tt.expectedResources["ClusterRole"].(*rbacv1.ClusterRole)
+			clusterRole, err := remoteClientSet.RbacV1().ClusterRoles().Get(context.Background(), tt.serviceAccountName+"-cluster-role", metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, expectedClusterRole, clusterRole, "cluster role found doesn't match expected")
+
 			// Verify ClusterRoleBinding created/exists
 			expectedClusterRoleBinding := tt.expectedResources["ClusterRoleBinding"].(*rbacv1.ClusterRoleBinding)
 			clusterRoleBinding, err := remoteClientSet.RbacV1().ClusterRoleBindings().Get(context.Background(), tt.serviceAccountName+"-cluster-role-binding", metav1.GetOptions{})
@@ -388,7 +397,8 @@ func TestDeleteServiceAccountResources(t *testing.T) {
 						Namespace: corev1.NamespaceDefault,
 					},
 				},
-				newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "cluster-admin", "test-service-account"),
+				newClusterRole("test-service-account-cluster-role", corev1.NamespaceDefault, adminRules),
+				newClusterRoleBinding("test-service-account-cluster-role-binding", corev1.NamespaceDefault, "test-service-account-cluster-role", "test-service-account"),
 				newServiceAccountTokenSecret("test-service-account-token", "test-service-account", corev1.NamespaceDefault),
 			},
 			"test-service-account",
@@ -402,6 +412,7 @@ func TestDeleteServiceAccountResources(t *testing.T) {
 			remoteClientSet := fake.NewSimpleClientset(tt.existingResources...)
 			clusterConnectionOpts := ClusterConnectionOptions{
 				ServiceAccountName:     tt.serviceAccountName,
+				ClusterRoleName:        tt.serviceAccountName + "-cluster-role",
 				ClusterRoleBindingName: tt.clusterRoleBindingName,
 				GitopsClusterName:      types.NamespacedName{Namespace: corev1.NamespaceDefault},
 			}
@@ -419,6 +430,11 @@ func TestDeleteServiceAccountResources(t *testing.T) {
 			assert.Error(t, err)
 			assert.True(t, apierrors.IsNotFound(err))
 
+			// Verify ClusterRole deleted
+			_, err = remoteClientSet.RbacV1().ClusterRoles().Get(context.Background(), clusterConnectionOpts.ClusterRoleName, metav1.GetOptions{})
+			assert.Error(t, err)
+			assert.True(t, apierrors.IsNotFound(err))
+
 		})
 	}
 
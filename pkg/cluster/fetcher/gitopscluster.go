@@ -121,16 +121,7 @@ func (f gitopsClusterFetcher) leafClusters(ctx context.Context) ([]mngrcluster.C
 			continue
 		}
 
-		var secretRef string
-
-		if cluster.Spec.CAPIClusterRef != nil {
-			secretRef = fmt.Sprintf("%s-kubeconfig", cluster.Spec.CAPIClusterRef.Name)
-		}
-
-		if secretRef == "" && cluster.Spec.SecretRef != nil {
-			secretRef = cluster.Spec.SecretRef.Name
-		}
-
+		secretRef := kubeconfigSecretRef(cluster)
 		if secretRef == "" {
 			f.log.V(logger.LogLevelDebug).Info("Ignoring GitOps Cluster, no secret ref found", "cluster", cluster.Name)
 			continue
@@ -148,16 +139,7 @@ func (f gitopsClusterFetcher) leafClusters(ctx context.Context) ([]mngrcluster.C
 			continue
 		}
 
-		var data []byte
-
-		for k := range secret.Data {
-			if k == dataKey || k == yamlDataKey {
-				data = secret.Data[k]
-
-				break
-			}
-		}
-
+		data := kubeconfigData(secret)
 		if len(data) == 0 {
 			f.log.V(logger.LogLevelDebug).Info("Ignoring GitOps Cluster, no data found", "cluster", cluster.Name)
 			continue
@@ -198,6 +180,31 @@ func (f gitopsClusterFetcher) leafClusters(ctx context.Context) ([]mngrcluster.C
 	return clusters, nil
 }
 
+// kubeconfigSecretRef returns the name of the secret with the kubeconfig of the cluster, the CAPI cluster
+// kubeconfig takes precedence over the secret ref.
+func kubeconfigSecretRef(cluster gitopsv1alpha1.GitopsCluster) string {
+	if cluster.Spec.CAPIClusterRef != nil {
+		return fmt.Sprintf("%s-kubeconfig", cluster.Spec.CAPIClusterRef.Name)
+	}
+
+	if cluster.Spec.SecretRef != nil {
+		return cluster.Spec.SecretRef.Name
+	}
+
+	return ""
+}
+
+// kubeconfigData returns the kubeconfig of a GitopsCluster secret
+func kubeconfigData(secret v1.Secret) []byte {
+	for k := range secret.Data {
+		if k == dataKey || k == yamlDataKey {
+			return secret.Data[k]
+		}
+	}
+
+	return nil
+}
+
 func isReady(cluster gitopsv1alpha1.GitopsCluster) bool {
 	return apimeta.IsStatusConditionTrue(cluster.GetConditions(), meta.ReadyCondition)
 }
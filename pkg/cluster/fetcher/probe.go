@@ -0,0 +1,315 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	mngrcluster "github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"golang.org/x/sync/singleflight"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Capabilities of the clusters, detected by the API groups of their CRDs.
+const (
+	CapabilityFlux            = "flux"
+	CapabilityTerraform       = "tf-controller"
+	CapabilityPolicyAgent     = "policy-agent"
+	CapabilityExternalSecrets = "external-secrets"
+)
+
+// DefaultProbeCacheTTL is how long the probe of a cluster is cached if not set.
+const DefaultProbeCacheTTL = 5 * time.Minute
+
+// probeTimeout limits the discovery requests to unreachable clusters.
+const probeTimeout = 10 * time.Second
+
+// probeConcurrency limits the clusters probed at the same time.
+const probeConcurrency = 10
+
+// minRefreshInterval is how long a probe is cached even if a refresh is requested, so refreshes can't
+// flood the clusters with discovery requests.
+const minRefreshInterval = 30 * time.Second
+
+// capabilityAPIGroups are the API groups a cluster must serve to support each capability.
+var capabilityAPIGroups = []struct {
+	name      string
+	apiGroups []string
+}{
+	{CapabilityFlux, []string{"source.toolkit.fluxcd.io", "kustomize.toolkit.fluxcd.io", "helm.toolkit.fluxcd.io"}},
+	{CapabilityTerraform, []string{"infra.contrib.fluxcd.io"}},
+	{CapabilityPolicyAgent, []string{"pac.weave.works"}},
+	{CapabilityExternalSecrets, []string{"external-secrets.io"}},
+}
+
+// ErrClusterNotFound is returned when probing a cluster that is not the management cluster or a GitopsCluster.
+var ErrClusterNotFound = errors.New("cluster not found")
+
+// Capability reports whether a cluster supports a capability.
+type Capability struct {
+	Name      string
+	Installed bool
+	// APIVersions are the preferred versions of the API groups of the capability, if installed.
+	APIVersions []string
+}
+
+// ClusterProbe is the outcome of running discovery against a cluster.
+type ClusterProbe struct {
+	// Cluster is the GitopsCluster, the namespace is empty for the management cluster.
+	Cluster types.NamespacedName
+	// Reachable is true if discovery succeeded with the stored kubeconfig.
+	Reachable bool
+	// Error is the reason the cluster is not reachable.
+	Error             string
+	KubernetesVersion string
+	Capabilities      []Capability
+	ProbedAt          time.Time
+}
+
+// ClusterProber runs discovery against the management cluster and the GitopsClusters with the kubeconfig
+// of their secrets, as the gitopsClusterFetcher does. Unlike the fetcher it reports the clusters that are
+// not ready or reachable. Probes are cached for the cache TTL, and concurrent probes of a cluster share
+// the same discovery.
+type ClusterProber struct {
+	fetcher            gitopsClusterFetcher
+	cacheTTL           time.Duration
+	newDiscoveryClient func(*rest.Config) (discovery.DiscoveryInterface, error)
+	now                func() time.Time
+
+	mu     sync.Mutex
+	cache  map[types.NamespacedName]ClusterProbe
+	probes singleflight.Group
+}
+
+// NewClusterProber creates a ClusterProber for the GitopsClusters in the namespace of the management
+// cluster, all namespaces if empty.
+func NewClusterProber(log logr.Logger, managementCluster mngrcluster.Cluster, namespace string, cacheTTL time.Duration) *ClusterProber {
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultProbeCacheTTL
+	}
+
+	return &ClusterProber{
+		fetcher: gitopsClusterFetcher{
+			log:       log.WithName("cluster-prober"),
+			cluster:   managementCluster,
+			namespace: namespace,
+		},
+		cacheTTL: cacheTTL,
+		newDiscoveryClient: func(config *rest.Config) (discovery.DiscoveryInterface, error) {
+			return discovery.NewDiscoveryClientForConfig(config)
+		},
+		now:   time.Now,
+		cache: map[types.NamespacedName]ClusterProbe{},
+	}
+}
+
+// ProbeAll returns the probes of the management cluster and the GitopsClusters sorted by name, running
+// discovery against those without a cached probe or if refresh is set. Only the clusters the visible
+// func returns true for are probed, all of them if nil.
+func (p *ClusterProber) ProbeAll(ctx context.Context, refresh bool, visible func(types.NamespacedName) bool) ([]ClusterProbe, error) {
+	cl, err := p.fetcher.cluster.GetServerClient()
+	if err != nil {
+		return nil, err
+	}
+
+	goClusters := &gitopsv1alpha1.GitopsClusterList{}
+	if err := cl.List(ctx, goClusters, client.InNamespace(p.fetcher.namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list GitopsClusters: %w", err)
+	}
+
+	type probeTarget struct {
+		cluster    types.NamespacedName
+		restConfig func() (*rest.Config, error)
+	}
+	targets := []probeTarget{}
+	if management := (types.NamespacedName{Name: p.fetcher.cluster.GetName()}); visible == nil || visible(management) {
+		targets = append(targets, probeTarget{management, p.fetcher.cluster.GetServerConfig})
+	}
+	for _, cluster := range goClusters.Items {
+		cluster := cluster
+		name := types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace}
+		if visible != nil && !visible(name) {
+			continue
+		}
+		targets = append(targets, probeTarget{name, func() (*rest.Config, error) {
+			return p.restConfig(ctx, cl, cluster)
+		}})
+	}
+
+	probes := make([]ClusterProbe, len(targets))
+	sem := make(chan struct{}, probeConcurrency)
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, target probeTarget) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			probes[i] = p.cachedProbe(target.cluster, refresh, target.restConfig)
+		}(i, target)
+	}
+	wg.Wait()
+
+	sort.Slice(probes, func(i, j int) bool {
+		return ToClusterName(probes[i].Cluster) < ToClusterName(probes[j].Cluster)
+	})
+
+	return probes, nil
+}
+
+// Probe runs discovery against a cluster, the management cluster if the namespace is empty, and caches
+// the result. Probes more recent than the minimum refresh interval are returned from the cache.
+func (p *ClusterProber) Probe(ctx context.Context, cluster types.NamespacedName) (ClusterProbe, error) {
+	if IsManagementCluster(p.fetcher.cluster.GetName(), cluster) {
+		return p.cachedProbe(cluster, true, p.fetcher.cluster.GetServerConfig), nil
+	}
+	if cluster.Namespace == "" {
+		return ClusterProbe{}, fmt.Errorf("%w: %s", ErrClusterNotFound, cluster.Name)
+	}
+
+	cl, err := p.fetcher.cluster.GetServerClient()
+	if err != nil {
+		return ClusterProbe{}, err
+	}
+
+	var goCluster gitopsv1alpha1.GitopsCluster
+	if err := cl.Get(ctx, cluster, &goCluster); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			return ClusterProbe{}, fmt.Errorf("%w: %s", ErrClusterNotFound, cluster)
+		}
+		return ClusterProbe{}, fmt.Errorf("failed to get GitopsCluster %s: %w", cluster, err)
+	}
+
+	return p.cachedProbe(cluster, true, func() (*rest.Config, error) {
+		return p.restConfig(ctx, cl, goCluster)
+	}), nil
+}
+
+// cachedProbe returns the cached probe of the cluster if it is within the cache TTL, or within the minimum
+// refresh interval on refresh, or probes it. Concurrent probes of the cluster wait for the same discovery.
+func (p *ClusterProber) cachedProbe(cluster types.NamespacedName, refresh bool, restConfig func() (*rest.Config, error)) ClusterProbe {
+	ttl := p.cacheTTL
+	if refresh && minRefreshInterval < ttl {
+		ttl = minRefreshInterval
+	}
+	p.mu.Lock()
+	probe, ok := p.cache[cluster]
+	p.mu.Unlock()
+	if ok && p.now().Sub(probe.ProbedAt) < ttl {
+		return probe
+	}
+
+	result, _, _ := p.probes.Do(cluster.String(), func() (interface{}, error) {
+		return p.probe(cluster, restConfig), nil
+	})
+
+	return result.(ClusterProbe)
+}
+
+// probe runs discovery against the cluster and caches the result
+func (p *ClusterProber) probe(cluster types.NamespacedName, restConfig func() (*rest.Config, error)) ClusterProbe {
+	probe := ClusterProbe{Cluster: cluster, ProbedAt: p.now()}
+	config, err := restConfig()
+	if err == nil {
+		err = p.discover(config, &probe)
+	}
+	if err != nil {
+		p.fetcher.log.V(logger.LogLevelDebug).Info("cluster probe failed", "cluster", cluster, "error", err.Error())
+		probe.Error = err.Error()
+	}
+
+	p.mu.Lock()
+	p.cache[cluster] = probe
+	p.mu.Unlock()
+
+	return probe
+}
+
+// discover sets the Kubernetes version and capabilities of the probe from the discovery API
+func (p *ClusterProber) discover(config *rest.Config, probe *ClusterProbe) error {
+	config = rest.CopyConfig(config)
+	config.Timeout = probeTimeout
+
+	dc, err := p.newDiscoveryClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	version, err := dc.ServerVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get server version: %w", err)
+	}
+	probe.Reachable = true
+	probe.KubernetesVersion = version.GitVersion
+
+	groups, err := dc.ServerGroups()
+	if err != nil {
+		return fmt.Errorf("failed to get server groups: %w", err)
+	}
+	probe.Capabilities = capabilities(groups)
+
+	return nil
+}
+
+// capabilities returns the capabilities supported by the API groups of a cluster
+func capabilities(groups *metav1.APIGroupList) []Capability {
+	preferredVersions := map[string]string{}
+	for _, group := range groups.Groups {
+		preferredVersions[group.Name] = group.PreferredVersion.GroupVersion
+	}
+
+	result := []Capability{}
+	for _, c := range capabilityAPIGroups {
+		capability := Capability{Name: c.name, Installed: true}
+		for _, group := range c.apiGroups {
+			version, ok := preferredVersions[group]
+			if !ok {
+				capability = Capability{Name: c.name}
+				break
+			}
+			capability.APIVersions = append(capability.APIVersions, version)
+		}
+		result = append(result, capability)
+	}
+
+	return result
+}
+
+// restConfig returns the rest config of the kubeconfig secret of a GitopsCluster
+func (p *ClusterProber) restConfig(ctx context.Context, cl client.Client, cluster gitopsv1alpha1.GitopsCluster) (*rest.Config, error) {
+	secretRef := kubeconfigSecretRef(cluster)
+	if secretRef == "" {
+		return nil, errors.New("no secret ref found")
+	}
+
+	var secret v1.Secret
+	if err := cl.Get(ctx, types.NamespacedName{Name: secretRef, Namespace: cluster.Namespace}, &secret); err != nil {
+		return nil, fmt.Errorf("unable to fetch secret %s: %w", secretRef, err)
+	}
+
+	data := kubeconfigData(secret)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no kubeconfig found in secret %s", secretRef)
+	}
+
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create kubeconfig from secret %s: %w", secretRef, err)
+	}
+
+	return restCfg, nil
+}
@@ -0,0 +1,197 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/go-logr/logr/testr"
+	. "github.com/onsi/gomega"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClusterProber(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(gitopsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		probeTestCluster("dev", "dev-kubeconfig"),
+		probeTestSecret(t, "dev-kubeconfig", "https://dev.example.com"),
+		probeTestCluster("unreachable", "unreachable-kubeconfig"),
+		probeTestSecret(t, "unreachable-kubeconfig", "https://unreachable.example.com"),
+		probeTestCluster("missing-secret", "missing-kubeconfig"),
+	).Build()
+
+	managementCluster := new(clusterfakes.FakeCluster)
+	managementCluster.GetNameReturns("management")
+	managementCluster.GetServerClientReturns(cl, nil)
+	managementCluster.GetServerConfigReturns(&rest.Config{Host: "https://management.example.com"}, nil)
+
+	var discoveries int32
+	prober := NewClusterProber(testr.New(t), managementCluster, "default", time.Minute)
+	prober.newDiscoveryClient = func(config *rest.Config) (discovery.DiscoveryInterface, error) {
+		atomic.AddInt32(&discoveries, 1)
+		switch config.Host {
+		case "https://dev.example.com":
+			return newFakeDiscovery("v1.28.2", "source.toolkit.fluxcd.io/v1", "kustomize.toolkit.fluxcd.io/v1", "helm.toolkit.fluxcd.io/v2beta1", "external-secrets.io/v1beta1"), nil
+		case "https://management.example.com":
+			return newFakeDiscovery("v1.27.1", "pac.weave.works/v2beta2"), nil
+		default:
+			return nil, errors.New("connection refused")
+		}
+	}
+	now := time.Now()
+	prober.now = func() time.Time { return now }
+
+	probes, err := prober.ProbeAll(context.Background(), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(discoveries).To(Equal(int32(3)))
+
+	g.Expect(probes).To(HaveLen(4))
+	g.Expect(probes[0].Cluster).To(Equal(types.NamespacedName{Name: "dev", Namespace: "default"}))
+	g.Expect(probes[0].Reachable).To(BeTrue())
+	g.Expect(probes[0].KubernetesVersion).To(Equal("v1.28.2"))
+	g.Expect(probes[0].Capabilities).To(Equal([]Capability{
+		{Name: CapabilityFlux, Installed: true, APIVersions: []string{"source.toolkit.fluxcd.io/v1", "kustomize.toolkit.fluxcd.io/v1", "helm.toolkit.fluxcd.io/v2beta1"}},
+		{Name: CapabilityTerraform},
+		{Name: CapabilityPolicyAgent},
+		{Name: CapabilityExternalSecrets, Installed: true, APIVersions: []string{"external-secrets.io/v1beta1"}},
+	}))
+	g.Expect(probes[1].Cluster).To(Equal(types.NamespacedName{Name: "missing-secret", Namespace: "default"}))
+	g.Expect(probes[1].Reachable).To(BeFalse())
+	g.Expect(probes[1].Error).To(ContainSubstring("unable to fetch secret missing-kubeconfig"))
+	g.Expect(probes[2].Cluster).To(Equal(types.NamespacedName{Name: "unreachable", Namespace: "default"}))
+	g.Expect(probes[2].Error).To(ContainSubstring("connection refused"))
+	g.Expect(probes[3].Cluster).To(Equal(types.NamespacedName{Name: "management"}))
+	g.Expect(probes[3].KubernetesVersion).To(Equal("v1.27.1"))
+	g.Expect(probes[3].Capabilities[2]).To(Equal(Capability{Name: CapabilityPolicyAgent, Installed: true, APIVersions: []string{"pac.weave.works/v2beta2"}}))
+
+	// Cached probes don't run discovery
+	_, err = prober.ProbeAll(context.Background(), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(discoveries).To(Equal(int32(3)))
+
+	// Refreshes within the minimum refresh interval don't run discovery
+	_, err = prober.ProbeAll(context.Background(), true, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(discoveries).To(Equal(int32(3)))
+
+	// Expired probes run discovery again
+	now = now.Add(2 * time.Minute)
+	_, err = prober.ProbeAll(context.Background(), false, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(discoveries).To(Equal(int32(6)))
+
+	// Only the visible clusters are probed
+	now = now.Add(minRefreshInterval)
+	probes, err = prober.ProbeAll(context.Background(), true, func(cluster types.NamespacedName) bool {
+		return cluster.Name == "dev"
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(probes).To(HaveLen(1))
+	g.Expect(probes[0].Cluster).To(Equal(types.NamespacedName{Name: "dev", Namespace: "default"}))
+	g.Expect(discoveries).To(Equal(int32(7)))
+
+	// Probing a cluster runs discovery past the minimum refresh interval
+	now = now.Add(minRefreshInterval)
+	probe, err := prober.Probe(context.Background(), types.NamespacedName{Name: "dev", Namespace: "default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(probe.Reachable).To(BeTrue())
+	g.Expect(discoveries).To(Equal(int32(8)))
+
+	_, err = prober.Probe(context.Background(), types.NamespacedName{Name: "prod", Namespace: "default"})
+	g.Expect(errors.Is(err, ErrClusterNotFound)).To(BeTrue())
+}
+
+func TestClusterProber_concurrentProbes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	managementCluster := new(clusterfakes.FakeCluster)
+	managementCluster.GetNameReturns("management")
+	managementCluster.GetServerConfigReturns(&rest.Config{Host: "https://management.example.com"}, nil)
+
+	var discoveries int32
+	prober := NewClusterProber(testr.New(t), managementCluster, "default", time.Minute)
+	prober.newDiscoveryClient = func(config *rest.Config) (discovery.DiscoveryInterface, error) {
+		atomic.AddInt32(&discoveries, 1)
+		time.Sleep(100 * time.Millisecond)
+		return newFakeDiscovery("v1.27.1"), nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := prober.Probe(context.Background(), types.NamespacedName{Name: "management"})
+			g.Expect(err).NotTo(HaveOccurred())
+		}()
+	}
+	wg.Wait()
+
+	// Concurrent probes of a cluster share the same discovery
+	g.Expect(discoveries).To(Equal(int32(1)))
+}
+
+func newFakeDiscovery(gitVersion string, groupVersions ...string) *fakediscovery.FakeDiscovery {
+	resources := []*metav1.APIResourceList{}
+	for _, groupVersion := range groupVersions {
+		resources = append(resources, &metav1.APIResourceList{GroupVersion: groupVersion})
+	}
+
+	return &fakediscovery.FakeDiscovery{
+		Fake:               &k8stesting.Fake{Resources: resources},
+		FakedServerVersion: &version.Info{GitVersion: gitVersion},
+	}
+}
+
+func probeTestCluster(name, secretName string) *gitopsv1alpha1.GitopsCluster {
+	return &gitopsv1alpha1.GitopsCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: gitopsv1alpha1.GitopsClusterSpec{
+			SecretRef: &meta.LocalObjectReference{Name: secretName},
+		},
+	}
+}
+
+func probeTestSecret(t *testing.T, name, server string) *corev1.Secret {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: server}
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "token"}
+	config.Contexts["cluster"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+	config.CurrentContext = "cluster"
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"value": data},
+	}
+}
@@ -0,0 +1,86 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
+)
+
+// ErrInvalidClusterSelector is returned when a cluster selector cannot be parsed.
+var ErrInvalidClusterSelector = errors.New("invalid cluster selector")
+
+// ClusterSelector matches the clusters whose GitopsCluster labels match a label selector.
+// A nil ClusterSelector matches all the clusters.
+type ClusterSelector struct {
+	clusters map[string]bool
+}
+
+// ParseClusterSelector parses a label selector on the labels of the GitopsClusters, in the
+// Kubernetes selector syntax, e.g. "env=prod,region in (eu-west-1,eu-west-2)".
+func ParseClusterSelector(selector string) (labels.Selector, error) {
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %s", ErrInvalidClusterSelector, selector, err)
+	}
+
+	return parsed, nil
+}
+
+// NewClusterSelector returns the ClusterSelector of the management cluster and the GitopsClusters
+// matching a label selector. The management cluster has no labels.
+func NewClusterSelector(selector labels.Selector, managementCluster string, clusters []gitopsv1alpha1.GitopsCluster) *ClusterSelector {
+	matching := map[string]bool{}
+	if selector.Matches(labels.Set{}) {
+		matching[managementCluster] = true
+	}
+	for _, c := range clusters {
+		if selector.Matches(labels.Set(c.Labels)) {
+			matching[ToClusterName(types.NamespacedName{Name: c.Name, Namespace: c.Namespace})] = true
+		}
+	}
+
+	return &ClusterSelector{clusters: matching}
+}
+
+// SelectClusters returns the ClusterSelector of the GitopsClusters that the user can list on the
+// management cluster matching a label selector, or nil if the selector is empty. The GitopsClusters
+// of the namespaces that fail to be listed are not matched.
+func SelectClusters(ctx context.Context, managementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher, managementCluster, selector string) (*ClusterSelector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+
+	parsed, err := ParseClusterSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	namespacedLists, err := managementFetcher.Fetch(ctx, "GitopsCluster", func() client.ObjectList {
+		return &gitopsv1alpha1.GitopsClusterList{}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query clusters: %w", err)
+	}
+
+	clusters := []gitopsv1alpha1.GitopsCluster{}
+	for _, namespacedList := range namespacedLists {
+		if namespacedList.Error != nil {
+			continue
+		}
+		clusters = append(clusters, namespacedList.List.(*gitopsv1alpha1.GitopsClusterList).Items...)
+	}
+
+	return NewClusterSelector(parsed, managementCluster, clusters), nil
+}
+
+// Matches returns true if the cluster, named as in the clusters manager, is selected.
+func (s *ClusterSelector) Matches(cluster string) bool {
+	return s == nil || s.clusters[cluster]
+}
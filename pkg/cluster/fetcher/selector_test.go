@@ -0,0 +1,71 @@
+package fetcher_test
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClusterSelector(t *testing.T) {
+	clusters := []gitopsv1alpha1.GitopsCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: "default", Labels: map[string]string{"env": "prod"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev", Namespace: "default", Labels: map[string]string{"env": "dev"}}},
+	}
+
+	testCases := []struct {
+		selector string
+		matching []string
+		excluded []string
+	}{
+		{
+			selector: "env=prod",
+			matching: []string{"default/prod"},
+			excluded: []string{"management", "default/dev"},
+		},
+		{
+			selector: "env!=prod",
+			matching: []string{"management", "default/dev"},
+			excluded: []string{"default/prod"},
+		},
+		{
+			selector: "env",
+			matching: []string{"default/prod", "default/dev"},
+			excluded: []string{"management"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.selector, func(t *testing.T) {
+			g := NewWithT(t)
+
+			selector, err := fetcher.ParseClusterSelector(tt.selector)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			clusterSelector := fetcher.NewClusterSelector(selector, "management", clusters)
+			for _, c := range tt.matching {
+				g.Expect(clusterSelector.Matches(c)).To(BeTrue(), c)
+			}
+			for _, c := range tt.excluded {
+				g.Expect(clusterSelector.Matches(c)).To(BeFalse(), c)
+			}
+		})
+	}
+
+	t.Run("nil selector matches all", func(t *testing.T) {
+		g := NewWithT(t)
+
+		var clusterSelector *fetcher.ClusterSelector
+		g.Expect(clusterSelector.Matches("default/dev")).To(BeTrue())
+	})
+
+	t.Run("invalid selector", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := fetcher.ParseClusterSelector("env in")
+		g.Expect(errors.Is(err, fetcher.ErrInvalidClusterSelector)).To(BeTrue())
+	})
+}
@@ -3,6 +3,7 @@ package namespaces
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	clustersv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
 	gitopssetsv1alpha1 "github.com/weaveworks/gitopssets-controller/api/v1alpha1"
@@ -55,29 +56,53 @@ type ruleCache struct {
 	resources map[string]struct{}
 }
 
+// buildConcurrency limits the concurrent SelfSubjectRulesReviews when building a user cache
+const buildConcurrency = 10
+
 func buildCache(ctx context.Context, client typedauth.AuthorizationV1Interface, namespaces []*v1.Namespace) (map[string][]string, error) {
+	// The reviews of the namespaces run concurrently, their rules are kept in the namespaces order
+	namespacesRules := make([][]ruleCache, len(namespaces))
+	errs := make([]error, len(namespaces))
+	sem := make(chan struct{}, buildConcurrency)
+	var wg sync.WaitGroup
+	for i := range namespaces {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			namespace := namespaces[i]
+			reviewReqeust := &authv1.SelfSubjectRulesReview{
+				Spec: authv1.SelfSubjectRulesReviewSpec{
+					Namespace: namespace.Name,
+				},
+			}
+
+			review, err := client.SelfSubjectRulesReviews().Create(ctx, reviewReqeust, metav1.CreateOptions{})
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to get user rules for namespace %s: %w", namespace.Name, err)
+				return
+			}
+
+			namespacesRules[i] = buildRules(review.Status.ResourceRules)
+		}(i)
+	}
+	wg.Wait()
+
 	resourceNamespaces := make(map[string][]string)
 	for _, requiredResource := range requiredResources {
 		// make sure each resource is set in map
 		resourceNamespaces[requiredResource.Kind] = []string{}
 	}
-	for i := range namespaces {
-		namespace := namespaces[i]
-		reviewReqeust := &authv1.SelfSubjectRulesReview{
-			Spec: authv1.SelfSubjectRulesReviewSpec{
-				Namespace: namespace.Name,
-			},
+	for i, namespace := range namespaces {
+		if errs[i] != nil {
+			return nil, errs[i]
 		}
 
-		review, err := client.SelfSubjectRulesReviews().Create(ctx, reviewReqeust, metav1.CreateOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get user rules for namespace %s: %w", namespace.Name, err)
-		}
-
-		rulesCache := buildRules(review.Status.ResourceRules)
-
 		for _, requiredResource := range requiredResources {
-			if findRequiredResource(requiredResource, rulesCache) {
+			if findRequiredResource(requiredResource, namespacesRules[i]) {
 				resourceNamespaces[requiredResource.Kind] = append(resourceNamespaces[requiredResource.Kind], namespace.Name)
 			}
 		}
@@ -0,0 +1,130 @@
+package namespaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// warmDelay batches the invalidations of bursts of RBAC changes, e.g. when a chart is installed
+const warmDelay = 2 * time.Second
+
+// minInvalidationWarmInterval limits how often the caches are warmed after invalidations, so that
+// frequent RBAC changes don't rebuild the caches of the active users continuously
+const minInvalidationWarmInterval = 15 * time.Second
+
+// RegisterRBACInvalidation invalidates the users caches when Roles, ClusterRoles, RoleBindings,
+// ClusterRoleBindings or Namespaces change, registering their informers in the shared informer
+// factory.
+func RegisterRBACInvalidation(factory informers.SharedInformerFactory, usersNamespaces *UsersResourcesNamespaces) error {
+	rbac := factory.Rbac().V1()
+	resourceInformers := map[string]cache.SharedIndexInformer{
+		"roles":               rbac.Roles().Informer(),
+		"clusterroles":        rbac.ClusterRoles().Informer(),
+		"rolebindings":        rbac.RoleBindings().Informer(),
+		"clusterrolebindings": rbac.ClusterRoleBindings().Informer(),
+		"namespaces":          factory.Core().V1().Namespaces().Informer(),
+	}
+
+	for resource, informer := range resourceInformers {
+		if _, err := informer.AddEventHandler(invalidationHandler(usersNamespaces)); err != nil {
+			return fmt.Errorf("failed to add invalidation event handler for %s: %w", resource, err)
+		}
+	}
+
+	return nil
+}
+
+// invalidationHandler invalidates the caches on the changes of the objects of an informer, ignoring
+// its initial list and resyncs
+func invalidationHandler(usersNamespaces *UsersResourcesNamespaces) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				usersNamespaces.Invalidate()
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldMeta, err := meta.Accessor(oldObj)
+			if err != nil {
+				return
+			}
+			newMeta, err := meta.Accessor(newObj)
+			if err != nil {
+				return
+			}
+			if oldMeta.GetResourceVersion() != newMeta.GetResourceVersion() {
+				usersNamespaces.Invalidate()
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			usersNamespaces.Invalidate()
+		},
+	}
+}
+
+// Warmer builds the caches of the recently active users after they are invalidated, so that their
+// next requests don't wait for them. The caches that expire are built on the next request instead.
+type Warmer struct {
+	log             logr.Logger
+	usersNamespaces *UsersResourcesNamespaces
+	listNamespaces  func() ([]*v1.Namespace, error)
+}
+
+// NewWarmer creates a Warmer of the users caches for the namespaces listed.
+func NewWarmer(log logr.Logger, usersNamespaces *UsersResourcesNamespaces, listNamespaces func() ([]*v1.Namespace, error)) *Warmer {
+	return &Warmer{
+		log:             log.WithName("users-namespaces-warmer"),
+		usersNamespaces: usersNamespaces,
+		listNamespaces:  listNamespaces,
+	}
+}
+
+// Start warms the caches after invalidations until the context is done. The warms are delayed to
+// batch the invalidations, and to keep the minimum invalidation warm interval since the last warm.
+func (w *Warmer) Start(ctx context.Context) {
+	var lastWarm time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.usersNamespaces.Invalidated():
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(invalidationWarmDelay(time.Since(lastWarm))):
+			}
+		}
+
+		w.warm(ctx)
+		lastWarm = time.Now()
+	}
+}
+
+// invalidationWarmDelay returns how long to wait to warm the caches after an invalidation, given the
+// time since the last warm
+func invalidationWarmDelay(sinceLastWarm time.Duration) time.Duration {
+	if delay := minInvalidationWarmInterval - sinceLastWarm; delay > warmDelay {
+		return delay
+	}
+	return warmDelay
+}
+
+func (w *Warmer) warm(ctx context.Context) {
+	namespaces, err := w.listNamespaces()
+	if err != nil {
+		w.log.Error(err, "failed to list namespaces")
+		return
+	}
+
+	for userID, err := range w.usersNamespaces.Warm(ctx, namespaces) {
+		w.log.V(logger.LogLevelDebug).Info("failed to warm user cache", "user", userID, "error", err.Error())
+	}
+}
@@ -0,0 +1,86 @@
+package namespaces
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestInvalidationHandler(t *testing.T) {
+	role := func(resourceVersion string) *rbacv1.Role {
+		return &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "test-ns", ResourceVersion: resourceVersion}}
+	}
+
+	testCases := []struct {
+		name        string
+		event       func(h cache.ResourceEventHandler)
+		invalidated bool
+	}{
+		{
+			name: "add",
+			event: func(h cache.ResourceEventHandler) {
+				h.OnAdd(role("1"), false)
+			},
+			invalidated: true,
+		},
+		{
+			name: "update",
+			event: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(role("1"), role("2"))
+			},
+			invalidated: true,
+		},
+		{
+			name: "resync",
+			event: func(h cache.ResourceEventHandler) {
+				h.OnUpdate(role("1"), role("1"))
+			},
+		},
+		{
+			name: "delete",
+			event: func(h cache.ResourceEventHandler) {
+				h.OnDelete(role("1"))
+			},
+			invalidated: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewUsersResourcesNamespaces()
+			h := invalidationHandler(u)
+
+			tt.event(h)
+
+			assert.Equal(t, tt.invalidated, u.generation > 0)
+		})
+	}
+}
+
+func TestInvalidationHandler_IgnoresInitialList(t *testing.T) {
+	u := NewUsersResourcesNamespaces()
+	h := invalidationHandler(u)
+
+	h.OnAdd(&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "role", Namespace: "test-ns"}}, true)
+
+	assert.Zero(t, u.generation)
+}
+
+func TestInvalidationWarmDelay(t *testing.T) {
+	assert.Equal(t, minInvalidationWarmInterval, invalidationWarmDelay(0))
+	assert.Equal(t, 5*time.Second, invalidationWarmDelay(minInvalidationWarmInterval-5*time.Second))
+	assert.Equal(t, warmDelay, invalidationWarmDelay(minInvalidationWarmInterval))
+	assert.Equal(t, warmDelay, invalidationWarmDelay(time.Hour))
+}
+
+func TestRegisterRBACInvalidation(t *testing.T) {
+	factory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+
+	assert.NoError(t, RegisterRBACInvalidation(factory, NewUsersResourcesNamespaces()))
+}
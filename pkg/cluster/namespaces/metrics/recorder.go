@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	usersNamespacesSubsystem = "users_namespaces_cache"
+
+	HitLabel  = "hit"
+	MissLabel = "miss"
+
+	FailedLabel  = "error"
+	SuccessLabel = "success"
+)
+
+var cacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Subsystem: usersNamespacesSubsystem,
+	Name:      "requests_total",
+	Help:      "number of lookups of the namespaces a user can access, by hit or miss",
+}, []string{"result"})
+
+var buildLatencyHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Subsystem: usersNamespacesSubsystem,
+	Name:      "build_latency_seconds",
+	Help:      "latency of building the namespaces a user can access",
+	Buckets:   prometheus.ExponentialBuckets(0.01, 2, 10),
+}, []string{"status"})
+
+var invalidations = prometheus.NewCounter(prometheus.CounterOpts{
+	Subsystem: usersNamespacesSubsystem,
+	Name:      "invalidations_total",
+	Help:      "number of invalidations of the cache on RBAC or namespace changes",
+})
+
+var activeUsers = prometheus.NewGauge(prometheus.GaugeOpts{
+	Subsystem: usersNamespacesSubsystem,
+	Name:      "active_users",
+	Help:      "number of recently active users whose cache is warmed",
+})
+
+func init() {
+	prometheus.MustRegister(cacheRequests)
+	prometheus.MustRegister(buildLatencyHistogram)
+	prometheus.MustRegister(invalidations)
+	prometheus.MustRegister(activeUsers)
+}
+
+// CacheRequest counts a lookup of the cache with its result, hit or miss
+func CacheRequest(result string) {
+	cacheRequests.WithLabelValues(result).Inc()
+}
+
+func BuildSetLatency(status string, duration time.Duration) {
+	buildLatencyHistogram.WithLabelValues(status).Observe(duration.Seconds())
+}
+
+func CacheInvalidated() {
+	invalidations.Inc()
+}
+
+func SetActiveUsers(number int) {
+	activeUsers.Set(float64(number))
+}
@@ -3,33 +3,71 @@ package namespaces
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/cheshir/ttlcache"
 	v1 "k8s.io/api/core/v1"
 	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/namespaces/metrics"
 )
 
 const (
 	cacheTTL            = time.Minute
 	cacheResolutionTime = 30 * time.Second
+	// activeUserTTL is how long a user is warmed after their last request, their cache is only
+	// invalidated within it as it expires afterwards
+	activeUserTTL = cacheTTL
+	// maxWarmedUsers limits the caches of the users built on warm, the others are built on their
+	// next request
+	maxWarmedUsers = 50
+	// maxBuildAttempts limits the rebuilds of a cache invalidated while it was built
+	maxBuildAttempts = 3
+	// warmConcurrency limits the caches of the users built at the same time on warm
+	warmConcurrency = 5
 )
 
 type UsersResourcesNamespaces struct {
 	cache *ttlcache.Cache
+	now   func() time.Time
+
+	mu sync.Mutex
+	// generation is incremented on every invalidation, so that caches built before are discarded
+	generation  uint64
+	activeUsers map[string]*activeUser
+	invalidated chan struct{}
+}
+
+// activeUser is a user whose cache is warmed, with the client their cache was built with
+type activeUser struct {
+	client     typedauth.AuthorizationV1Interface
+	lastActive time.Time
 }
 
 func NewUsersResourcesNamespaces() *UsersResourcesNamespaces {
 	return &UsersResourcesNamespaces{
-		cache: ttlcache.New(cacheResolutionTime),
+		cache:       ttlcache.New(cacheResolutionTime),
+		now:         time.Now,
+		activeUsers: map[string]*activeUser{},
+		invalidated: make(chan struct{}, 1),
 	}
 }
 
 func (n *UsersResourcesNamespaces) Get(userID, kind string) ([]string, bool) {
+	n.mu.Lock()
+	if user, ok := n.activeUsers[userID]; ok {
+		user.lastActive = n.now()
+	}
+	n.mu.Unlock()
+
 	if val, found := n.cache.Get(n.cacheKey(userID, kind)); found {
+		metrics.CacheRequest(metrics.HitLabel)
 		return val.([]string), true
 	}
 
+	metrics.CacheRequest(metrics.MissLabel)
 	return nil, false
 }
 
@@ -41,14 +79,124 @@ func (n *UsersResourcesNamespaces) cacheKey(userID, kind string) uint64 {
 	return ttlcache.StringKey(fmt.Sprintf("%s:%s", userID, kind))
 }
 
+// Build builds the cache of a user, who is then considered active and their cache warmed.
 func (n *UsersResourcesNamespaces) Build(ctx context.Context, userID string, client typedauth.AuthorizationV1Interface, namespaces []*v1.Namespace) error {
-	resourceNamespaces, err := buildCache(ctx, client, namespaces)
-	if err != nil {
+	if err := n.build(ctx, userID, client, namespaces); err != nil {
 		return err
 	}
 
-	for kind, nsList := range resourceNamespaces {
-		n.Set(userID, kind, nsList)
-	}
+	n.mu.Lock()
+	n.activeUsers[userID] = &activeUser{client: client, lastActive: n.now()}
+	metrics.SetActiveUsers(len(n.activeUsers))
+	n.mu.Unlock()
+
 	return nil
 }
+
+// build builds the cache of a user, again if it is invalidated meanwhile as the RBAC changed
+func (n *UsersResourcesNamespaces) build(ctx context.Context, userID string, client typedauth.AuthorizationV1Interface, namespaces []*v1.Namespace) error {
+	for attempt := 1; ; attempt++ {
+		n.mu.Lock()
+		generation := n.generation
+		n.mu.Unlock()
+
+		start := time.Now()
+		resourceNamespaces, err := buildCache(ctx, client, namespaces)
+		if err != nil {
+			metrics.BuildSetLatency(metrics.FailedLabel, time.Since(start))
+			return err
+		}
+		metrics.BuildSetLatency(metrics.SuccessLabel, time.Since(start))
+
+		n.mu.Lock()
+		if generation == n.generation || attempt == maxBuildAttempts {
+			for kind, nsList := range resourceNamespaces {
+				n.Set(userID, kind, nsList)
+			}
+			n.mu.Unlock()
+			return nil
+		}
+		n.mu.Unlock()
+	}
+}
+
+// Invalidate removes the caches of all the users, and notifies the warmer to build those of the
+// recently active users again.
+func (n *UsersResourcesNamespaces) Invalidate() {
+	n.mu.Lock()
+	n.generation++
+	n.cache.Clear()
+	n.mu.Unlock()
+
+	metrics.CacheInvalidated()
+
+	select {
+	case n.invalidated <- struct{}{}:
+	default:
+		// a warm is already pending
+	}
+}
+
+// Invalidated notifies the invalidations of the cache.
+func (n *UsersResourcesNamespaces) Invalidated() <-chan struct{} {
+	return n.invalidated
+}
+
+// Warm builds the caches of the most recently active users, up to the max warmed users, concurrently
+// and forgets the users inactive for the active user TTL. It returns the errors of the users whose
+// cache failed to build, who are forgotten too.
+func (n *UsersResourcesNamespaces) Warm(ctx context.Context, namespaces []*v1.Namespace) map[string]error {
+	n.mu.Lock()
+	activeIDs := []string{}
+	for userID, user := range n.activeUsers {
+		if n.now().Sub(user.lastActive) > activeUserTTL {
+			delete(n.activeUsers, userID)
+			continue
+		}
+		activeIDs = append(activeIDs, userID)
+	}
+	sort.Slice(activeIDs, func(i, j int) bool {
+		return n.activeUsers[activeIDs[i]].lastActive.After(n.activeUsers[activeIDs[j]].lastActive)
+	})
+	if len(activeIDs) > maxWarmedUsers {
+		activeIDs = activeIDs[:maxWarmedUsers]
+	}
+	users := map[string]typedauth.AuthorizationV1Interface{}
+	for _, userID := range activeIDs {
+		users[userID] = n.activeUsers[userID].client
+	}
+	metrics.SetActiveUsers(len(n.activeUsers))
+	n.mu.Unlock()
+
+	errs := map[string]error{}
+	var errsMu sync.Mutex
+	sem := make(chan struct{}, warmConcurrency)
+	var wg sync.WaitGroup
+	for userID, client := range users {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(userID string, client typedauth.AuthorizationV1Interface) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := n.build(ctx, userID, client, namespaces); err != nil {
+				errsMu.Lock()
+				errs[userID] = err
+				errsMu.Unlock()
+			}
+		}(userID, client)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		n.mu.Lock()
+		for userID := range errs {
+			delete(n.activeUsers, userID)
+		}
+		metrics.SetActiveUsers(len(n.activeUsers))
+		n.mu.Unlock()
+	}
+
+	return errs
+}
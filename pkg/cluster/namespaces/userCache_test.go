@@ -2,7 +2,10 @@ package namespaces
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	authv1 "k8s.io/api/authorization/v1"
@@ -52,3 +55,114 @@ func TestUserCacheBuild(t *testing.T) {
 	assert.Equal(t, gotnsList, namespaces)
 
 }
+
+func TestUserCacheInvalidate(t *testing.T) {
+	cli := fake.NewSimpleClientset()
+	cli.PrependReactor("create", "selfsubjectrulesreviews", reviewReactor("gitopsclusters"))
+	nsList := []*v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}}}
+
+	u := NewUsersResourcesNamespaces()
+	assert.NoError(t, u.Build(context.Background(), "id", cli.AuthorizationV1(), nsList))
+
+	u.Invalidate()
+
+	_, found := u.Get("id", "GitopsCluster")
+	assert.False(t, found)
+
+	select {
+	case <-u.Invalidated():
+	default:
+		t.Fatal("the invalidation was not notified")
+	}
+}
+
+func TestUserCacheWarm(t *testing.T) {
+	cli := fake.NewSimpleClientset()
+	resource := "gitopsclusters"
+	cli.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return reviewReactor(resource)(action)
+	})
+	failingCli := fake.NewSimpleClientset()
+	failingCli.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("review failed")
+	})
+	nsList := []*v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}}}
+
+	now := time.Now()
+	u := NewUsersResourcesNamespaces()
+	u.now = func() time.Time { return now }
+
+	assert.NoError(t, u.Build(context.Background(), "active", cli.AuthorizationV1(), nsList))
+	assert.NoError(t, u.Build(context.Background(), "inactive", cli.AuthorizationV1(), nsList))
+	assert.NoError(t, u.Build(context.Background(), "failing", failingCli.AuthorizationV1(), []*v1.Namespace{}))
+
+	now = now.Add(activeUserTTL)
+	u.Get("active", "GitopsCluster")
+	u.Get("failing", "GitopsCluster")
+	now = now.Add(time.Minute)
+
+	// the permissions changed
+	resource = "pipelines"
+	u.Invalidate()
+
+	errs := u.Warm(context.Background(), nsList)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs, "failing")
+
+	got, found := u.Get("active", "Pipeline")
+	assert.True(t, found)
+	assert.Equal(t, []string{"test-ns"}, got)
+	got, found = u.Get("active", "GitopsCluster")
+	assert.True(t, found)
+	assert.Empty(t, got)
+
+	_, found = u.Get("inactive", "Pipeline")
+	assert.False(t, found)
+	assert.NotContains(t, u.activeUsers, "inactive")
+	assert.NotContains(t, u.activeUsers, "failing")
+	assert.Contains(t, u.activeUsers, "active")
+}
+
+func TestUserCacheWarm_MaxWarmedUsers(t *testing.T) {
+	cli := fake.NewSimpleClientset()
+	cli.PrependReactor("create", "selfsubjectrulesreviews", reviewReactor("gitopsclusters"))
+	nsList := []*v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: "test-ns"}}}
+
+	now := time.Now()
+	u := NewUsersResourcesNamespaces()
+	u.now = func() time.Time { return now }
+
+	for i := 0; i <= maxWarmedUsers; i++ {
+		assert.NoError(t, u.Build(context.Background(), fmt.Sprintf("user-%d", i), cli.AuthorizationV1(), nsList))
+		now = now.Add(time.Millisecond)
+	}
+
+	u.Invalidate()
+
+	assert.Empty(t, u.Warm(context.Background(), nsList))
+
+	// the least recently active user is built on their next request
+	_, found := u.Get("user-0", "GitopsCluster")
+	assert.False(t, found)
+	assert.Contains(t, u.activeUsers, "user-0")
+	_, found = u.Get("user-1", "GitopsCluster")
+	assert.True(t, found)
+	_, found = u.Get(fmt.Sprintf("user-%d", maxWarmedUsers), "GitopsCluster")
+	assert.True(t, found)
+}
+
+func reviewReactor(resource string) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &authv1.SelfSubjectRulesReview{
+			Status: authv1.SubjectRulesReviewStatus{
+				ResourceRules: []authv1.ResourceRule{
+					{
+						Verbs:     []string{"list", "get"},
+						APIGroups: []string{"gitops.weave.works", "pipelines.weave.works"},
+						Resources: []string{resource},
+					},
+				},
+			},
+		}, nil
+	}
+}
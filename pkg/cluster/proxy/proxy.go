@@ -0,0 +1,223 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	gitopsv1alpha1 "github.com/weaveworks/cluster-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	mngrcluster "github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/rest"
+)
+
+// DefaultPathPrefix is the path the proxy is served on, followed by the namespace and name of the
+// GitopsCluster and the path of the Kubernetes API request, e.g.
+// /v1/cluster-proxy/default/leaf-1/api/v1/namespaces.
+const DefaultPathPrefix = "/v1/cluster-proxy/"
+
+// Handler proxies the Kubernetes API requests of the authenticated users to the GitopsClusters.
+// The requests impersonate the user, as the clients of the clusters manager do, so the RBAC of the
+// leaf cluster applies. Users must be able to get the GitopsCluster on the management cluster.
+// Every request is audit logged.
+type Handler struct {
+	log               logr.Logger
+	clustersManager   clustersmngr.ClustersManager
+	managementCluster string
+	userPrefixes      kube.UserPrefixes
+	pathPrefix        string
+	newTransport      func(*rest.Config) (http.RoundTripper, error)
+}
+
+// NewHandler creates a Handler for the clusters of the clusters manager served on the path prefix.
+func NewHandler(log logr.Logger, clustersManager clustersmngr.ClustersManager, managementCluster string, userPrefixes kube.UserPrefixes, pathPrefix string) *Handler {
+	return &Handler{
+		log:               log.WithName("cluster-proxy"),
+		clustersManager:   clustersManager,
+		managementCluster: managementCluster,
+		userPrefixes:      userPrefixes,
+		pathPrefix:        pathPrefix,
+		newTransport:      rest.TransportFor,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+	principal := auth.Principal(r.Context())
+	cluster, apiPath, ok := parsePath(strings.TrimPrefix(r.URL.Path, h.pathPrefix))
+
+	defer func() {
+		h.audit(principal, cluster, r, apiPath, rw.status, time.Since(start))
+	}()
+
+	if principal == nil || !principal.Valid() {
+		http.Error(rw, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !ok {
+		http.Error(rw, "the path must be the namespace and name of a cluster followed by the Kubernetes API path", http.StatusNotFound)
+		return
+	}
+	if httpstream.IsUpgradeRequest(r) {
+		http.Error(rw, "upgrade requests such as exec, attach and port-forward are not supported by the cluster proxy", http.StatusNotImplemented)
+		return
+	}
+
+	config, status, err := h.clusterConfig(r, principal, cluster)
+	if err != nil {
+		http.Error(rw, err.Error(), status)
+		return
+	}
+
+	proxy, err := h.reverseProxy(config, apiPath)
+	if err != nil {
+		h.log.Error(err, "failed to create cluster proxy", "cluster", cluster)
+		http.Error(rw, fmt.Sprintf("failed to proxy to cluster %s", cluster), http.StatusInternalServerError)
+		return
+	}
+
+	proxy.ServeHTTP(rw, r)
+}
+
+// clusterConfig returns the rest config of the cluster impersonating the user, if they can get the
+// GitopsCluster, or the status code of the error.
+func (h *Handler) clusterConfig(r *http.Request, principal *auth.UserPrincipal, cluster types.NamespacedName) (*rest.Config, int, error) {
+	var management, leaf mngrcluster.Cluster
+	for _, c := range h.clustersManager.GetClusters() {
+		switch c.GetName() {
+		case h.managementCluster:
+			management = c
+		case cluster.String():
+			leaf = c
+		}
+	}
+	if management == nil {
+		return nil, http.StatusServiceUnavailable, fmt.Errorf("management cluster %s not found", h.managementCluster)
+	}
+
+	cl, err := management.GetUserClient(principal)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to create client for the management cluster: %w", err)
+	}
+	if err := cl.Get(r.Context(), cluster, &gitopsv1alpha1.GitopsCluster{}); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			return nil, http.StatusNotFound, fmt.Errorf("cluster %s not found", cluster)
+		}
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to get GitopsCluster %s: %w", cluster, err)
+	}
+	if leaf == nil {
+		return nil, http.StatusServiceUnavailable, fmt.Errorf("cluster %s is not connected", cluster)
+	}
+
+	config, err := leaf.GetServerConfig()
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("failed to get config of cluster %s: %w", cluster, err)
+	}
+
+	return kube.ConfigWithPrincipal(principal, config, h.userPrefixes), http.StatusOK, nil
+}
+
+// reverseProxy returns a proxy sending the request to the API path of the cluster, with the
+// credentials and impersonation of the config instead of those of the request.
+func (h *Handler) reverseProxy(config *rest.Config, apiPath string) (*httputil.ReverseProxy, error) {
+	target, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster host %q: %w", config.Host, err)
+	}
+	if target.Scheme == "" {
+		target.Scheme = "https"
+	}
+
+	transport, err := h.newTransport(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transport: %w", err)
+	}
+
+	return &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			req.URL.Path = strings.TrimSuffix(target.Path, "/") + apiPath
+			req.URL.RawPath = ""
+			req.Host = target.Host
+			// The credentials of Weave GitOps and any impersonation requested by the user are not
+			// forwarded, the transport authenticates and impersonates the user.
+			req.Header.Del("Authorization")
+			req.Header.Del("Cookie")
+			for name := range req.Header {
+				if strings.HasPrefix(name, "Impersonate-") {
+					req.Header.Del(name)
+				}
+			}
+		},
+		Transport: transport,
+		// Stream the responses of watch requests
+		FlushInterval: -1,
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			h.log.Error(err, "cluster proxy request failed", "host", target.Host, "path", apiPath)
+			http.Error(w, "failed to reach the cluster", http.StatusBadGateway)
+		},
+	}, nil
+}
+
+// audit logs who made a request to which cluster, and its outcome
+func (h *Handler) audit(principal *auth.UserPrincipal, cluster types.NamespacedName, r *http.Request, apiPath string, status int, duration time.Duration) {
+	user, groups := "", []string{}
+	if principal != nil {
+		user, groups = principal.ID, principal.Groups
+	}
+
+	h.log.Info("cluster proxy request",
+		"user", user,
+		"groups", groups,
+		"cluster", cluster.String(),
+		"method", r.Method,
+		"path", apiPath,
+		"query", r.URL.RawQuery,
+		"status", status,
+		"duration", duration.String(),
+	)
+}
+
+// parsePath splits the path of a proxied request into the GitopsCluster and the Kubernetes API path.
+func parsePath(path string) (types.NamespacedName, string, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return types.NamespacedName{}, "", false
+	}
+
+	apiPath := "/"
+	if len(parts) == 3 {
+		apiPath += parts[2]
+	}
+
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, apiPath, true
+}
+
+// statusRecorder records the status code of a response for the audit log
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -187,6 +187,40 @@ func (p *AzureDevOpsProvider) ListPullRequests(ctx context.Context, repoURL stri
 	return prs, nil
 }
 
+func (p *AzureDevOpsProvider) ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*CommitInfo, error) {
+	repoURL, err := GetGitProviderUrl(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get git provider url: %w", err)
+	}
+
+	// At this point GetGitProviderUrl should fail if it's not a valid URL.
+	u, _ := url.Parse(repoURL)
+	jsmc := JenkinsSCM{}
+
+	repo, err := jsmc.GetRepository(ctx, p.log, p.client, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find repository: %w", err)
+	}
+
+	commitList, _, err := p.client.Git.ListCommits(ctx, repo.FullName, scm.CommitListOptions{Ref: branch, Page: page, Size: perPage})
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []*CommitInfo{}
+	for _, c := range commitList {
+		commits = append(commits, &CommitInfo{
+			SHA:       c.Sha,
+			Author:    c.Author.Name,
+			Message:   c.Message,
+			CreatedAt: c.Author.Date,
+			Link:      c.Link,
+		})
+	}
+
+	return commits, nil
+}
+
 func (p *AzureDevOpsProvider) sendRawRequest(ctx context.Context, request *scm.Request, response interface{}) (*scm.Response, error) {
 	resp, err := p.client.Do(ctx, request)
 	if err != nil {
@@ -209,3 +243,26 @@ func (p *AzureDevOpsProvider) sendRawRequest(ctx context.Context, request *scm.R
 
 	return resp, nil
 }
+
+func (p *AzureDevOpsProvider) GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error) {
+	repoURL, err := GetGitProviderUrl(repoURL)
+	if err != nil {
+		return "", fmt.Errorf("unable to get git provider url: %w", err)
+	}
+
+	// At this point GetGitProviderUrl should fail if it's not a valid URL.
+	u, _ := url.Parse(repoURL)
+	jsmc := JenkinsSCM{}
+
+	repo, err := jsmc.GetRepository(ctx, p.log, p.client, u)
+	if err != nil {
+		return "", fmt.Errorf("unable to find repository: %w", err)
+	}
+
+	content, _, err := p.client.Contents.Find(ctx, repo.FullName, path, ref)
+	if err != nil {
+		return "", fmt.Errorf("unable to get file %q: %w", path, err)
+	}
+
+	return string(content.Data), nil
+}
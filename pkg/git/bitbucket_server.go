@@ -143,3 +143,18 @@ func (p *BitBucketServerProvider) ListPullRequests(ctx context.Context, repoURL
 
 	return ggp.ListPullRequests(ctx, repo)
 }
+
+func (p *BitBucketServerProvider) ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*CommitInfo, error) {
+	ggp := goGitProvider{}
+
+	repo, err := ggp.GetRepository(ctx, p.log, p.client, repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return ggp.ListCommits(ctx, repo, branch, perPage, page)
+}
+
+func (p *BitBucketServerProvider) GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error) {
+	return "", fmt.Errorf("getting file contents is %w by %s", ErrNotSupported, BitBucketServerProviderName)
+}
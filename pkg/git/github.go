@@ -7,6 +7,7 @@ import (
 	"github.com/fluxcd/go-git-providers/github"
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/go-logr/logr"
+	gogithub "github.com/google/go-github/v52/github"
 )
 
 const GitHubProviderName string = "github"
@@ -139,3 +140,41 @@ func (p *GitHubProvider) ListPullRequests(ctx context.Context, repoURL string) (
 
 	return ggp.ListPullRequests(ctx, repo)
 }
+
+func (p *GitHubProvider) ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*CommitInfo, error) {
+	ggp := goGitProvider{}
+
+	repo, err := ggp.GetRepository(ctx, p.log, p.client, repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return ggp.ListCommits(ctx, repo, branch, perPage, page)
+}
+
+func (p *GitHubProvider) GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error) {
+	ggp := goGitProvider{}
+
+	repo, err := ggp.GetRepository(ctx, p.log, p.client, repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	// go-git-providers can only fetch all the files of a directory, use the
+	// underlying client to fetch a single file.
+	client, ok := p.client.Raw().(*gogithub.Client)
+	if !ok {
+		return "", fmt.Errorf("unexpected GitHub client type %T", p.client.Raw())
+	}
+
+	file, _, _, err := client.Repositories.GetContents(ctx, repo.Repository().GetIdentity(), repo.Repository().GetRepository(), path, &gogithub.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return "", fmt.Errorf("unable to get file %q: %w", path, err)
+	}
+
+	if file == nil {
+		return "", fmt.Errorf("path %q is not a file", path)
+	}
+
+	return file.GetContent()
+}
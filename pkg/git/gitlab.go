@@ -7,6 +7,7 @@ import (
 	"github.com/fluxcd/go-git-providers/gitlab"
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/go-logr/logr"
+	gogitlab "github.com/xanzy/go-gitlab"
 )
 
 const (
@@ -169,3 +170,39 @@ func (p *GitLabProvider) ListPullRequests(ctx context.Context, repoURL string) (
 
 	return ggp.ListPullRequests(ctx, repo)
 }
+
+func (p *GitLabProvider) ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*CommitInfo, error) {
+	ggp := goGitProvider{}
+
+	repo, err := ggp.GetRepository(ctx, p.log, p.client, repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return ggp.ListCommits(ctx, repo, branch, perPage, page)
+}
+
+func (p *GitLabProvider) GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error) {
+	ggp := goGitProvider{}
+
+	repo, err := ggp.GetRepository(ctx, p.log, p.client, repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	// go-git-providers can only fetch all the files of a directory, use the
+	// underlying client to fetch a single file.
+	client, ok := p.client.Raw().(*gogitlab.Client)
+	if !ok {
+		return "", fmt.Errorf("unexpected GitLab client type %T", p.client.Raw())
+	}
+
+	projectPath := fmt.Sprintf("%s/%s", repo.Repository().GetIdentity(), repo.Repository().GetRepository())
+
+	content, _, err := client.RepositoryFiles.GetRawFile(projectPath, path, &gogitlab.GetRawFileOptions{Ref: &ref}, gogitlab.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("unable to get file %q: %w", path, err)
+	}
+
+	return string(content), nil
+}
@@ -224,3 +224,23 @@ func (g goGitProvider) ListPullRequests(ctx context.Context, repo gitprovider.Or
 
 	return prs, nil
 }
+
+func (g goGitProvider) ListCommits(ctx context.Context, repo gitprovider.OrgRepository, branch string, perPage, page int) ([]*CommitInfo, error) {
+	commitList, err := repo.Commits().ListPage(ctx, branch, perPage, page)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := []*CommitInfo{}
+	for _, c := range commitList {
+		commits = append(commits, &CommitInfo{
+			SHA:       c.Get().Sha,
+			Author:    c.Get().Author,
+			Message:   c.Get().Message,
+			CreatedAt: c.Get().CreatedAt,
+			Link:      c.Get().URL,
+		})
+	}
+
+	return commits, nil
+}
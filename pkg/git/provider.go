@@ -2,8 +2,13 @@ package git
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrNotSupported is returned, wrapped, by the providers that do not support an operation,
+// e.g. reading file contents from BitBucket Server.
+var ErrNotSupported = errors.New("not supported")
+
 // Provider defines the interface that WGE will use to interact
 // with a git provider.
 type Provider interface {
@@ -19,6 +24,11 @@ type Provider interface {
 	GetRepository(ctx context.Context, repoURL string) (*Repository, error)
 	GetTreeList(ctx context.Context, repoUrl, sha, path string) ([]*TreeEntry, error)
 	ListPullRequests(ctx context.Context, repoURL string) ([]*PullRequest, error)
+	// ListCommits returns a page of the commits of a branch, newest first.
+	// Pages start at 1.
+	ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*CommitInfo, error)
+	// GetFileContents returns the contents of a file at a branch or commit.
+	GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error)
 }
 
 // CommitFile represents the contents of file in the repository.
@@ -1,5 +1,7 @@
 package git
 
+import "time"
+
 type Repository struct {
 	Domain string
 	Org    string
@@ -14,3 +16,12 @@ type TreeEntry struct {
 	SHA  string
 	Link string
 }
+
+// CommitInfo represents a commit that exists in a repository.
+type CommitInfo struct {
+	SHA       string
+	Author    string
+	Message   string
+	CreatedAt time.Time
+	Link      string
+}
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -44,6 +47,45 @@ func (s *server) ApprovePromotion(ctx context.Context, msg *pb.ApprovePromotionR
 		return nil, fmt.Errorf("failed getting server client: %w", err)
 	}
 
+	policy, err := environmentApprovalPolicy(p, msg.Env)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	resp := &pb.ApprovePromotionResponse{}
+
+	if policy != nil {
+		principal := auth.Principal(ctx)
+
+		if waiting := p.Status.GetWaitingApproval(msg.Env).Revision; waiting != msg.Revision {
+			return nil, grpcStatus.Errorf(codes.FailedPrecondition, "revision=%s is not waiting for approval in environment=%s of pipeline=%s, waiting revision is %q", msg.Revision, msg.Env, msg.Name, waiting)
+		}
+
+		if err := s.checkApprover(ctx, c, p, msg.Env, msg.Revision, policy, principal); err != nil {
+			return nil, err
+		}
+
+		approval := pendingApproval{
+			Environment: msg.Env,
+			Revision:    msg.Revision,
+			Principal:   principal.ID,
+			Groups:      principal.Groups,
+			Timestamp:   time.Now().UTC(),
+		}
+
+		approvals, err := addPendingApproval(ctx, sc, s.cluster, p, policy, approval)
+		if err != nil {
+			return nil, fmt.Errorf("failed storing approval for pipeline=%s in namespace=%s in cluster=%s: %w", msg.Name, msg.Namespace, s.cluster, err)
+		}
+
+		resp.Approvals = int32(approvals)
+		resp.RequiredApprovals = int32(policy.requiredApprovals())
+
+		if approvals < policy.requiredApprovals() {
+			return resp, nil
+		}
+	}
+
 	var hmacSecret *corev1.Secret
 
 	if p.Spec.Promotion != nil && p.Spec.Promotion.Strategy.SecretRef != nil {
@@ -59,9 +101,21 @@ func (s *server) ApprovePromotion(ctx context.Context, msg *pb.ApprovePromotionR
 			msg.Name, msg.Namespace, s.cluster, err)
 	}
 
-	return &pb.ApprovePromotionResponse{
-		PullRequestUrl: prURL,
-	}, nil
+	// The promotion went through at this point, failing to record it should
+	// not be reported as a failed approval.
+	if policy != nil {
+		err = completePendingApprovals(ctx, sc, s.cluster, p, msg.Env, msg.Revision, prURL)
+	} else {
+		err = recordApprovals(ctx, sc, s.cluster, p, newApprovalRecord(auth.Principal(ctx), msg.Env, msg.Revision, prURL))
+	}
+	if err != nil {
+		s.log.Error(err, "failed recording approval", "pipeline", msg.Name, "namespace", msg.Namespace, "env", msg.Env)
+	}
+
+	resp.PullRequestUrl = prURL
+	resp.Promoted = true
+
+	return resp, nil
 }
 
 func sign(payload, key string) string {
@@ -0,0 +1,400 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	helm "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/gitauth/server/gitproviders"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	commitsPerPage = 100
+	// maxCommitPages bounds how far back we look for the revision of the
+	// environment being compared to.
+	maxCommitPages = 5
+)
+
+func (s *server) DiffPipelineEnvironments(ctx context.Context, msg *pb.DiffPipelineEnvironmentsRequest) (*pb.DiffPipelineEnvironmentsResponse, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	p := ctrl.Pipeline{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      msg.Name,
+			Namespace: msg.Namespace,
+		},
+	}
+
+	if err := c.Get(ctx, s.cluster, client.ObjectKeyFromObject(&p), &p); err != nil {
+		return nil, fmt.Errorf("failed to find pipeline=%s in namespace=%s in cluster=%s: %w", msg.Name, msg.Namespace, s.cluster, err)
+	}
+
+	diffErrors := []string{}
+
+	from, err := s.environmentApp(ctx, c, p, msg.FromEnv, &diffErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.environmentApp(ctx, c, p, msg.ToEnv, &diffErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &pb.EnvironmentDiff{
+		Kind: p.Spec.AppRef.Kind,
+	}
+
+	switch p.Spec.AppRef.Kind {
+	case "HelmRelease":
+		if err := diffHelmReleases(from.app, to.app, diff); err != nil {
+			return nil, err
+		}
+	case "Kustomization":
+		if err := s.diffKustomizations(ctx, c, p, msg.FromEnv, from, to, diff); err != nil {
+			// Do not throw an error, the revisions are still worth returning
+			// when the commits cannot be listed.
+			diffErrors = append(diffErrors, err.Error())
+		}
+	default:
+		return nil, UnknownKind{
+			kind:   p.Spec.AppRef.Kind,
+			source: "workload",
+			name:   p.Spec.AppRef.Name,
+		}
+	}
+
+	return &pb.DiffPipelineEnvironmentsResponse{
+		Diff:   diff,
+		Errors: diffErrors,
+	}, nil
+}
+
+type environmentApp struct {
+	app     *unstructured.Unstructured
+	cluster string
+}
+
+// environmentApp returns the application deployed to the first reachable
+// target of an environment. Targets that cannot be read are reported in
+// diffErrors.
+func (s *server) environmentApp(ctx context.Context, c clustersmngr.Client, p ctrl.Pipeline, env string, diffErrors *[]string) (*environmentApp, error) {
+	for _, e := range p.Spec.Environments {
+		if e.Name != env {
+			continue
+		}
+
+		for _, t := range e.Targets {
+			app, err := s.getTargetApp(ctx, c, p, t)
+			if err != nil {
+				*diffErrors = append(*diffErrors, err.Error())
+				continue
+			}
+
+			cluster, _ := targetCluster(p, t, s.cluster)
+
+			return &environmentApp{app: app, cluster: cluster}, nil
+		}
+
+		return nil, fmt.Errorf("no target of environment=%s in pipeline=%s could be read", env, p.Name)
+	}
+
+	return nil, grpcStatus.Errorf(codes.InvalidArgument, "environment=%s not found in pipeline=%s in namespace=%s", env, p.Name, p.Namespace)
+}
+
+func diffHelmReleases(fromObj, toObj *unstructured.Unstructured, diff *pb.EnvironmentDiff) error {
+	from := helm.HelmRelease{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(fromObj.Object, &from); err != nil {
+		return fmt.Errorf("failed converting unstructured.Unstructured to HelmRelease: %w", err)
+	}
+
+	to := helm.HelmRelease{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(toObj.Object, &to); err != nil {
+		return fmt.Errorf("failed converting unstructured.Unstructured to HelmRelease: %w", err)
+	}
+
+	diff.FromRevision = from.Status.LastAppliedRevision
+	diff.ToRevision = to.Status.LastAppliedRevision
+	diff.FromChartVersion = from.Spec.Chart.Spec.Version
+	diff.ToChartVersion = to.Spec.Chart.Spec.Version
+
+	values, err := diffValues(from.GetValues(), to.GetValues())
+	if err != nil {
+		return err
+	}
+	diff.Values = values
+
+	return nil
+}
+
+// diffValues compares two sets of Helm values, nested keys are flattened into
+// dot separated paths. Only inline values are compared, values coming from
+// ConfigMaps or Secrets are not.
+func diffValues(from, to map[string]interface{}) ([]*pb.ValueDiff, error) {
+	fromFlat := map[string]interface{}{}
+	flattenValues("", from, fromFlat)
+
+	toFlat := map[string]interface{}{}
+	flattenValues("", to, toFlat)
+
+	paths := map[string]bool{}
+	for k := range fromFlat {
+		paths[k] = true
+	}
+	for k := range toFlat {
+		paths[k] = true
+	}
+
+	diffs := []*pb.ValueDiff{}
+
+	for path := range paths {
+		fromValue, inFrom := fromFlat[path]
+		toValue, inTo := toFlat[path]
+
+		if inFrom && inTo && reflect.DeepEqual(fromValue, toValue) {
+			continue
+		}
+
+		d := &pb.ValueDiff{Path: path}
+
+		if inFrom {
+			b, err := json.Marshal(fromValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed encoding value %s: %w", path, err)
+			}
+			d.From = string(b)
+		}
+
+		if inTo {
+			b, err := json.Marshal(toValue)
+			if err != nil {
+				return nil, fmt.Errorf("failed encoding value %s: %w", path, err)
+			}
+			d.To = string(b)
+		}
+
+		diffs = append(diffs, d)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs, nil
+}
+
+func flattenValues(prefix string, values map[string]interface{}, out map[string]interface{}) {
+	for k, v := range values {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenValues(path, nested, out)
+			continue
+		}
+
+		out[path] = v
+	}
+}
+
+func (s *server) diffKustomizations(ctx context.Context, c clustersmngr.Client, p ctrl.Pipeline, fromEnv string, fromApp, toApp *environmentApp, diff *pb.EnvironmentDiff) error {
+	from := kustomizev1.Kustomization{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(fromApp.app.Object, &from); err != nil {
+		return fmt.Errorf("failed converting unstructured.Unstructured to Kustomization: %w", err)
+	}
+
+	to := kustomizev1.Kustomization{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(toApp.app.Object, &to); err != nil {
+		return fmt.Errorf("failed converting unstructured.Unstructured to Kustomization: %w", err)
+	}
+
+	diff.FromRevision = from.Status.LastAppliedRevision
+	diff.ToRevision = to.Status.LastAppliedRevision
+
+	branch, fromSHA := parseGitRevision(from.Status.LastAppliedRevision)
+	_, toSHA := parseGitRevision(to.Status.LastAppliedRevision)
+
+	if fromSHA == "" || toSHA == "" || fromSHA == toSHA {
+		return nil
+	}
+
+	if from.Spec.SourceRef.Kind != sourcev1.GitRepositoryKind {
+		return fmt.Errorf("cannot list commits of Kustomization=%s, its source is a %s", from.Name, from.Spec.SourceRef.Kind)
+	}
+
+	repo := sourcev1.GitRepository{}
+	repoKey := types.NamespacedName{Name: from.Spec.SourceRef.Name, Namespace: from.Spec.SourceRef.Namespace}
+	if repoKey.Namespace == "" {
+		repoKey.Namespace = from.Namespace
+	}
+
+	if err := c.Get(ctx, fromApp.cluster, repoKey, &repo); err != nil {
+		return fmt.Errorf("failed getting GitRepository=%s on cluster=%s: %w", repoKey, fromApp.cluster, err)
+	}
+
+	if branch == "" && repo.Spec.Reference != nil {
+		branch = repo.Spec.Reference.Branch
+	}
+
+	if branch == "" {
+		return fmt.Errorf("cannot list commits of GitRepository=%s, it does not track a branch", repoKey)
+	}
+
+	gp, err := gitProviderFor(ctx, c, s.cluster, p, fromEnv, fromApp.cluster, repo)
+	if err != nil {
+		return err
+	}
+
+	commits, toAhead, err := s.commitsBetween(ctx, gp, repo.Spec.URL, branch, fromSHA, toSHA)
+	if err != nil {
+		return err
+	}
+
+	diff.Commits = commits
+	diff.ToAhead = toAhead
+
+	return nil
+}
+
+// gitProviderFor returns the git provider configuration to read a
+// GitRepository. The token of the pull request promotion of the pipeline is
+// used if there is one, the credentials of the GitRepository otherwise. The
+// Secrets are read with the client of the user, so that users only get diffs
+// authenticated with credentials they can read.
+func gitProviderFor(ctx context.Context, c clustersmngr.Client, pipelineCluster string, p ctrl.Pipeline, env, cluster string, repo sourcev1.GitRepository) (csgit.GitProvider, error) {
+	repoURL, err := gitproviders.NewRepoURL(repo.Spec.URL)
+	if err != nil {
+		return csgit.GitProvider{}, fmt.Errorf("failed parsing url of GitRepository=%s/%s: %w", repo.Namespace, repo.Name, err)
+	}
+
+	gp := csgit.GitProvider{
+		Type:     string(repoURL.Provider()),
+		Hostname: repoURL.URL().Host,
+	}
+
+	if promotion := p.Spec.GetPromotion(env); promotion != nil && promotion.Strategy.PullRequest != nil {
+		var secret corev1.Secret
+		if err := c.Get(ctx, pipelineCluster, client.ObjectKey{Namespace: p.Namespace, Name: promotion.Strategy.PullRequest.SecretRef.Name}, &secret); err != nil {
+			return csgit.GitProvider{}, fmt.Errorf("failed to fetch Secret: %w", err)
+		}
+
+		gp.Type = promotion.Strategy.PullRequest.Type.String()
+		gp.Token = string(secret.Data["token"])
+
+		return gp, nil
+	}
+
+	if repo.Spec.SecretRef != nil {
+		var secret corev1.Secret
+		if err := c.Get(ctx, cluster, client.ObjectKey{Namespace: repo.Namespace, Name: repo.Spec.SecretRef.Name}, &secret); err != nil {
+			return csgit.GitProvider{}, fmt.Errorf("failed to fetch Secret: %w", err)
+		}
+
+		gp.Token = string(secret.Data["password"])
+	}
+
+	return gp, nil
+}
+
+// commitsBetween lists the commits of branch between fromSHA and toSHA. The
+// branch is walked back from the newer of the two revisions to, but not
+// including, the older one. toAhead reports whether toSHA is the newer
+// revision.
+func (s *server) commitsBetween(ctx context.Context, gp csgit.GitProvider, repoURL, branch, fromSHA, toSHA string) (commits []*pb.Commit, toAhead bool, err error) {
+	commits = []*pb.Commit{}
+	newer, older := "", ""
+
+	for page := 1; page <= maxCommitPages; page++ {
+		infos, err := s.gitProvider.ListCommits(ctx, gp, repoURL, branch, commitsPerPage, page)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed listing commits of %s: %w", repoURL, err)
+		}
+
+		for _, info := range infos {
+			if newer == "" {
+				switch info.SHA {
+				case fromSHA:
+					newer, older = fromSHA, toSHA
+				case toSHA:
+					newer, older = toSHA, fromSHA
+					toAhead = true
+				default:
+					continue
+				}
+			}
+
+			if info.SHA == older {
+				return commits, toAhead, nil
+			}
+
+			commits = append(commits, &pb.Commit{
+				Sha:       info.SHA,
+				Author:    info.Author,
+				Message:   info.Message,
+				Timestamp: info.CreatedAt.Format(time.RFC3339),
+				Url:       info.Link,
+			})
+		}
+
+		if len(infos) < commitsPerPage {
+			break
+		}
+	}
+
+	if newer == "" {
+		return nil, false, fmt.Errorf("revisions %s and %s not found in the last %d commits of branch %s", fromSHA, toSHA, maxCommitPages*commitsPerPage, branch)
+	}
+
+	return nil, false, fmt.Errorf("revision %s not found in the %d commits preceding %s on branch %s", older, len(commits), newer, branch)
+}
+
+// parseGitRevision splits a Flux source revision into branch and commit SHA.
+// Both the "<branch>@sha1:<sha>" and legacy "<branch>/<sha>" formats are
+// supported.
+func parseGitRevision(revision string) (string, string) {
+	if i := strings.LastIndex(revision, "@"); i >= 0 {
+		ref := strings.TrimPrefix(revision[:i], "refs/heads/")
+		digest := revision[i+1:]
+
+		if j := strings.Index(digest, ":"); j >= 0 {
+			digest = digest[j+1:]
+		}
+
+		return ref, digest
+	}
+
+	if strings.HasPrefix(revision, "sha1:") {
+		return "", strings.TrimPrefix(revision, "sha1:")
+	}
+
+	if i := strings.LastIndex(revision, "/"); i >= 0 {
+		return revision[:i], revision[i+1:]
+	}
+
+	return "", revision
+}
@@ -0,0 +1,358 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	helm "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git/gitfakes"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/pipetesting"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestDiffPipelineEnvironments_HelmRelease(t *testing.T) {
+	ctx := context.Background()
+
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	devNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	prodNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", "", nil)
+
+	devHR := &helm.HelmRelease{
+		TypeMeta: v1.TypeMeta{
+			APIVersion: helm.GroupVersion.String(),
+			Kind:       "HelmRelease",
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "app-1",
+			Namespace: devNamespace.Name,
+		},
+		Spec: helm.HelmReleaseSpec{
+			Chart: helm.HelmChartTemplate{
+				Spec: helm.HelmChartTemplateSpec{
+					Version: "0.2.0",
+				},
+			},
+			Values: &apiextensionsv1.JSON{Raw: []byte(`{"image":{"tag":"v2","pullPolicy":"Always"},"replicas":1,"debug":true}`)},
+		},
+		Status: helm.HelmReleaseStatus{
+			LastAppliedRevision: "0.2.0",
+		},
+	}
+	require.NoError(t, kclient.Create(ctx, devHR))
+
+	prodHR := devHR.DeepCopy()
+	prodHR.ResourceVersion = ""
+	prodHR.Namespace = prodNamespace.Name
+	prodHR.Spec.Chart.Spec.Version = "0.1.0"
+	prodHR.Spec.Values = &apiextensionsv1.JSON{Raw: []byte(`{"image":{"tag":"v1","pullPolicy":"Always"},"replicas":3}`)}
+	prodHR.Status.LastAppliedRevision = "0.1.0"
+	require.NoError(t, kclient.Create(ctx, prodHR))
+
+	p := newPipeline("pipe-1", pipelineNamespace.Name, devNamespace.Name, "dev", devHR,
+		withEnvironment("prod", []ctrl.Target{{Namespace: prodNamespace.Name}}, nil))
+	require.NoError(t, kclient.Create(ctx, p))
+
+	res, err := serverClient.DiffPipelineEnvironments(ctx, &pb.DiffPipelineEnvironmentsRequest{
+		Name:      p.Name,
+		Namespace: pipelineNamespace.Name,
+		FromEnv:   "dev",
+		ToEnv:     "prod",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Errors)
+
+	assert.Equal(t, "HelmRelease", res.Diff.Kind)
+	assert.Equal(t, "0.2.0", res.Diff.FromChartVersion)
+	assert.Equal(t, "0.1.0", res.Diff.ToChartVersion)
+	assert.Equal(t, "0.2.0", res.Diff.FromRevision)
+	assert.Equal(t, "0.1.0", res.Diff.ToRevision)
+
+	assert.Equal(t, []*pb.ValueDiff{
+		{Path: "debug", From: "true"},
+		{Path: "image.tag", From: `"v2"`, To: `"v1"`},
+		{Path: "replicas", From: "1", To: "3"},
+	}, res.Diff.Values)
+
+	t.Run("unknown environment", func(t *testing.T) {
+		_, err := serverClient.DiffPipelineEnvironments(ctx, &pb.DiffPipelineEnvironmentsRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			FromEnv:   "dev",
+			ToEnv:     "staging",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestDiffPipelineEnvironments_Kustomization(t *testing.T) {
+	ctx := context.Background()
+
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	devNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	prodNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	canaryNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+
+	now := time.Now()
+	gitProvider := &gitfakes.FakeGitProvider{
+		Commits: []*git.CommitInfo{
+			{SHA: "ccc", Author: "alice", Message: "newer than dev", CreatedAt: now},
+			{SHA: "bbb", Author: "bob", Message: "deployed to dev", CreatedAt: now.Add(-time.Hour)},
+			{SHA: "aaa", Author: "alice", Message: "in between", CreatedAt: now.Add(-2 * time.Hour)},
+			{SHA: "999", Author: "alice", Message: "deployed to prod", CreatedAt: now.Add(-3 * time.Hour)},
+		},
+	}
+
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", "", gitProvider)
+
+	for _, ns := range []string{devNamespace.Name, prodNamespace.Name, canaryNamespace.Name} {
+		require.NoError(t, kclient.Create(ctx, &sourcev1.GitRepository{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "app-repo",
+				Namespace: ns,
+			},
+			Spec: sourcev1.GitRepositorySpec{
+				URL: "https://github.com/my-org/app",
+				Reference: &sourcev1.GitRepositoryRef{
+					Branch: "main",
+				},
+			},
+		}))
+	}
+
+	newKustomization := func(ns, revision string) *kustomizev1.Kustomization {
+		ks := &kustomizev1.Kustomization{
+			TypeMeta: v1.TypeMeta{
+				APIVersion: kustomizev1.GroupVersion.String(),
+				Kind:       kustomizev1.KustomizationKind,
+			},
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "app-1",
+				Namespace: ns,
+			},
+			Spec: kustomizev1.KustomizationSpec{
+				SourceRef: kustomizev1.CrossNamespaceSourceReference{
+					Kind: sourcev1.GitRepositoryKind,
+					Name: "app-repo",
+				},
+			},
+			Status: kustomizev1.KustomizationStatus{
+				LastAppliedRevision: revision,
+			},
+		}
+		require.NoError(t, kclient.Create(ctx, ks))
+		return ks
+	}
+
+	devKs := newKustomization(devNamespace.Name, "main@sha1:bbb")
+	newKustomization(prodNamespace.Name, "main@sha1:999")
+	newKustomization(canaryNamespace.Name, "main@sha1:ccc")
+
+	p := &ctrl.Pipeline{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "pipe-1",
+			Namespace: pipelineNamespace.Name,
+		},
+		Spec: ctrl.PipelineSpec{
+			AppRef: ctrl.LocalAppReference{
+				APIVersion: kustomizev1.GroupVersion.String(),
+				Kind:       kustomizev1.KustomizationKind,
+				Name:       devKs.Name,
+			},
+			Environments: []ctrl.Environment{
+				{Name: "dev", Targets: []ctrl.Target{{Namespace: devNamespace.Name}}},
+				{Name: "prod", Targets: []ctrl.Target{{Namespace: prodNamespace.Name}}},
+				{Name: "canary", Targets: []ctrl.Target{{Namespace: canaryNamespace.Name}}},
+			},
+		},
+	}
+	require.NoError(t, kclient.Create(ctx, p))
+
+	commitSHAs := func(commits []*pb.Commit) []string {
+		shas := []string{}
+		for _, c := range commits {
+			shas = append(shas, c.Sha)
+		}
+		return shas
+	}
+
+	t.Run("target behind", func(t *testing.T) {
+		res, err := serverClient.DiffPipelineEnvironments(ctx, &pb.DiffPipelineEnvironmentsRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			FromEnv:   "dev",
+			ToEnv:     "prod",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Errors)
+
+		assert.Equal(t, "Kustomization", res.Diff.Kind)
+		assert.Equal(t, "main@sha1:bbb", res.Diff.FromRevision)
+		assert.Equal(t, "main@sha1:999", res.Diff.ToRevision)
+
+		assert.Equal(t, []string{"bbb", "aaa"}, commitSHAs(res.Diff.Commits))
+		assert.Equal(t, "bob", res.Diff.Commits[0].Author)
+		assert.False(t, res.Diff.ToAhead)
+	})
+
+	t.Run("reversed environments", func(t *testing.T) {
+		res, err := serverClient.DiffPipelineEnvironments(ctx, &pb.DiffPipelineEnvironmentsRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			FromEnv:   "prod",
+			ToEnv:     "dev",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Errors)
+
+		assert.Equal(t, []string{"bbb", "aaa"}, commitSHAs(res.Diff.Commits))
+		assert.True(t, res.Diff.ToAhead)
+	})
+
+	t.Run("target ahead", func(t *testing.T) {
+		res, err := serverClient.DiffPipelineEnvironments(ctx, &pb.DiffPipelineEnvironmentsRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			FromEnv:   "dev",
+			ToEnv:     "canary",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Errors)
+
+		assert.Equal(t, []string{"ccc"}, commitSHAs(res.Diff.Commits))
+		assert.True(t, res.Diff.ToAhead)
+	})
+}
+
+func TestDiffPipelineEnvironments_CredentialsReadAsUser(t *testing.T) {
+	ctx := context.Background()
+
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	devNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	prodNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+
+	// The user may read the pipeline and its applications but not secrets.
+	userK8s := interceptor.NewClient(kclient.(client.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if _, ok := obj.(*corev1.Secret); ok {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, key.Name, nil)
+			}
+
+			return c.Get(ctx, key, obj, opts...)
+		},
+	})
+	userClient, err := grpctesting.MakeClustersManager(userK8s, nil).GetImpersonatedClient(ctx, nil)
+	require.NoError(t, err)
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+	factory.GetImpersonatedClientReturns(userClient, nil)
+
+	gitProvider := &gitfakes.FakeGitProvider{
+		Commits: []*git.CommitInfo{
+			{SHA: "bbb", Author: "bob", Message: "deployed to dev", CreatedAt: time.Now()},
+			{SHA: "999", Author: "alice", Message: "deployed to prod", CreatedAt: time.Now().Add(-time.Hour)},
+		},
+	}
+
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", "", gitProvider)
+
+	revisions := map[string]string{
+		devNamespace.Name:  "main@sha1:bbb",
+		prodNamespace.Name: "main@sha1:999",
+	}
+	for ns, revision := range revisions {
+		require.NoError(t, kclient.Create(ctx, &corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{Name: "git-credentials", Namespace: ns},
+			Data:       map[string][]byte{"password": []byte("s3cr3t")},
+		}))
+		require.NoError(t, kclient.Create(ctx, &sourcev1.GitRepository{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "app-repo",
+				Namespace: ns,
+			},
+			Spec: sourcev1.GitRepositorySpec{
+				URL:       "https://github.com/my-org/app",
+				Reference: &sourcev1.GitRepositoryRef{Branch: "main"},
+				SecretRef: &meta.LocalObjectReference{Name: "git-credentials"},
+			},
+		}))
+		require.NoError(t, kclient.Create(ctx, &kustomizev1.Kustomization{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "app-1",
+				Namespace: ns,
+			},
+			Spec: kustomizev1.KustomizationSpec{
+				SourceRef: kustomizev1.CrossNamespaceSourceReference{
+					Kind: sourcev1.GitRepositoryKind,
+					Name: "app-repo",
+				},
+			},
+			Status: kustomizev1.KustomizationStatus{
+				LastAppliedRevision: revision,
+			},
+		}))
+	}
+
+	p := &ctrl.Pipeline{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "pipe-1",
+			Namespace: pipelineNamespace.Name,
+		},
+		Spec: ctrl.PipelineSpec{
+			AppRef: ctrl.LocalAppReference{
+				APIVersion: kustomizev1.GroupVersion.String(),
+				Kind:       kustomizev1.KustomizationKind,
+				Name:       "app-1",
+			},
+			Environments: []ctrl.Environment{
+				{Name: "dev", Targets: []ctrl.Target{{Namespace: devNamespace.Name}}},
+				{Name: "prod", Targets: []ctrl.Target{{Namespace: prodNamespace.Name}}},
+			},
+		},
+	}
+	require.NoError(t, kclient.Create(ctx, p))
+
+	res, err := serverClient.DiffPipelineEnvironments(ctx, &pb.DiffPipelineEnvironmentsRequest{
+		Name:      p.Name,
+		Namespace: pipelineNamespace.Name,
+		FromEnv:   "dev",
+		ToEnv:     "prod",
+	})
+	require.NoError(t, err)
+
+	// The commits are not listed with credentials the user cannot read
+	assert.Empty(t, res.Diff.Commits)
+	require.Len(t, res.Errors, 1)
+	assert.Contains(t, res.Errors[0], "forbidden")
+}
@@ -6,9 +6,11 @@ import (
 	"time"
 
 	helm "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
 	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/pipelines/internal/convert"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -63,17 +65,7 @@ func (s *server) GetPipeline(ctx context.Context, msg *pb.GetPipelineRequest) (*
 			app.SetKind(p.Spec.AppRef.Kind)
 			app.SetName(p.Spec.AppRef.Name)
 			app.SetNamespace(t.Namespace)
-			clusterName := s.cluster
-			clusterNamespace := p.Namespace
-			if t.ClusterRef != nil && t.ClusterRef.Namespace != "" {
-				clusterNamespace = t.ClusterRef.Namespace
-			}
-			if t.ClusterRef != nil {
-				clusterName = types.NamespacedName{
-					Name:      t.ClusterRef.Name,
-					Namespace: clusterNamespace,
-				}.String()
-			}
+			clusterName, clusterNamespace := targetCluster(p, t, s.cluster)
 
 			if err := c.Get(ctx, clusterName, client.ObjectKeyFromObject(app), app); err != nil {
 				pipelineErrors = append(
@@ -138,6 +130,41 @@ func (s *server) GetPipeline(ctx context.Context, msg *pb.GetPipelineRequest) (*
 	}, nil
 }
 
+// getTargetApp gets the application of the pipeline deployed to a target.
+func (s *server) getTargetApp(ctx context.Context, c clustersmngr.Client, p ctrl.Pipeline, t ctrl.Target) (*unstructured.Unstructured, error) {
+	app := &unstructured.Unstructured{}
+	app.SetAPIVersion(p.Spec.AppRef.APIVersion)
+	app.SetKind(p.Spec.AppRef.Kind)
+	app.SetName(p.Spec.AppRef.Name)
+	app.SetNamespace(t.Namespace)
+
+	clusterName, _ := targetCluster(p, t, s.cluster)
+
+	if err := c.Get(ctx, clusterName, client.ObjectKeyFromObject(app), app); err != nil {
+		return nil, fmt.Errorf("failed getting app=%s on cluster=%s: %w", app.GetName(), clusterName, err)
+	}
+
+	return app, nil
+}
+
+// targetCluster returns the name of the cluster a target points to, as known
+// by the clusters manager, and the namespace of its cluster reference.
+func targetCluster(p ctrl.Pipeline, t ctrl.Target, defaultCluster string) (string, string) {
+	clusterNamespace := p.Namespace
+	if t.ClusterRef != nil && t.ClusterRef.Namespace != "" {
+		clusterNamespace = t.ClusterRef.Namespace
+	}
+
+	if t.ClusterRef == nil {
+		return defaultCluster, clusterNamespace
+	}
+
+	return types.NamespacedName{
+		Name:      t.ClusterRef.Name,
+		Namespace: clusterNamespace,
+	}.String(), clusterNamespace
+}
+
 func getWorkloadStatus(obj *unstructured.Unstructured) (*pb.WorkloadStatus, error) {
 	ws := &pb.WorkloadStatus{}
 
@@ -163,6 +190,26 @@ func getWorkloadStatus(obj *unstructured.Unstructured) (*pb.WorkloadStatus, erro
 				Timestamp: c.LastTransitionTime.Format(time.RFC3339),
 			})
 		}
+	case "Kustomization":
+		ks := kustomizev1.Kustomization{}
+
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &ks); err != nil {
+			return nil, fmt.Errorf("failed converting unstructured.Unstructured to Kustomization: %w", err)
+		}
+		ws.Kind = ks.Kind
+		ws.Name = ks.Name
+		ws.LastAppliedRevision = ks.Status.LastAppliedRevision
+		ws.Suspended = ks.Spec.Suspend
+		ws.Conditions = []*pb.Condition{}
+		for _, c := range ks.Status.Conditions {
+			ws.Conditions = append(ws.Conditions, &pb.Condition{
+				Type:      c.Type,
+				Status:    string(c.Status),
+				Reason:    c.Reason,
+				Message:   c.Message,
+				Timestamp: c.LastTransitionTime.Format(time.RFC3339),
+			})
+		}
 	default:
 		return nil, UnknownKind{
 			kind:   obj.GetKind(),
@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// PromotionHistoryPipelineLabel is set on the ConfigMaps holding the
+	// approval history of a pipeline.
+	PromotionHistoryPipelineLabel = "pipelines.weave.works/pipeline"
+
+	promotionHistoryKey = "approvals"
+	// maxPromotionHistory is the number of approvals kept per pipeline,
+	// the oldest ones are dropped first.
+	maxPromotionHistory = 100
+)
+
+// Types of the entries returned in a promotion history timeline.
+const (
+	HistoryEntryApproved        = "Approved"
+	HistoryEntryDeployed        = "Deployed"
+	HistoryEntryWaitingApproval = "WaitingApproval"
+	HistoryEntryEvent           = "Event"
+)
+
+// approvalRecord is what is persisted for every successful call to
+// ApprovePromotion.
+type approvalRecord struct {
+	Environment    string    `json:"environment"`
+	Revision       string    `json:"revision"`
+	Principal      string    `json:"principal"`
+	Groups         []string  `json:"groups,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+	PullRequestURL string    `json:"pullRequestURL,omitempty"`
+}
+
+func newApprovalRecord(principal *auth.UserPrincipal, env, revision, prURL string) approvalRecord {
+	rec := approvalRecord{
+		Environment:    env,
+		Revision:       revision,
+		Timestamp:      time.Now().UTC(),
+		PullRequestURL: prURL,
+	}
+
+	if principal != nil {
+		rec.Principal = principal.ID
+		rec.Groups = principal.Groups
+	}
+
+	return rec
+}
+
+func promotionHistoryName(pipelineName string) string {
+	return fmt.Sprintf("%s-promotion-history", pipelineName)
+}
+
+// updatePromotionHistory passes the data of the history ConfigMap of the
+// pipeline to fn and writes it back, creating the ConfigMap if it does not
+// exist yet. The ConfigMap is owned by the pipeline so it is garbage collected
+// with it.
+func updatePromotionHistory(ctx context.Context, c clustersmngr.Client, cluster string, p ctrl.Pipeline, fn func(data map[string]string) error) error {
+	key := client.ObjectKey{Namespace: p.Namespace, Name: promotionHistoryName(p.Name)}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+
+		err := c.Get(ctx, cluster, key, cm)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed getting promotion history: %w", err)
+		}
+
+		exists := err == nil
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		if err := fn(cm.Data); err != nil {
+			return err
+		}
+
+		if exists {
+			return c.Update(ctx, cluster, cm)
+		}
+
+		cm.ObjectMeta = v1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				PromotionHistoryPipelineLabel: p.Name,
+			},
+			OwnerReferences: []v1.OwnerReference{
+				{
+					APIVersion: ctrl.GroupVersion.String(),
+					Kind:       ctrl.PipelineKind,
+					Name:       p.Name,
+					UID:        p.UID,
+				},
+			},
+		}
+
+		return c.Create(ctx, cluster, cm)
+	})
+}
+
+// getPromotionHistory returns the data of the history ConfigMap of the
+// pipeline, which is empty if nothing was recorded yet.
+func getPromotionHistory(ctx context.Context, c clustersmngr.Client, cluster, namespace, name string) (map[string]string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, cluster, client.ObjectKey{Namespace: namespace, Name: promotionHistoryName(name)}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+
+		return nil, fmt.Errorf("failed getting promotion history: %w", err)
+	}
+
+	return cm.Data, nil
+}
+
+func decodeHistoryKey(data map[string]string, key string, v any) error {
+	if data[key] == "" {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(data[key]), v); err != nil {
+		return fmt.Errorf("failed decoding promotion history %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func encodeHistoryKey(data map[string]string, key string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed encoding promotion history %s: %w", key, err)
+	}
+
+	data[key] = string(b)
+
+	return nil
+}
+
+// recordApprovals appends approvals to the history of the pipeline.
+func recordApprovals(ctx context.Context, c clustersmngr.Client, cluster string, p ctrl.Pipeline, recs ...approvalRecord) error {
+	return updatePromotionHistory(ctx, c, cluster, p, func(data map[string]string) error {
+		records := []approvalRecord{}
+		if err := decodeHistoryKey(data, promotionHistoryKey, &records); err != nil {
+			return err
+		}
+
+		records = append(records, recs...)
+		if len(records) > maxPromotionHistory {
+			records = records[len(records)-maxPromotionHistory:]
+		}
+
+		return encodeHistoryKey(data, promotionHistoryKey, records)
+	})
+}
+
+// listApprovals returns the approvals recorded for a pipeline, oldest first.
+func listApprovals(ctx context.Context, c clustersmngr.Client, cluster, namespace, name string) ([]approvalRecord, error) {
+	data, err := getPromotionHistory(ctx, c, cluster, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []approvalRecord{}
+	if err := decodeHistoryKey(data, promotionHistoryKey, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func (s *server) ListPromotionHistory(ctx context.Context, msg *pb.ListPromotionHistoryRequest) (*pb.ListPromotionHistoryResponse, error) {
+	// GetPipeline checks the user has access to the pipeline and gives us the
+	// current status of every target.
+	pipelineResp, err := s.GetPipeline(ctx, &pb.GetPipelineRequest{
+		Name:      msg.Name,
+		Namespace: msg.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := pipelineResp.Pipeline
+	historyErrors := append([]string{}, pipelineResp.Errors...)
+
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting server client: %w", err)
+	}
+
+	approvals, err := listApprovals(ctx, sc, s.cluster, msg.Namespace, msg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing approvals for pipeline=%s in namespace=%s in cluster=%s: %w", msg.Name, msg.Namespace, s.cluster, err)
+	}
+
+	events, err := s.listPipelineEvents(ctx, msg.Namespace, msg.Name)
+	if err != nil {
+		// Do not throw an error, users might not be allowed to read events
+		// but can still see the rest of the history.
+		historyErrors = append(historyErrors, err.Error())
+	}
+
+	environments := []*pb.EnvironmentHistory{}
+
+	for _, env := range pipeline.Environments {
+		if msg.Env != "" && msg.Env != env.Name {
+			continue
+		}
+
+		entries := []*pb.PromotionHistoryEntry{}
+
+		for _, a := range approvals {
+			if a.Environment != env.Name {
+				continue
+			}
+
+			entries = append(entries, &pb.PromotionHistoryEntry{
+				Type:           HistoryEntryApproved,
+				Revision:       a.Revision,
+				Principal:      a.Principal,
+				Timestamp:      a.Timestamp.Format(time.RFC3339),
+				PullRequestUrl: a.PullRequestURL,
+			})
+		}
+
+		if envStatus, ok := pipeline.Status.Environments[env.Name]; ok {
+			entries = append(entries, deployedEntries(envStatus)...)
+
+			if envStatus.WaitingStatus != nil && envStatus.WaitingStatus.Revision != "" {
+				entries = append(entries, &pb.PromotionHistoryEntry{
+					Type:     HistoryEntryWaitingApproval,
+					Revision: envStatus.WaitingStatus.Revision,
+				})
+			}
+		}
+
+		for _, e := range events {
+			if !eventMentionsEnvironment(e, env.Name) {
+				continue
+			}
+
+			timestamp := e.LastTimestamp
+			if timestamp.IsZero() {
+				timestamp = e.CreationTimestamp
+			}
+
+			entries = append(entries, &pb.PromotionHistoryEntry{
+				Type:      HistoryEntryEvent,
+				Timestamp: timestamp.Format(time.RFC3339),
+				Reason:    e.Reason,
+				Message:   e.Message,
+			})
+		}
+
+		sortHistoryEntries(entries)
+
+		environments = append(environments, &pb.EnvironmentHistory{
+			Environment: env.Name,
+			Entries:     entries,
+		})
+	}
+
+	if msg.Env != "" && len(environments) == 0 {
+		return nil, fmt.Errorf("environment=%s not found in pipeline=%s in namespace=%s", msg.Env, msg.Name, msg.Namespace)
+	}
+
+	return &pb.ListPromotionHistoryResponse{
+		Environments: environments,
+		Errors:       historyErrors,
+	}, nil
+}
+
+func (s *server) listPipelineEvents(ctx context.Context, namespace, name string) ([]corev1.Event, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	l := &corev1.EventList{}
+	if err := c.List(ctx, s.cluster, l, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed listing events for pipeline=%s in namespace=%s: %w", name, namespace, err)
+	}
+
+	events := []corev1.Event{}
+	for _, e := range l.Items {
+		if e.InvolvedObject.Kind == ctrl.PipelineKind && e.InvolvedObject.Name == name {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+// deployedEntries returns an entry for every workload deployed in the
+// environment, dated with the last transition of its Ready condition.
+func deployedEntries(envStatus *pb.PipelineStatus_EnvironmentStatus) []*pb.PromotionHistoryEntry {
+	entries := []*pb.PromotionHistoryEntry{}
+
+	for _, target := range envStatus.TargetsStatuses {
+		for _, w := range target.Workloads {
+			if w.LastAppliedRevision == "" {
+				continue
+			}
+
+			entry := &pb.PromotionHistoryEntry{
+				Type:     HistoryEntryDeployed,
+				Revision: w.LastAppliedRevision,
+				Message:  fmt.Sprintf("%s/%s deployed to %s", w.Kind, w.Name, targetName(target)),
+			}
+
+			for _, c := range w.Conditions {
+				if c.Type == "Ready" {
+					entry.Timestamp = c.Timestamp
+					entry.Reason = c.Reason
+				}
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func targetName(target *pb.PipelineTargetStatus) string {
+	if target.ClusterRef == nil || target.ClusterRef.Name == "" {
+		return target.Namespace
+	}
+
+	return fmt.Sprintf("%s/%s/%s", target.ClusterRef.Namespace, target.ClusterRef.Name, target.Namespace)
+}
+
+// eventMentionsEnvironment reports whether an event refers to an environment.
+// The pipeline-controller does not annotate its events, so we look for the
+// environment name in the message.
+func eventMentionsEnvironment(e corev1.Event, env string) bool {
+	for _, word := range strings.FieldsFunc(e.Message, func(r rune) bool {
+		return r == ' ' || r == '"' || r == '\'' || r == ',' || r == ':' || r == '='
+	}) {
+		if word == env {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortHistoryEntries sorts entries newest first, entries without a timestamp
+// (e.g. a pending approval) are kept on top.
+func sortHistoryEntries(entries []*pb.PromotionHistoryEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		ti, erri := time.Parse(time.RFC3339, entries[i].Timestamp)
+		tj, errj := time.Parse(time.RFC3339, entries[j].Timestamp)
+
+		switch {
+		case erri != nil && errj != nil:
+			return false
+		case erri != nil:
+			return true
+		case errj != nil:
+			return false
+		}
+
+		return ti.After(tj)
+	})
+}
@@ -0,0 +1,138 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/pipetesting"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/pipelines/server"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestListPromotionHistory(t *testing.T) {
+	ctx := context.Background()
+
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	targetNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	target2Namespace := pipetesting.NewNamespace(ctx, t, kclient)
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "https://github.com/my-project/pulls/1")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer s.Close()
+
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", s.URL, nil)
+
+	hr := createHelmRelease(ctx, t, kclient, "app-1", targetNamespace.Name)
+
+	envName := "dev"
+	env2Name := "prod"
+
+	p := newPipeline("pipe-1", pipelineNamespace.Name, targetNamespace.Name, envName, hr,
+		withEnvironment(env2Name, []ctrl.Target{{Namespace: target2Namespace.Name}}, nil))
+	p.Status.Environments = map[string]*ctrl.EnvironmentStatus{
+		env2Name: {
+			WaitingApproval: ctrl.WaitingApproval{
+				Revision: "0.1.3",
+			},
+		},
+	}
+	require.NoError(t, kclient.Create(ctx, p))
+
+	require.NoError(t, kclient.Create(ctx, &corev1.Event{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "pipe-1.promotion",
+			Namespace: pipelineNamespace.Name,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      ctrl.PipelineKind,
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+		},
+		Reason:        "Promoted",
+		Message:       "promotion of revision 0.1.3 to environment prod is waiting approval",
+		LastTimestamp: v1.Now(),
+	}))
+
+	_, err := serverClient.ApprovePromotion(ctx, &pb.ApprovePromotionRequest{
+		Name:      p.Name,
+		Namespace: pipelineNamespace.Name,
+		Env:       env2Name,
+		Revision:  "0.1.3",
+	})
+	require.NoError(t, err)
+
+	t.Run("all environments", func(t *testing.T) {
+		res, err := serverClient.ListPromotionHistory(ctx, &pb.ListPromotionHistoryRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, res.Environments, 2)
+		assert.Equal(t, envName, res.Environments[0].Environment)
+		assert.Equal(t, env2Name, res.Environments[1].Environment)
+
+		entryTypes := func(entries []*pb.PromotionHistoryEntry) []string {
+			types := []string{}
+			for _, e := range entries {
+				types = append(types, e.Type)
+			}
+			return types
+		}
+
+		assert.Equal(t, []string{server.HistoryEntryDeployed}, entryTypes(res.Environments[0].Entries))
+		assert.Equal(t, hr.Status.LastAppliedRevision, res.Environments[0].Entries[0].Revision)
+
+		assert.ElementsMatch(t, []string{
+			server.HistoryEntryWaitingApproval,
+			server.HistoryEntryApproved,
+			server.HistoryEntryEvent,
+		}, entryTypes(res.Environments[1].Entries))
+		assert.Equal(t, server.HistoryEntryWaitingApproval, res.Environments[1].Entries[0].Type)
+
+		for _, e := range res.Environments[1].Entries {
+			if e.Type == server.HistoryEntryApproved {
+				assert.Equal(t, "0.1.3", e.Revision)
+				assert.Equal(t, "https://github.com/my-project/pulls/1", e.PullRequestUrl)
+				assert.NotEmpty(t, e.Timestamp)
+			}
+		}
+	})
+
+	t.Run("single environment", func(t *testing.T) {
+		res, err := serverClient.ListPromotionHistory(ctx, &pb.ListPromotionHistoryRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       env2Name,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, res.Environments, 1)
+		assert.Equal(t, env2Name, res.Environments[0].Environment)
+	})
+
+	t.Run("unknown environment", func(t *testing.T) {
+		_, err := serverClient.ListPromotionHistory(ctx, &pb.ListPromotionHistoryRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "staging",
+		})
+		require.Error(t, err)
+	})
+}
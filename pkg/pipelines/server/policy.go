@@ -0,0 +1,383 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ApprovalPolicyAnnotation holds the approval policy of a pipeline, as
+	// YAML or JSON, e.g.
+	//
+	//	pipelines.weave.works/approval-policy: |
+	//	  environments:
+	//	    prod:
+	//	      approverGroups: [release-managers]
+	//	      requiredApprovals: 2
+	//	      approvalTTL: 24h
+	ApprovalPolicyAnnotation = "pipelines.weave.works/approval-policy"
+
+	// RevisionAuthorAnnotation can be set on the application of an environment
+	// (e.g. by CI) to record who authored the revision deployed there. It is
+	// used to prevent authors from approving the promotion of their own
+	// revisions to the next environment.
+	RevisionAuthorAnnotation = "pipelines.weave.works/revision-author"
+
+	pendingApprovalsKey = "pending"
+)
+
+// ApprovalPolicy defines who can approve promotions into the environments of
+// a pipeline. Environments without a policy can be approved by anybody with
+// access to the pipeline.
+type ApprovalPolicy struct {
+	Environments map[string]EnvironmentApprovalPolicy `json:"environments"`
+}
+
+// EnvironmentApprovalPolicy defines the approvals needed to promote a
+// revision into an environment.
+type EnvironmentApprovalPolicy struct {
+	// ApproverGroups lists the groups allowed to approve promotions. Anybody
+	// with access to the pipeline can approve if empty.
+	ApproverGroups []string `json:"approverGroups,omitempty"`
+	// RequiredApprovals is the number of distinct approvers needed before
+	// the promotion happens. Defaults to 1.
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+	// AllowSelfApproval allows the author of a revision to approve it.
+	AllowSelfApproval bool `json:"allowSelfApproval,omitempty"`
+	// ApprovalTTL is how long an approval counts for, approvals never expire
+	// if not set.
+	ApprovalTTL *v1.Duration `json:"approvalTTL,omitempty"`
+}
+
+func (p EnvironmentApprovalPolicy) requiredApprovals() int {
+	if p.RequiredApprovals < 1 {
+		return 1
+	}
+
+	return p.RequiredApprovals
+}
+
+func (p EnvironmentApprovalPolicy) expired(approval pendingApproval, now time.Time) bool {
+	if p.ApprovalTTL == nil || p.ApprovalTTL.Duration == 0 {
+		return false
+	}
+
+	return now.After(approval.Timestamp.Add(p.ApprovalTTL.Duration))
+}
+
+func (p EnvironmentApprovalPolicy) expiresAt(approval pendingApproval) string {
+	if p.ApprovalTTL == nil || p.ApprovalTTL.Duration == 0 {
+		return ""
+	}
+
+	return approval.Timestamp.Add(p.ApprovalTTL.Duration).Format(time.RFC3339)
+}
+
+func (p EnvironmentApprovalPolicy) canApprove(principal *auth.UserPrincipal) bool {
+	if len(p.ApproverGroups) == 0 {
+		return true
+	}
+
+	if principal == nil {
+		return false
+	}
+
+	for _, allowed := range p.ApproverGroups {
+		for _, g := range principal.Groups {
+			if g == allowed {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// pendingApproval is an approval that has not led to a promotion yet.
+type pendingApproval struct {
+	Environment string    `json:"environment"`
+	Revision    string    `json:"revision"`
+	Principal   string    `json:"principal"`
+	Groups      []string  `json:"groups,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// getApprovalPolicy parses the approval policy annotation of a pipeline, it
+// returns nil if the pipeline has none.
+func getApprovalPolicy(p ctrl.Pipeline) (*ApprovalPolicy, error) {
+	raw, ok := p.GetAnnotations()[ApprovalPolicyAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	policy := &ApprovalPolicy{}
+	if err := yaml.Unmarshal([]byte(raw), policy); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation on pipeline=%s in namespace=%s: %w", ApprovalPolicyAnnotation, p.Name, p.Namespace, err)
+	}
+
+	return policy, nil
+}
+
+// environmentApprovalPolicy returns the approval policy of an environment, or
+// nil if promotions into it do not need to follow one.
+func environmentApprovalPolicy(p ctrl.Pipeline, env string) (*EnvironmentApprovalPolicy, error) {
+	policy, err := getApprovalPolicy(p)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+
+	envPolicy, ok := policy.Environments[env]
+	if !ok {
+		return nil, nil
+	}
+
+	return &envPolicy, nil
+}
+
+// checkApprover returns a PermissionDenied error if the principal is not
+// allowed to approve the promotion of revision into env, and a
+// FailedPrecondition error if the author of the revision must be known to
+// prevent self-approval but is not.
+func (s *server) checkApprover(ctx context.Context, c clustersmngr.Client, p ctrl.Pipeline, env, revision string, policy *EnvironmentApprovalPolicy, principal *auth.UserPrincipal) error {
+	if principal == nil || principal.ID == "" {
+		return grpcStatus.Errorf(codes.PermissionDenied, "approving promotions to environment=%s of pipeline=%s requires an authenticated user", env, p.Name)
+	}
+
+	if !policy.canApprove(principal) {
+		return grpcStatus.Errorf(codes.PermissionDenied, "approving promotions to environment=%s of pipeline=%s requires membership of one of the groups %v", env, p.Name, policy.ApproverGroups)
+	}
+
+	if policy.AllowSelfApproval {
+		return nil
+	}
+
+	author, err := s.revisionAuthor(ctx, c, p, env, revision)
+	if err != nil {
+		return err
+	}
+
+	if author == "" {
+		return grpcStatus.Errorf(codes.FailedPrecondition, "the author of revision=%s is unknown, set the %s annotation on the applications of the previous environment or allow self-approval in environment=%s of pipeline=%s", revision, RevisionAuthorAnnotation, env, p.Name)
+	}
+
+	if author == principal.ID {
+		return grpcStatus.Errorf(codes.PermissionDenied, "revision=%s was authored by %s and cannot be approved by its author", revision, author)
+	}
+
+	return nil
+}
+
+// revisionAuthor looks for the author of a revision on the applications of
+// the environment preceding env, which is where the revision is deployed
+// while it waits for approval.
+func (s *server) revisionAuthor(ctx context.Context, c clustersmngr.Client, p ctrl.Pipeline, env, revision string) (string, error) {
+	var previous *ctrl.Environment
+
+	for i, e := range p.Spec.Environments {
+		if e.Name == env && i > 0 {
+			previous = &p.Spec.Environments[i-1]
+		}
+	}
+
+	if previous == nil {
+		return "", nil
+	}
+
+	for _, t := range previous.Targets {
+		app, err := s.getTargetApp(ctx, c, p, t)
+		if err != nil {
+			return "", fmt.Errorf("failed to find the author of revision=%s: %w", revision, err)
+		}
+
+		ws, err := getWorkloadStatus(app)
+		if err != nil {
+			return "", err
+		}
+
+		if ws.LastAppliedRevision != revision {
+			continue
+		}
+
+		if author := app.GetAnnotations()[RevisionAuthorAnnotation]; author != "" {
+			return author, nil
+		}
+	}
+
+	return "", nil
+}
+
+// addPendingApproval stores an approval and returns the number of valid
+// approvals for the same environment and revision. Expired approvals, and
+// approvals for previous revisions of the environment, are dropped.
+func addPendingApproval(ctx context.Context, c clustersmngr.Client, cluster string, p ctrl.Pipeline, policy *EnvironmentApprovalPolicy, approval pendingApproval) (int, error) {
+	count := 0
+
+	err := updatePromotionHistory(ctx, c, cluster, p, func(data map[string]string) error {
+		pending := []pendingApproval{}
+		if err := decodeHistoryKey(data, pendingApprovalsKey, &pending); err != nil {
+			return err
+		}
+
+		kept := []pendingApproval{}
+		count = 0
+
+		for _, a := range pending {
+			if a.Environment == approval.Environment {
+				if a.Revision != approval.Revision || a.Principal == approval.Principal || policy.expired(a, approval.Timestamp) {
+					continue
+				}
+
+				count++
+			}
+
+			kept = append(kept, a)
+		}
+
+		kept = append(kept, approval)
+		count++
+
+		return encodeHistoryKey(data, pendingApprovalsKey, kept)
+	})
+
+	return count, err
+}
+
+// completePendingApprovals moves the pending approvals of an environment to
+// the history of the pipeline once the promotion went through.
+func completePendingApprovals(ctx context.Context, c clustersmngr.Client, cluster string, p ctrl.Pipeline, env, revision, prURL string) error {
+	return updatePromotionHistory(ctx, c, cluster, p, func(data map[string]string) error {
+		pending := []pendingApproval{}
+		if err := decodeHistoryKey(data, pendingApprovalsKey, &pending); err != nil {
+			return err
+		}
+
+		records := []approvalRecord{}
+		if err := decodeHistoryKey(data, promotionHistoryKey, &records); err != nil {
+			return err
+		}
+
+		kept := []pendingApproval{}
+		completed := []approvalRecord{}
+
+		for _, a := range pending {
+			if a.Environment != env {
+				kept = append(kept, a)
+				continue
+			}
+
+			if a.Revision == revision {
+				completed = append(completed, approvalRecord{
+					Environment: a.Environment,
+					Revision:    a.Revision,
+					Principal:   a.Principal,
+					Groups:      a.Groups,
+					Timestamp:   a.Timestamp,
+				})
+			}
+		}
+
+		if len(completed) > 0 {
+			completed[len(completed)-1].PullRequestURL = prURL
+		}
+
+		records = append(records, completed...)
+		if len(records) > maxPromotionHistory {
+			records = records[len(records)-maxPromotionHistory:]
+		}
+
+		if err := encodeHistoryKey(data, promotionHistoryKey, records); err != nil {
+			return err
+		}
+
+		return encodeHistoryKey(data, pendingApprovalsKey, kept)
+	})
+}
+
+func (s *server) ListPendingApprovals(ctx context.Context, msg *pb.ListPendingApprovalsRequest) (*pb.ListPendingApprovalsResponse, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	p := ctrl.Pipeline{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      msg.Name,
+			Namespace: msg.Namespace,
+		},
+	}
+
+	if err := c.Get(ctx, s.cluster, client.ObjectKeyFromObject(&p), &p); err != nil {
+		return nil, fmt.Errorf("failed to find pipeline=%s in namespace=%s in cluster=%s: %w", msg.Name, msg.Namespace, s.cluster, err)
+	}
+
+	policy, err := getApprovalPolicy(p)
+	if err != nil {
+		return nil, err
+	}
+
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting server client: %w", err)
+	}
+
+	data, err := getPromotionHistory(ctx, sc, s.cluster, msg.Namespace, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []pendingApproval{}
+	if err := decodeHistoryKey(data, pendingApprovalsKey, &pending); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := []*pb.PendingApproval{}
+
+	for _, e := range p.Spec.Environments {
+		revision := p.Status.GetWaitingApproval(e.Name).Revision
+		if revision == "" {
+			continue
+		}
+
+		envPolicy := EnvironmentApprovalPolicy{}
+		if policy != nil {
+			envPolicy = policy.Environments[e.Name]
+		}
+
+		approvals := []*pb.Approval{}
+		for _, a := range pending {
+			if a.Environment != e.Name || a.Revision != revision || envPolicy.expired(a, now) {
+				continue
+			}
+
+			approvals = append(approvals, &pb.Approval{
+				Principal: a.Principal,
+				Timestamp: a.Timestamp.Format(time.RFC3339),
+				ExpiresAt: envPolicy.expiresAt(a),
+			})
+		}
+
+		result = append(result, &pb.PendingApproval{
+			Environment:       e.Name,
+			Revision:          revision,
+			Approvals:         approvals,
+			RequiredApprovals: int32(envPolicy.requiredApprovals()),
+			ApproverGroups:    envPolicy.ApproverGroups,
+		})
+	}
+
+	return &pb.ListPendingApprovalsResponse{
+		PendingApprovals: result,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/pipetesting"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/pipelines/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApprovePromotionWithPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	devNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	prodNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+
+	var promotions int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&promotions, 1)
+		w.Header().Set("Location", "https://github.com/my-project/pulls/1")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer s.Close()
+
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", s.URL, nil, pipetesting.WithPrincipalFromMetadata())
+
+	hr := createHelmRelease(ctx, t, kclient, "app-1", devNamespace.Name)
+	hr.SetAnnotations(map[string]string{server.RevisionAuthorAnnotation: "carol"})
+	require.NoError(t, kclient.Update(ctx, hr))
+
+	p := newPipeline("pipe-1", pipelineNamespace.Name, devNamespace.Name, "dev", hr,
+		withEnvironment("prod", []ctrl.Target{{Namespace: prodNamespace.Name}}, nil))
+	p.SetAnnotations(map[string]string{
+		server.ApprovalPolicyAnnotation: `
+environments:
+  prod:
+    approverGroups: [release-managers]
+    requiredApprovals: 2
+    approvalTTL: 1h
+`,
+	})
+	p.Status.Environments = map[string]*ctrl.EnvironmentStatus{
+		"prod": {
+			WaitingApproval: ctrl.WaitingApproval{
+				Revision: hr.Status.LastAppliedRevision,
+			},
+		},
+	}
+	require.NoError(t, kclient.Create(ctx, p))
+
+	approve := func(ctx context.Context) (*pb.ApprovePromotionResponse, error) {
+		return serverClient.ApprovePromotion(ctx, &pb.ApprovePromotionRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "prod",
+			Revision:  hr.Status.LastAppliedRevision,
+		})
+	}
+
+	t.Run("approver not in the approver groups", func(t *testing.T) {
+		_, err := approve(pipetesting.AsUser(ctx, "mallory", "developers"))
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("unauthenticated user", func(t *testing.T) {
+		_, err := approve(ctx)
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("author cannot approve their own revision", func(t *testing.T) {
+		_, err := approve(pipetesting.AsUser(ctx, "carol", "release-managers"))
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("revision not waiting for approval", func(t *testing.T) {
+		_, err := serverClient.ApprovePromotion(pipetesting.AsUser(ctx, "alice", "release-managers"), &pb.ApprovePromotionRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "prod",
+			Revision:  "9.9.9",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("promotes once enough approvals are collected", func(t *testing.T) {
+		resp, err := approve(pipetesting.AsUser(ctx, "alice", "release-managers"))
+		require.NoError(t, err)
+		assert.False(t, resp.Promoted)
+		assert.Equal(t, int32(1), resp.Approvals)
+		assert.Equal(t, int32(2), resp.RequiredApprovals)
+
+		// approving twice does not count twice
+		resp, err = approve(pipetesting.AsUser(ctx, "alice", "release-managers"))
+		require.NoError(t, err)
+		assert.False(t, resp.Promoted)
+		assert.Equal(t, int32(1), resp.Approvals)
+
+		pending, err := serverClient.ListPendingApprovals(ctx, &pb.ListPendingApprovalsRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, pending.PendingApprovals, 1)
+		assert.Equal(t, "prod", pending.PendingApprovals[0].Environment)
+		assert.Equal(t, int32(2), pending.PendingApprovals[0].RequiredApprovals)
+		assert.Equal(t, []string{"release-managers"}, pending.PendingApprovals[0].ApproverGroups)
+		require.Len(t, pending.PendingApprovals[0].Approvals, 1)
+		assert.Equal(t, "alice", pending.PendingApprovals[0].Approvals[0].Principal)
+		assert.NotEmpty(t, pending.PendingApprovals[0].Approvals[0].ExpiresAt)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&promotions))
+
+		resp, err = approve(pipetesting.AsUser(ctx, "bob", "release-managers"))
+		require.NoError(t, err)
+		assert.True(t, resp.Promoted)
+		assert.Equal(t, int32(2), resp.Approvals)
+		assert.Equal(t, "https://github.com/my-project/pulls/1", resp.PullRequestUrl)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&promotions))
+
+		pending, err = serverClient.ListPendingApprovals(ctx, &pb.ListPendingApprovalsRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+		})
+		require.NoError(t, err)
+		require.Len(t, pending.PendingApprovals, 1)
+		assert.Empty(t, pending.PendingApprovals[0].Approvals)
+
+		history, err := serverClient.ListPromotionHistory(ctx, &pb.ListPromotionHistoryRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "prod",
+		})
+		require.NoError(t, err)
+
+		approvers := []string{}
+		for _, e := range history.Environments[0].Entries {
+			if e.Type == server.HistoryEntryApproved {
+				approvers = append(approvers, e.Principal)
+			}
+		}
+		assert.ElementsMatch(t, []string{"alice", "bob"}, approvers)
+	})
+}
+
+func TestApprovePromotionWithPolicy_UnknownAuthor(t *testing.T) {
+	ctx := context.Background()
+
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	devNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	prodNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+
+	var promotions int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&promotions, 1)
+		w.Header().Set("Location", "https://github.com/my-project/pulls/1")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer s.Close()
+
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", s.URL, nil, pipetesting.WithPrincipalFromMetadata())
+
+	// The application has no revision author annotation
+	hr := createHelmRelease(ctx, t, kclient, "app-1", devNamespace.Name)
+
+	newPolicyPipeline := func(name, policy string) *ctrl.Pipeline {
+		p := newPipeline(name, pipelineNamespace.Name, devNamespace.Name, "dev", hr,
+			withEnvironment("prod", []ctrl.Target{{Namespace: prodNamespace.Name}}, nil))
+		p.SetAnnotations(map[string]string{server.ApprovalPolicyAnnotation: policy})
+		p.Status.Environments = map[string]*ctrl.EnvironmentStatus{
+			"prod": {
+				WaitingApproval: ctrl.WaitingApproval{
+					Revision: hr.Status.LastAppliedRevision,
+				},
+			},
+		}
+		require.NoError(t, kclient.Create(ctx, p))
+
+		return p
+	}
+
+	approve := func(p *ctrl.Pipeline) (*pb.ApprovePromotionResponse, error) {
+		return serverClient.ApprovePromotion(pipetesting.AsUser(ctx, "alice", "release-managers"), &pb.ApprovePromotionRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "prod",
+			Revision:  hr.Status.LastAppliedRevision,
+		})
+	}
+
+	t.Run("self-approval is not allowed", func(t *testing.T) {
+		p := newPolicyPipeline("pipe-1", `
+environments:
+  prod:
+    approverGroups: [release-managers]
+`)
+
+		_, err := approve(p)
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&promotions))
+	})
+
+	t.Run("self-approval is allowed", func(t *testing.T) {
+		p := newPolicyPipeline("pipe-2", `
+environments:
+  prod:
+    approverGroups: [release-managers]
+    allowSelfApproval: true
+`)
+
+		resp, err := approve(p)
+		require.NoError(t, err)
+		assert.True(t, resp.Promoted)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&promotions))
+	})
+}
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	csgit "github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxPreviewFiles bounds the number of manifests read from the repository
+// when previewing a promotion.
+const maxPreviewFiles = 500
+
+// previewConcurrency bounds the number of manifests read from the repository
+// at the same time.
+const previewConcurrency = 8
+
+func (s *server) PreviewPromotion(ctx context.Context, msg *pb.PreviewPromotionRequest) (*pb.PreviewPromotionResponse, error) {
+	if msg.Revision == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "revision is required")
+	}
+
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	p := ctrl.Pipeline{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      msg.Name,
+			Namespace: msg.Namespace,
+		},
+	}
+
+	if err := c.Get(ctx, s.cluster, client.ObjectKeyFromObject(&p), &p); err != nil {
+		return nil, fmt.Errorf("failed to find pipeline=%s in namespace=%s in cluster=%s: %w", msg.Name, msg.Namespace, s.cluster, err)
+	}
+
+	if !hasEnvironment(p, msg.Env) {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "environment=%s not found in pipeline=%s in namespace=%s", msg.Env, msg.Name, msg.Namespace)
+	}
+
+	promotion := p.Spec.GetPromotion(msg.Env)
+	if promotion == nil || promotion.Strategy.PullRequest == nil {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "environment=%s of pipeline=%s is not promoted through pull requests", msg.Env, msg.Name)
+	}
+
+	pr := promotion.Strategy.PullRequest
+
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed getting server client: %w", err)
+	}
+
+	// getting provider token from pipeline definition
+	var secret corev1.Secret
+	if err := sc.Get(ctx, s.cluster, client.ObjectKey{Namespace: msg.Namespace, Name: pr.SecretRef.Name}, &secret); err != nil {
+		return nil, fmt.Errorf("failed to fetch Secret: %w", err)
+	}
+
+	u, err := url.Parse(pr.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	gp := csgit.GitProvider{
+		Token:    string(secret.Data["token"]),
+		Type:     pr.Type.String(),
+		Hostname: u.Hostname(),
+	}
+
+	entries, err := s.gitProvider.GetTreeList(ctx, gp, pr.URL, pr.BaseBranch, "", true)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing files of %s@%s: %w", pr.URL, pr.BaseBranch, err)
+	}
+
+	setter := promotionSetterName(p, msg.Env)
+	files := []*pb.PromotionFileChange{}
+	previewErrors := []string{}
+
+	paths := []string{}
+	for _, entry := range entries {
+		if entry.Type == "tree" || !isManifest(entry.Path) {
+			continue
+		}
+
+		if len(paths) == maxPreviewFiles {
+			previewErrors = append(previewErrors, fmt.Sprintf("only the first %d manifests of the repository were previewed", maxPreviewFiles))
+			break
+		}
+		paths = append(paths, entry.Path)
+	}
+
+	contents, err := s.readPreviewFiles(ctx, gp, pr.URL, pr.BaseBranch, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, path := range paths {
+		if contents[i].err != nil {
+			previewErrors = append(previewErrors, contents[i].err.Error())
+			continue
+		}
+
+		content := contents[i].content
+		updated, changes := applyPromotionMarkers(content, setter, msg.Revision)
+		if updated == content {
+			continue
+		}
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(content),
+			B:        difflib.SplitLines(updated),
+			FromFile: "a/" + path,
+			ToFile:   "b/" + path,
+			Context:  3,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed computing diff of %s: %w", path, err)
+		}
+
+		files = append(files, &pb.PromotionFileChange{
+			Path:    path,
+			Diff:    diff,
+			Changes: int32(changes),
+		})
+	}
+
+	return &pb.PreviewPromotionResponse{
+		RepositoryUrl: pr.URL,
+		BaseBranch:    pr.BaseBranch,
+		Files:         files,
+		Errors:        previewErrors,
+	}, nil
+}
+
+type previewFile struct {
+	content string
+	err     error
+}
+
+// readPreviewFiles reads the contents of the files at ref, at most
+// previewConcurrency at a time, in the order of paths. The first file is read
+// on its own so that a provider that can't read file contents fails the
+// preview once with Unimplemented instead of once per file.
+func (s *server) readPreviewFiles(ctx context.Context, gp csgit.GitProvider, repoURL, ref string, paths []string) ([]previewFile, error) {
+	contents := make([]previewFile, len(paths))
+	if len(paths) == 0 {
+		return contents, nil
+	}
+
+	contents[0].content, contents[0].err = s.gitProvider.GetFileContents(ctx, gp, repoURL, paths[0], ref)
+	if errors.Is(contents[0].err, git.ErrNotSupported) {
+		return nil, grpcStatus.Errorf(codes.Unimplemented, "previewing promotions is not supported for %s repositories: %s", gp.Type, contents[0].err)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, previewConcurrency)
+
+	for i := 1; i < len(paths); i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			contents[i].content, contents[i].err = s.gitProvider.GetFileContents(ctx, gp, repoURL, paths[i], ref)
+		}(i)
+	}
+
+	wg.Wait()
+
+	return contents, nil
+}
+
+func hasEnvironment(p ctrl.Pipeline, env string) bool {
+	for _, e := range p.Spec.Environments {
+		if e.Name == env {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isManifest(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// promotionSetterName returns the name the pipeline controller uses to find
+// the fields to update when promoting into an environment, fields are marked
+// with a comment like
+//
+//	version: 0.1.0 # {"$promotion": "flux-system:podinfo:prod"}
+func promotionSetterName(p ctrl.Pipeline, env string) string {
+	return fmt.Sprintf("%s:%s:%s", p.Namespace, p.Name, env)
+}
+
+// applyPromotionMarkers sets the value of every field marked for setter to
+// revision, the way the pipeline controller does when creating the pull
+// request. It returns the updated content and the number of fields changed.
+func applyPromotionMarkers(content, setter, revision string) (string, int) {
+	marker := regexp.MustCompile(`^(\s*(?:-\s+)?(?:[^#\s][^#]*?:\s+)?)("[^"]*"|'[^']*'|[^\s#]+)(\s+#\s*\{\s*"\$promotion"\s*:\s*"` + regexp.QuoteMeta(setter) + `"\s*\}.*)$`)
+
+	lines := strings.Split(content, "\n")
+	changes := 0
+
+	for i, line := range lines {
+		m := marker.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		value := revision
+		switch m[2][0] {
+		case '"':
+			value = `"` + revision + `"`
+		case '\'':
+			value = "'" + revision + "'"
+		}
+
+		if m[2] == value {
+			continue
+		}
+
+		lines[i] = m[1] + value + m[3]
+		changes++
+	}
+
+	return strings.Join(lines, "\n"), changes
+}
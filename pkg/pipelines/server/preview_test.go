@@ -0,0 +1,113 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ctrl "github.com/weaveworks/pipeline-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/git/gitfakes"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/pipetesting"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/pipelines"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPreviewPromotion(t *testing.T) {
+	ctx := context.Background()
+	kclient := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	pipelineNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	targetNamespace := pipetesting.NewNamespace(ctx, t, kclient)
+	secret := createSecret(ctx, t, kclient, "github-token", pipelineNamespace.Name, map[string][]byte{
+		"token": []byte("github-token"),
+	})
+	hr := createHelmRelease(ctx, t, kclient, "app-1", targetNamespace.Name)
+
+	p := newPipeline("pipe-1", pipelineNamespace.Name, targetNamespace.Name, "dev", hr,
+		withEnvironment("prod", []ctrl.Target{{Namespace: targetNamespace.Name}}, &ctrl.Promotion{
+			Strategy: ctrl.Strategy{
+				PullRequest: &ctrl.PullRequestPromotion{
+					Type:       ctrl.Github,
+					URL:        "https://github.com/my-org/fleet",
+					BaseBranch: "main",
+					SecretRef: meta.LocalObjectReference{
+						Name: secret.Name,
+					},
+				},
+			},
+		}))
+	require.NoError(t, kclient.Create(ctx, p))
+
+	marker := fmt.Sprintf(`# {"$promotion": "%s:pipe-1:prod"}`, pipelineNamespace.Name)
+
+	gitProvider := &gitfakes.FakeGitProvider{
+		OriginalFiles: []string{"prod/app.yaml", "dev/app.yaml", "README.md"},
+		Files: map[string]string{
+			"prod/app.yaml": "spec:\n  chart:\n    spec:\n      version: 0.1.2 " + marker + "\n  values:\n    tag: \"0.1.2\" " + marker + "\n",
+			"dev/app.yaml":  "spec:\n  chart:\n    spec:\n      version: 0.1.2 # {\"$promotion\": \"other:pipe-1:prod\"}\n",
+		},
+	}
+
+	factory := grpctesting.MakeClustersManager(kclient, nil, "management", fmt.Sprintf("%s/cluster-1", pipelineNamespace.Name))
+	serverClient := pipetesting.SetupServer(t, factory, kclient, "management", "", gitProvider)
+
+	res, err := serverClient.PreviewPromotion(ctx, &pb.PreviewPromotionRequest{
+		Name:      p.Name,
+		Namespace: pipelineNamespace.Name,
+		Env:       "prod",
+		Revision:  "0.2.0",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Errors)
+	assert.Equal(t, "https://github.com/my-org/fleet", res.RepositoryUrl)
+	assert.Equal(t, "main", res.BaseBranch)
+
+	require.Len(t, res.Files, 1)
+	assert.Equal(t, "prod/app.yaml", res.Files[0].Path)
+	assert.Equal(t, int32(2), res.Files[0].Changes)
+	assert.Contains(t, res.Files[0].Diff, "-      version: 0.1.2 "+marker)
+	assert.Contains(t, res.Files[0].Diff, "+      version: 0.2.0 "+marker)
+	assert.Contains(t, res.Files[0].Diff, "+    tag: \"0.2.0\" "+marker)
+
+	t.Run("environment without pull request promotion", func(t *testing.T) {
+		_, err := serverClient.PreviewPromotion(ctx, &pb.PreviewPromotionRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "dev",
+			Revision:  "0.2.0",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("provider without file contents", func(t *testing.T) {
+		gitProvider.FileContentsErr = fmt.Errorf("getting file contents is %w by bitbucket-server", git.ErrNotSupported)
+		defer func() { gitProvider.FileContentsErr = nil }()
+
+		_, err := serverClient.PreviewPromotion(ctx, &pb.PreviewPromotionRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "prod",
+			Revision:  "0.2.0",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("missing revision", func(t *testing.T) {
+		_, err := serverClient.PreviewPromotion(ctx, &pb.PreviewPromotionRequest{
+			Name:      p.Name,
+			Namespace: pipelineNamespace.Name,
+			Env:       "prod",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
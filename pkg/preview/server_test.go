@@ -941,3 +941,11 @@ func (p *TestProvider) GetTreeList(ctx context.Context, repoUrl, sha, path strin
 func (p *TestProvider) ListPullRequests(ctx context.Context, repoUrl string) ([]*git.PullRequest, error) {
 	return nil, nil
 }
+
+func (p *TestProvider) ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*git.CommitInfo, error) {
+	return nil, nil
+}
+
+func (p *TestProvider) GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error) {
+	return "", nil
+}
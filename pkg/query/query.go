@@ -20,6 +20,13 @@ type QueryService interface {
 	GetAccessRules(ctx context.Context) ([]models.AccessRule, error)
 }
 
+// ClusterScopedQuery is a query whose results are limited to the objects of some clusters.
+type ClusterScopedQuery interface {
+	store.Query
+	// MatchesCluster returns true if the objects of the cluster are part of the results.
+	MatchesCluster(cluster string) bool
+}
+
 // Authorizer creates an authorization predicate when given a cluster name.
 type Authorizer interface {
 	ObjectAuthorizer(roles []models.Role, rolebindings []models.RoleBinding, principal *auth.UserPrincipal, cluster string) func(models.Object) (bool, error)
@@ -112,6 +119,8 @@ func (q *qs) RunQuery(ctx context.Context, query store.Query, opts store.QueryOp
 	// keep track of any cluster authorize predicate we might need again
 	perClusterAllowed := map[string](func(models.Object) (bool, error)){}
 
+	clusterQuery, isClusterScoped := query.(ClusterScopedQuery)
+
 	for iter.Next() {
 		if limit > 0 && len(result) == int(limit) {
 			// Limit is set in the query and reached.
@@ -125,6 +134,10 @@ func (q *qs) RunQuery(ctx context.Context, query store.Query, opts store.QueryOp
 			continue
 		}
 
+		if isClusterScoped && !clusterQuery.MatchesCluster(obj.Cluster) {
+			continue
+		}
+
 		tenantID := fmt.Sprintf("%s/%s", obj.Cluster, obj.Namespace)
 		if tenantName, ok := tenantLookup[tenantID]; ok {
 			obj.Tenant = tenantName
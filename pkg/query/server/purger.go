@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+)
+
+// ErrNotCollecting is returned when purging a cluster while the Explorer is not collecting objects.
+var ErrNotCollecting = errors.New("the explorer is not collecting objects")
+
+// ClusterPurger deletes the data of clusters from the Explorer, e.g. when they are decommissioned.
+// It purges nothing until a query server collecting objects is created with it.
+type ClusterPurger struct {
+	mu    sync.RWMutex
+	store store.Store
+	index store.IndexWriter
+}
+
+// NewClusterPurger creates a ClusterPurger to set in the ServerOpts.
+func NewClusterPurger() *ClusterPurger {
+	return &ClusterPurger{}
+}
+
+func (p *ClusterPurger) set(s store.Store, idx store.IndexWriter) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.store, p.index = s, idx
+}
+
+// PurgeCluster deletes the objects, access rules and tenants of a cluster from the store and the
+// index. The collectors add them again while the cluster is connected.
+func (p *ClusterPurger) PurgeCluster(ctx context.Context, cluster string) error {
+	p.mu.RLock()
+	s, idx := p.store, p.index
+	p.mu.RUnlock()
+
+	if s == nil {
+		return ErrNotCollecting
+	}
+
+	clusters := []string{cluster}
+	if err := s.DeleteAllObjects(ctx, clusters); err != nil {
+		return fmt.Errorf("failed to delete objects: %w", err)
+	}
+	if err := idx.RemoveByQuery(ctx, fmt.Sprintf("+cluster:%s", cluster)); err != nil {
+		return fmt.Errorf("failed to delete objects from index: %w", err)
+	}
+	if err := s.DeleteAllRoles(ctx, clusters); err != nil {
+		return fmt.Errorf("failed to delete roles: %w", err)
+	}
+	if err := s.DeleteAllRoleBindings(ctx, clusters); err != nil {
+		return fmt.Errorf("failed to delete role bindings: %w", err)
+	}
+
+	tenants, err := s.GetTenants(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tenants: %w", err)
+	}
+	clusterTenants := []models.Tenant{}
+	for _, t := range tenants {
+		if t.ClusterName == cluster {
+			clusterTenants = append(clusterTenants, t)
+		}
+	}
+	if len(clusterTenants) > 0 {
+		if err := s.DeleteTenants(ctx, clusterTenants); err != nil {
+			return fmt.Errorf("failed to delete tenants: %w", err)
+		}
+	}
+
+	return nil
+}
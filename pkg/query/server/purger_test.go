@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+)
+
+func TestClusterPurger(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	s, err := store.NewStore(store.StorageBackendSQLite, t.TempDir(), logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	idx, err := store.NewIndexer(s, t.TempDir(), logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	objects := []models.Object{}
+	roles := []models.Role{}
+	tenants := []models.Tenant{}
+	for _, cluster := range []string{"management", "default/leaf"} {
+		objects = append(objects, models.Object{
+			Cluster:    cluster,
+			Kind:       "Kustomization",
+			Name:       "flux-system",
+			Namespace:  "flux-system",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Category:   "automation",
+		})
+		roles = append(roles, models.Role{
+			Cluster:   cluster,
+			Kind:      "ClusterRole",
+			Name:      "viewer",
+			Namespace: "",
+			PolicyRules: []models.PolicyRule{
+				{APIGroups: "*", Resources: "*", Verbs: "get,list"},
+			},
+		})
+		tenants = append(tenants, models.Tenant{ClusterName: cluster, Name: "team-a", Namespace: "team-a"})
+	}
+	// more objects than a search of the index returns by default
+	for i := 0; i < 15; i++ {
+		objects = append(objects, models.Object{
+			Cluster:    "default/leaf",
+			Kind:       "Kustomization",
+			Name:       fmt.Sprintf("app-%d", i),
+			Namespace:  "flux-system",
+			APIGroup:   "kustomize.toolkit.fluxcd.io",
+			APIVersion: "v1",
+			Category:   "automation",
+		})
+	}
+	g.Expect(s.StoreObjects(ctx, objects)).To(Succeed())
+	g.Expect(idx.Add(ctx, objects)).To(Succeed())
+	g.Expect(s.StoreRoles(ctx, roles)).To(Succeed())
+	g.Expect(s.StoreTenants(ctx, tenants)).To(Succeed())
+
+	purger := NewClusterPurger()
+	g.Expect(purger.PurgeCluster(ctx, "default/leaf")).To(MatchError(ErrNotCollecting))
+
+	purger.set(s, idx)
+	g.Expect(purger.PurgeCluster(ctx, "default/leaf")).To(Succeed())
+
+	iter, err := s.GetAllObjects(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	storedObjects, err := iter.All()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(storedObjects).To(HaveLen(1))
+	g.Expect(storedObjects[0].Cluster).To(Equal("management"))
+
+	iter, err = idx.Search(ctx, &pb.DoQueryRequest{}, &pb.DoQueryRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	indexedObjects, err := iter.All()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(indexedObjects).To(HaveLen(1))
+	g.Expect(indexedObjects[0].Cluster).To(Equal("management"))
+
+	storedRoles, err := s.GetRoles(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(storedRoles).To(HaveLen(1))
+	g.Expect(storedRoles[0].Cluster).To(Equal("management"))
+
+	storedTenants, err := s.GetTenants(ctx)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(storedTenants).To(HaveLen(1))
+	g.Expect(storedTenants[0].ClusterName).To(Equal("management"))
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+)
+
+// Querier queries the objects collected by the Explorer from other services, e.g. to export the
+// inventory of the clusters. It queries nothing until a query server collecting objects is created with it.
+type Querier struct {
+	mu     sync.RWMutex
+	server *server
+}
+
+// NewQuerier creates a Querier to set in the ServerOpts.
+func NewQuerier() *Querier {
+	return &Querier{}
+}
+
+func (q *Querier) set(s *server) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.server = s
+}
+
+// DoQuery runs a query as the principal of the context, like the DoQuery endpoint of the query server.
+func (q *Querier) DoQuery(ctx context.Context, msg *pb.DoQueryRequest) (*pb.DoQueryResponse, error) {
+	q.mu.RLock()
+	s := q.server
+	q.mu.RUnlock()
+
+	if s == nil {
+		return nil, ErrNotCollecting
+	}
+
+	return s.DoQuery(ctx, msg)
+}
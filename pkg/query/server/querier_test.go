@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/query"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/internal/models"
+	store "github.com/weaveworks/weave-gitops-enterprise/pkg/query/store"
+)
+
+type fakeQueryService struct {
+	objects []models.Object
+}
+
+func (f fakeQueryService) RunQuery(ctx context.Context, q store.Query, opts store.QueryOption) ([]models.Object, error) {
+	return f.objects, nil
+}
+
+func (f fakeQueryService) ListFacets(ctx context.Context, cat configuration.ObjectCategory) (store.Facets, error) {
+	return nil, nil
+}
+
+func (f fakeQueryService) GetAccessRules(ctx context.Context) ([]models.AccessRule, error) {
+	return nil, nil
+}
+
+func TestQuerier(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	querier := NewQuerier()
+	_, err := querier.DoQuery(ctx, &pb.DoQueryRequest{Filters: []string{"kind:HelmRelease"}})
+	g.Expect(err).To(MatchError(ErrNotCollecting))
+
+	querier.set(&server{qs: fakeQueryService{objects: []models.Object{
+		{Cluster: "management", Kind: "HelmRelease", Name: "podinfo", Namespace: "flux-system"},
+	}}})
+
+	res, err := querier.DoQuery(ctx, &pb.DoQueryRequest{Filters: []string{"kind:HelmRelease"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Objects).To(HaveLen(1))
+	g.Expect(res.Objects[0].Cluster).To(Equal("management"))
+	g.Expect(res.Objects[0].Name).To(Equal("podinfo"))
+}
@@ -2,14 +2,19 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"time"
 
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/cleaner"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/configuration"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/query/tenantscollector"
 	"github.com/weaveworks/weave-gitops/core/logger"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	"k8s.io/client-go/discovery"
 
 	"github.com/go-logr/logr"
@@ -41,6 +46,9 @@ type server struct {
 	cleaner          cleaner.ObjectCleaner
 	enabledFor       []string
 	clustersManager  clustersmngr.ClustersManager
+
+	managementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher
+	cluster           string
 }
 
 func (s *server) StopCollection() error {
@@ -73,10 +81,36 @@ type ServerOpts struct {
 	ServiceAccount      collector.ImpersonateServiceAccount
 	EnableObjectCleaner bool
 	EnabledFor          []string
+	// required to resolve cluster selectors
+	ManagementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher
+	// name of the management cluster
+	Cluster string
+	// set up to purge the data of the collected clusters, optional
+	ClusterPurger *ClusterPurger
+	// set up to query the collected objects from other services, optional
+	Querier *Querier
 }
 
 func (s *server) DoQuery(ctx context.Context, msg *pb.DoQueryRequest) (*pb.DoQueryResponse, error) {
-	objs, err := s.qs.RunQuery(ctx, msg, msg)
+	var q store.Query = msg
+
+	if msg.ClusterSelector != "" {
+		if s.managementFetcher == nil {
+			return nil, grpcStatus.Error(codes.Unimplemented, "cluster selectors are not enabled")
+		}
+
+		clusterSelector, err := fetcher.SelectClusters(ctx, s.managementFetcher, s.cluster, msg.ClusterSelector)
+		if err != nil {
+			if errors.Is(err, fetcher.ErrInvalidClusterSelector) {
+				return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, fmt.Errorf("failed to select clusters: %w", err)
+		}
+
+		q = clusterSelectorQuery{DoQueryRequest: msg, clusterSelector: clusterSelector}
+	}
+
+	objs, err := s.qs.RunQuery(ctx, q, msg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to run query: %w", err)
 	}
@@ -86,6 +120,16 @@ func (s *server) DoQuery(ctx context.Context, msg *pb.DoQueryRequest) (*pb.DoQue
 	}, nil
 }
 
+// clusterSelectorQuery limits a query to the clusters matching its cluster selector
+type clusterSelectorQuery struct {
+	*pb.DoQueryRequest
+	clusterSelector *fetcher.ClusterSelector
+}
+
+func (q clusterSelectorQuery) MatchesCluster(cluster string) bool {
+	return q.clusterSelector.Matches(cluster)
+}
+
 func (s *server) DebugGetAccessRules(ctx context.Context, msg *pb.DebugGetAccessRulesRequest) (*pb.DebugGetAccessRulesResponse, error) {
 	rules, err := s.qs.GetAccessRules(ctx)
 	if err != nil {
@@ -232,9 +276,11 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 	debug.Info("query service created")
 
 	serv := &server{
-		qs:              qs,
-		enabledFor:      opts.EnabledFor,
-		clustersManager: opts.ClustersManager,
+		qs:                qs,
+		enabledFor:        opts.EnabledFor,
+		clustersManager:   opts.ClustersManager,
+		managementFetcher: opts.ManagementFetcher,
+		cluster:           opts.Cluster,
 	}
 
 	if !opts.SkipCollection {
@@ -302,6 +348,14 @@ func NewServer(opts ServerOpts) (_ pb.QueryServer, _ func() error, retErr error)
 			serv.cleaner = oc
 		}
 
+		if opts.ClusterPurger != nil {
+			opts.ClusterPurger.set(s, idx)
+		}
+
+		if opts.Querier != nil {
+			opts.Querier.set(serv)
+		}
+
 		serv.arc = rulesCollector
 		serv.objs = objsCollector
 		serv.cancelCollection = cancel
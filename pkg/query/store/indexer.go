@@ -160,26 +160,36 @@ func (i *bleveIndexer) Remove(ctx context.Context, objects []models.Object) (err
 	return nil
 }
 
+// removeByQueryPageSize is how many matches of a query are removed at a time
+const removeByQueryPageSize = 1000
+
 func (i *bleveIndexer) RemoveByQuery(ctx context.Context, q string) (err error) {
 	// metrics
 	metrics.IndexerAddInflightRequests(metrics.RemoveByQueryAction, 1)
 	defer recordIndexerMetrics(metrics.RemoveByQueryAction, time.Now(), err)
 
 	query := bleve.NewQueryStringQuery(q)
-	req := bleve.NewSearchRequest(query)
 
-	result, err := i.idx.Search(req)
-	if err != nil {
-		return fmt.Errorf("failed to search index: %w", err)
-	}
+	// the search returns a page of matches, so they are searched again until none is left
+	for {
+		req := bleve.NewSearchRequestOptions(query, removeByQueryPageSize, 0, false)
 
-	for _, hit := range result.Hits {
-		if err := i.idx.Delete(hit.ID); err != nil {
-			return fmt.Errorf("failed to delete object: %w", err)
+		result, err := i.idx.Search(req)
+		if err != nil {
+			return fmt.Errorf("failed to search index: %w", err)
+		}
+		if len(result.Hits) == 0 {
+			return nil
 		}
-	}
 
-	return nil
+		batch := i.idx.NewBatch()
+		for _, hit := range result.Hits {
+			batch.Delete(hit.ID)
+		}
+		if err := i.idx.Batch(batch); err != nil {
+			return fmt.Errorf("failed to delete objects: %w", err)
+		}
+	}
 }
 
 func (i *bleveIndexer) Search(ctx context.Context, q Query, opts QueryOption) (it Iterator, err error) {
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"fmt"
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
@@ -164,6 +165,36 @@ func TestIndexer_RemoveByQuery(t *testing.T) {
 			},
 			expected: []string{"otherName"},
 		},
+		{
+			name:  "removes more matches than a search returns by default",
+			query: "+cluster:management",
+			objects: func() []models.Object {
+				objects := []models.Object{
+					{
+						Cluster:    "othercluster",
+						Kind:       "Namespace",
+						Name:       "otherName",
+						APIGroup:   "anyGroup",
+						APIVersion: "anyVersion",
+						Category:   "automation",
+						Namespace:  "anyNamespace",
+					},
+				}
+				for i := 0; i < 25; i++ {
+					objects = append(objects, models.Object{
+						Cluster:    "management",
+						Kind:       "Namespace",
+						Name:       fmt.Sprintf("name-%d", i),
+						APIGroup:   "anyGroup",
+						APIVersion: "anyVersion",
+						Category:   "automation",
+						Namespace:  "anyNamespace",
+					})
+				}
+				return objects
+			}(),
+			expected: []string{"otherName"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -193,7 +224,7 @@ func TestIndexer_RemoveByQuery(t *testing.T) {
 			// Ensure things got written to the index
 			all, err := iter.All()
 			g.Expect(err).NotTo(HaveOccurred())
-			g.Expect(len(all)).To(Equal(2))
+			g.Expect(len(all)).To(Equal(len(tt.objects)))
 
 			err = idx.RemoveByQuery(context.Background(), tt.query)
 			g.Expect(err).NotTo(HaveOccurred())
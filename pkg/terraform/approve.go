@@ -0,0 +1,489 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/spf13/viper"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/gitauth/server/gitproviders"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/kustomize/kyaml/kio"
+	"sigs.k8s.io/kustomize/kyaml/yaml"
+)
+
+const (
+	PlanApproved = "approved"
+	PlanRejected = "rejected"
+
+	planDecisionsKey = "decisions"
+	// maxManifestSearch bounds the number of files read from the repository
+	// when looking for the manifest of an object.
+	maxManifestSearch = 200
+)
+
+// planDecision records who approved or rejected a plan.
+type planDecision struct {
+	PlanID         string    `json:"planID"`
+	Decision       string    `json:"decision"`
+	Principal      string    `json:"principal,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+	Reason         string    `json:"reason,omitempty"`
+	PullRequestURL string    `json:"pullRequestURL,omitempty"`
+}
+
+func (d *planDecision) toPB() *pb.TerraformPlanDecision {
+	if d == nil {
+		return nil
+	}
+
+	return &pb.TerraformPlanDecision{
+		Decision:       d.Decision,
+		Principal:      d.Principal,
+		Timestamp:      d.Timestamp.Format(time.RFC3339),
+		Reason:         d.Reason,
+		PullRequestUrl: d.PullRequestURL,
+	}
+}
+
+func (s *server) ApproveTerraformPlan(ctx context.Context, msg *pb.ApproveTerraformPlanRequest) (*pb.ApproveTerraformPlanResponse, error) {
+	clustersClient, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	obj, err := getPendingPlanObject(ctx, clustersClient, msg.ClusterName, msg.Name, msg.Namespace, msg.PlanId)
+	if err != nil {
+		return nil, err
+	}
+
+	planID := obj.Status.Plan.Pending
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("getting scoped client for cluster %s: %w", msg.ClusterName, err)
+	}
+
+	patch := client.MergeFrom(obj.DeepCopy())
+	obj.Spec.ApprovePlan = planID
+
+	decision := planDecision{
+		PlanID:    planID,
+		Decision:  PlanApproved,
+		Principal: principalID(ctx),
+		Timestamp: time.Now().UTC(),
+	}
+
+	if !msg.CreatePullRequest {
+		if err := c.Patch(ctx, obj, patch); err != nil {
+			return nil, patchError(obj, err)
+		}
+	} else {
+		// Make sure the caller would be allowed to approve the plan directly.
+		if err := c.Patch(ctx, obj, patch, client.DryRunAll); err != nil {
+			return nil, patchError(obj, err)
+		}
+
+		prURL, err := s.createApprovalPullRequest(ctx, clustersClient, msg.ClusterName, obj, msg.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		decision.PullRequestURL = prURL
+	}
+
+	if err := s.recordPlanDecision(ctx, msg.ClusterName, obj, decision); err != nil {
+		s.log.Error(err, "failed to record terraform plan approval", "name", msg.Name, "namespace", msg.Namespace, "cluster", msg.ClusterName)
+	}
+
+	return &pb.ApproveTerraformPlanResponse{
+		PlanId:         planID,
+		PullRequestUrl: decision.PullRequestURL,
+	}, nil
+}
+
+func (s *server) RejectTerraformPlan(ctx context.Context, msg *pb.RejectTerraformPlanRequest) (*pb.RejectTerraformPlanResponse, error) {
+	clustersClient, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	obj, err := getPendingPlanObject(ctx, clustersClient, msg.ClusterName, msg.Name, msg.Namespace, msg.PlanId)
+	if err != nil {
+		return nil, err
+	}
+
+	planID := obj.Status.Plan.Pending
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("getting scoped client for cluster %s: %w", msg.ClusterName, err)
+	}
+
+	// The patch is sent even if the plan was not approved, so that only
+	// users allowed to approve plans can reject them.
+	patch := client.MergeFrom(obj.DeepCopy())
+	if obj.Spec.ApprovePlan != "" && strings.HasPrefix(planID, obj.Spec.ApprovePlan) {
+		obj.Spec.ApprovePlan = ""
+	}
+
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return nil, patchError(obj, err)
+	}
+
+	decision := planDecision{
+		PlanID:    planID,
+		Decision:  PlanRejected,
+		Principal: principalID(ctx),
+		Timestamp: time.Now().UTC(),
+		Reason:    msg.Reason,
+	}
+
+	if err := s.recordPlanDecision(ctx, msg.ClusterName, obj, decision); err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectTerraformPlanResponse{PlanId: planID}, nil
+}
+
+// getPendingPlanObject returns a Terraform object waiting for its plan to be
+// approved. If planID is set it must match the pending plan.
+func getPendingPlanObject(ctx context.Context, c clustersmngr.Client, cluster, name, namespace, planID string) (*tfctrl.Terraform, error) {
+	if name == "" || namespace == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "object name or namespace is empty")
+	}
+
+	obj := &tfctrl.Terraform{}
+	if err := c.Get(ctx, cluster, types.NamespacedName{Name: name, Namespace: namespace}, obj); err != nil {
+		return nil, fmt.Errorf("getting object with name %s in namespace %s: %w", name, namespace, err)
+	}
+
+	if obj.Spec.ApprovePlan == "auto" {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "object %s in namespace %s applies its plans automatically", name, namespace)
+	}
+
+	if obj.Status.Plan.Pending == "" {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "object %s in namespace %s has no pending plan", name, namespace)
+	}
+
+	if planID != "" && planID != obj.Status.Plan.Pending {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "plan %s is not the pending plan of object %s in namespace %s", planID, name, namespace)
+	}
+
+	return obj, nil
+}
+
+func patchError(obj *tfctrl.Terraform, err error) error {
+	if apierrors.IsForbidden(err) {
+		return grpcStatus.Errorf(codes.PermissionDenied, "patching object %s in namespace %s: %s", obj.Name, obj.Namespace, err.Error())
+	}
+
+	return fmt.Errorf("patching object %s in namespace %s: %w", obj.Name, obj.Namespace, err)
+}
+
+func principalID(ctx context.Context) string {
+	if p := auth.Principal(ctx); p != nil {
+		return p.ID
+	}
+
+	return ""
+}
+
+func (s *server) recordPlanDecision(ctx context.Context, cluster string, obj *tfctrl.Terraform, decision planDecision) error {
+	err := s.updatePlanHistory(ctx, cluster, obj, func(cm *corev1.ConfigMap) error {
+		decisions, err := decodePlanDecisions(cm.Data)
+		if err != nil {
+			return err
+		}
+
+		decisions[decision.PlanID] = &decision
+
+		// Only keep decisions about plans that are still around.
+		plans, err := decodePlans(cm.Data)
+		if err != nil {
+			return err
+		}
+
+		kept := []*planDecision{&decision}
+		for _, p := range plans {
+			if d, ok := decisions[p.ID]; ok && p.ID != decision.PlanID {
+				kept = append(kept, d)
+			}
+		}
+
+		b, err := json.Marshal(kept)
+		if err != nil {
+			return fmt.Errorf("encoding plan decisions: %w", err)
+		}
+
+		cm.Data[planDecisionsKey] = string(b)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("recording decision on plan %s: %w", decision.PlanID, err)
+	}
+
+	return nil
+}
+
+// decodePlanDecisions returns the decisions of a plan history by plan id.
+func decodePlanDecisions(data map[string]string) (map[string]*planDecision, error) {
+	decisions := map[string]*planDecision{}
+
+	if data[planDecisionsKey] == "" {
+		return decisions, nil
+	}
+
+	list := []*planDecision{}
+	if err := json.Unmarshal([]byte(data[planDecisionsKey]), &list); err != nil {
+		return nil, fmt.Errorf("decoding plan decisions: %w", err)
+	}
+
+	for _, d := range list {
+		decisions[d.PlanID] = d
+	}
+
+	return decisions, nil
+}
+
+// createApprovalPullRequest opens a pull request setting spec.approvePlan in
+// the manifest of the object, in the repository of the Flux Kustomization
+// that applies it.
+func (s *server) createApprovalPullRequest(ctx context.Context, c clustersmngr.Client, cluster string, obj *tfctrl.Terraform, path string) (string, error) {
+	if s.providerCreator == nil {
+		return "", grpcStatus.Error(codes.Unimplemented, "approving plans through pull requests is not enabled")
+	}
+
+	ks, repo, err := getManagingRepository(ctx, c, cluster, obj)
+	if err != nil {
+		return "", err
+	}
+
+	branch := repo.Spec.Reference.Branch
+	planID := obj.Status.Plan.Pending
+
+	provider, err := newGitProvider(ctx, s.providerCreator, repo.Spec.URL)
+	if err != nil {
+		return "", err
+	}
+
+	var content string
+
+	if path != "" {
+		original, err := provider.GetFileContents(ctx, repo.Spec.URL, path, branch)
+		if err != nil {
+			return "", fmt.Errorf("getting %s from %s: %w", path, repo.Spec.URL, err)
+		}
+
+		updated, found, err := setApprovePlan(original, obj, planID)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			return "", grpcStatus.Errorf(codes.NotFound, "object %s in namespace %s not found in %s", obj.Name, obj.Namespace, path)
+		}
+
+		content = updated
+	} else {
+		path, content, err = findManifest(ctx, provider, repo.Spec.URL, branch, ks.Spec.Path, obj, planID)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	pr, err := provider.CreatePullRequest(ctx, git.PullRequestInput{
+		RepositoryURL: repo.Spec.URL,
+		Title:         fmt.Sprintf("Approve plan %s of %s/%s", planID, obj.Namespace, obj.Name),
+		Body:          fmt.Sprintf("Approves the plan %s of the Terraform object %s/%s on cluster %s.", planID, obj.Namespace, obj.Name, cluster),
+		Head:          fmt.Sprintf("approve-%s-%s", obj.Name, planID),
+		Base:          branch,
+		Commits: []git.Commit{
+			{
+				CommitMessage: fmt.Sprintf("Approve plan %s of %s/%s", planID, obj.Namespace, obj.Name),
+				Files: []git.CommitFile{
+					{
+						Path:    path,
+						Content: ptr.To(content),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("creating pull request: %w", err)
+	}
+
+	return pr.Link, nil
+}
+
+// getManagingRepository returns the Flux Kustomization that applies an
+// object, and the GitRepository it is applied from.
+func getManagingRepository(ctx context.Context, c clustersmngr.Client, cluster string, obj *tfctrl.Terraform) (*kustomizev1.Kustomization, *sourcev1.GitRepository, error) {
+	labels := obj.GetLabels()
+	ksKey := types.NamespacedName{
+		Name:      labels[kustomizev1.GroupVersion.Group+"/name"],
+		Namespace: labels[kustomizev1.GroupVersion.Group+"/namespace"],
+	}
+
+	if ksKey.Name == "" || ksKey.Namespace == "" {
+		return nil, nil, grpcStatus.Errorf(codes.FailedPrecondition, "object %s in namespace %s is not applied by a Flux Kustomization", obj.Name, obj.Namespace)
+	}
+
+	ks := &kustomizev1.Kustomization{}
+	if err := c.Get(ctx, cluster, ksKey, ks); err != nil {
+		return nil, nil, fmt.Errorf("getting Kustomization %s: %w", ksKey, err)
+	}
+
+	if ks.Spec.SourceRef.Kind != sourcev1.GitRepositoryKind {
+		return nil, nil, grpcStatus.Errorf(codes.FailedPrecondition, "Kustomization %s is not applied from a GitRepository", ksKey)
+	}
+
+	repoKey := types.NamespacedName{Name: ks.Spec.SourceRef.Name, Namespace: ks.Spec.SourceRef.Namespace}
+	if repoKey.Namespace == "" {
+		repoKey.Namespace = ks.Namespace
+	}
+
+	repo := &sourcev1.GitRepository{}
+	if err := c.Get(ctx, cluster, repoKey, repo); err != nil {
+		return nil, nil, fmt.Errorf("getting GitRepository %s: %w", repoKey, err)
+	}
+
+	if repo.Spec.Reference == nil || repo.Spec.Reference.Branch == "" {
+		return nil, nil, grpcStatus.Errorf(codes.FailedPrecondition, "GitRepository %s does not track a branch", repoKey)
+	}
+
+	return ks, repo, nil
+}
+
+// findManifest looks for the manifest of an object under dir, and returns
+// its path and content with spec.approvePlan set.
+func findManifest(ctx context.Context, provider git.Provider, repoURL, branch, dir string, obj *tfctrl.Terraform, planID string) (string, string, error) {
+	dir = strings.TrimPrefix(filepath.Clean(dir), "./")
+	if dir == "." {
+		dir = ""
+	}
+
+	entries, err := provider.GetTreeList(ctx, repoURL, branch, dir)
+	if err != nil {
+		return "", "", fmt.Errorf("listing files of %s@%s: %w", repoURL, branch, err)
+	}
+
+	read := 0
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Path)
+		if entry.Type == "tree" || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		if read == maxManifestSearch {
+			break
+		}
+		read++
+
+		content, err := provider.GetFileContents(ctx, repoURL, entry.Path, branch)
+		if err != nil {
+			return "", "", fmt.Errorf("getting %s from %s: %w", entry.Path, repoURL, err)
+		}
+
+		updated, found, err := setApprovePlan(content, obj, planID)
+		if err != nil {
+			// Not every YAML file in a repository is a manifest.
+			continue
+		}
+
+		if found {
+			return entry.Path, updated, nil
+		}
+	}
+
+	return "", "", grpcStatus.Errorf(codes.NotFound, "object %s in namespace %s not found in %s, set the path of its manifest", obj.Name, obj.Namespace, repoURL)
+}
+
+// setApprovePlan sets spec.approvePlan on the manifest of obj in content, it
+// returns false if the manifest is not in content.
+func setApprovePlan(content string, obj *tfctrl.Terraform, planID string) (string, bool, error) {
+	nodes, err := kio.FromBytes([]byte(content))
+	if err != nil {
+		return "", false, fmt.Errorf("parsing manifests: %w", err)
+	}
+
+	found := false
+
+	for _, n := range nodes {
+		if n.GetKind() != tfctrl.TerraformKind || n.GetName() != obj.Name {
+			continue
+		}
+
+		if ns := n.GetNamespace(); ns != "" && ns != obj.Namespace {
+			continue
+		}
+
+		if err := n.PipeE(yaml.LookupCreate(yaml.MappingNode, "spec"), yaml.SetField("approvePlan", yaml.NewStringRNode(planID))); err != nil {
+			return "", false, fmt.Errorf("setting approvePlan: %w", err)
+		}
+
+		found = true
+	}
+
+	if !found {
+		return "", false, nil
+	}
+
+	updated, err := kio.StringAll(nodes)
+	if err != nil {
+		return "", false, fmt.Errorf("writing manifests: %w", err)
+	}
+
+	return updated, true, nil
+}
+
+func newGitProvider(ctx context.Context, creator git.ProviderCreator, repositoryURL string) (git.Provider, error) {
+	repoURL, err := gitproviders.NewRepoURL(repositoryURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repository URL: %w", err)
+	}
+
+	providerType := string(repoURL.Provider())
+
+	token, tokenType := viper.GetString("git-provider-token"), ""
+	if providerToken, err := middleware.ExtractProviderToken(ctx); err == nil {
+		token, tokenType = providerToken.AccessToken, "oauth2"
+	}
+
+	opts := []git.ProviderWithFn{git.WithDomain(repoURL.URL().Host)}
+	switch providerType {
+	case git.GitHubProviderName:
+		opts = append(opts, git.WithOAuth2Token(token))
+	case git.BitBucketServerProviderName:
+		opts = append(opts, git.WithUsername(""), git.WithToken(tokenType, token))
+	default:
+		opts = append(opts, git.WithToken(tokenType, token))
+	}
+
+	provider, err := creator.Create(providerType, opts...)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unavailable, "error creating git provider: %s", err.Error())
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,249 @@
+package terraform_test
+
+import (
+	"context"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApproveTerraformPlan(t *testing.T) {
+	ctx := context.Background()
+	client, k8s := setup(t)
+
+	tfObj := createPendingPlan(ctx, t, k8s, "plan-main-abc123")
+	recordPlans(ctx, t, k8s)
+
+	_, err := client.ApproveTerraformPlan(ctx, &pb.ApproveTerraformPlanRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+		PlanId:      "plan-main-other",
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	res, err := client.ApproveTerraformPlan(ctx, &pb.ApproveTerraformPlanRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+		PlanId:      "plan-main-abc123",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "plan-main-abc123", res.PlanId)
+
+	assert.NoError(t, k8s.Get(ctx, types.NamespacedName{Name: tfObj.Name, Namespace: tfObj.Namespace}, tfObj))
+	assert.Equal(t, "plan-main-abc123", tfObj.Spec.ApprovePlan)
+
+	plans, err := client.ListTerraformObjectPlans(ctx, &pb.ListTerraformObjectPlansRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+	})
+	require.NoError(t, err)
+	require.Len(t, plans.Plans, 1)
+	require.NotNil(t, plans.Plans[0].Decision)
+	assert.Equal(t, terraform.PlanApproved, plans.Plans[0].Decision.Decision)
+}
+
+func TestRejectTerraformPlan(t *testing.T) {
+	ctx := context.Background()
+	client, k8s := setup(t)
+
+	tfObj := createPendingPlan(ctx, t, k8s, "plan-main-abc123")
+	tfObj.Spec.ApprovePlan = "plan-main-abc123"
+	require.NoError(t, k8s.Update(ctx, tfObj))
+	recordPlans(ctx, t, k8s)
+
+	res, err := client.RejectTerraformPlan(ctx, &pb.RejectTerraformPlanRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+		Reason:      "deletes the database",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "plan-main-abc123", res.PlanId)
+
+	assert.NoError(t, k8s.Get(ctx, types.NamespacedName{Name: tfObj.Name, Namespace: tfObj.Namespace}, tfObj))
+	assert.Empty(t, tfObj.Spec.ApprovePlan)
+
+	plans, err := client.ListTerraformObjectPlans(ctx, &pb.ListTerraformObjectPlansRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+	})
+	require.NoError(t, err)
+	require.Len(t, plans.Plans, 1)
+	require.NotNil(t, plans.Plans[0].Decision)
+	assert.Equal(t, terraform.PlanRejected, plans.Plans[0].Decision.Decision)
+	assert.Equal(t, "deletes the database", plans.Plans[0].Decision.Reason)
+}
+
+func TestApproveTerraformPlan_PullRequest(t *testing.T) {
+	ctx := context.Background()
+	provider := &testProvider{
+		files: map[string]string{
+			"infra/README.yaml": "not: [a manifest",
+			"infra/tf.yaml":     "apiVersion: infra.contrib.fluxcd.io/v1alpha1\nkind: Terraform\nmetadata:\n  name: my-obj\n  namespace: default\nspec:\n  approvePlan: \"\"\n  path: ./vpc\n",
+		},
+	}
+	client, k8s := setupWithProvider(t, provider)
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "flux-system"},
+		Spec: sourcev1.GitRepositorySpec{
+			URL:       "https://github.com/my-org/fleet",
+			Reference: &sourcev1.GitRepositoryRef{Branch: "main"},
+		},
+	}
+	require.NoError(t, k8s.Create(ctx, repo))
+
+	ks := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "flux-system"},
+		Spec: kustomizev1.KustomizationSpec{
+			Path: "./infra",
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{
+				Kind: sourcev1.GitRepositoryKind,
+				Name: "fleet",
+			},
+		},
+	}
+	require.NoError(t, k8s.Create(ctx, ks))
+
+	tfObj := createPendingPlan(ctx, t, k8s, "plan-main-abc123")
+	tfObj.Labels = map[string]string{
+		"kustomize.toolkit.fluxcd.io/name":      "infra",
+		"kustomize.toolkit.fluxcd.io/namespace": "flux-system",
+	}
+	require.NoError(t, k8s.Update(ctx, tfObj))
+
+	res, err := client.ApproveTerraformPlan(ctx, &pb.ApproveTerraformPlanRequest{
+		ClusterName:       "Default",
+		Name:              tfObj.Name,
+		Namespace:         tfObj.Namespace,
+		CreatePullRequest: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/my-org/fleet/pull/1", res.PullRequestUrl)
+
+	require.NotNil(t, provider.pr)
+	assert.Equal(t, "main", provider.pr.Base)
+	require.Len(t, provider.pr.Commits, 1)
+	require.Len(t, provider.pr.Commits[0].Files, 1)
+	assert.Equal(t, "infra/tf.yaml", provider.pr.Commits[0].Files[0].Path)
+	assert.Contains(t, *provider.pr.Commits[0].Files[0].Content, `approvePlan: "plan-main-abc123"`)
+
+	// The object is only approved once the pull request is merged.
+	assert.NoError(t, k8s.Get(ctx, types.NamespacedName{Name: tfObj.Name, Namespace: tfObj.Namespace}, tfObj))
+	assert.Empty(t, tfObj.Spec.ApprovePlan)
+}
+
+func createPendingPlan(ctx context.Context, t *testing.T, k client.Client, planID string) *tfctrl.Terraform {
+	t.Helper()
+
+	tfObj := &tfctrl.Terraform{}
+	tfObj.Name = "my-obj"
+	tfObj.Namespace = "default"
+	tfObj.Spec.StoreReadablePlan = "json"
+	require.NoError(t, k.Create(ctx, tfObj))
+
+	planSecret := &corev1.Secret{}
+	planSecret.Name = "tfplan-default-my-obj.json"
+	planSecret.Namespace = "default"
+	planSecret.Data = map[string][]byte{
+		"tfplan": []byte(`{"resource_changes": [{"address": "aws_instance.web", "type": "aws_instance", "name": "web", "change": {"actions": ["create"], "after": {"size": "small"}}}]}`),
+	}
+	require.NoError(t, k.Create(ctx, planSecret))
+
+	tfObj.Status.Plan.Pending = planID
+	require.NoError(t, k.Status().Update(ctx, tfObj))
+
+	return tfObj
+}
+
+func setupWithProvider(t *testing.T, provider git.Provider) (pb.TerraformClient, client.Client) {
+	k8s := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).WithStatusSubresource(&tfctrl.Terraform{}).Build()
+
+	namespaces := map[string][]corev1.Namespace{
+		"Default": {
+			corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}},
+		},
+	}
+
+	opts := terraform.ServerOpts{
+		Logger:          logr.Discard(),
+		ClientsFactory:  grpctesting.MakeClustersManager(k8s, namespaces),
+		ProviderCreator: &testProviderFactory{provider: provider},
+	}
+	srv := terraform.NewTerraformServer(opts)
+
+	conn := grpctesting.Setup(t, func(s *grpc.Server) {
+		pb.RegisterTerraformServer(s, srv)
+	})
+
+	return pb.NewTerraformClient(conn), k8s
+}
+
+type testProviderFactory struct {
+	provider git.Provider
+}
+
+func (f *testProviderFactory) Create(providerName string, opts ...git.ProviderWithFn) (git.Provider, error) {
+	return f.provider, nil
+}
+
+type testProvider struct {
+	files map[string]string
+	pr    *git.PullRequestInput
+}
+
+func (p *testProvider) CreatePullRequest(ctx context.Context, input git.PullRequestInput) (*git.PullRequest, error) {
+	p.pr = &input
+	return &git.PullRequest{Link: input.RepositoryURL + "/pull/1"}, nil
+}
+
+func (p *testProvider) Setup(git.ProviderOption) error {
+	return nil
+}
+
+func (p *testProvider) GetRepository(ctx context.Context, repoURL string) (*git.Repository, error) {
+	return nil, nil
+}
+
+func (p *testProvider) GetTreeList(ctx context.Context, repoUrl, sha, path string) ([]*git.TreeEntry, error) {
+	entries := []*git.TreeEntry{}
+	for name := range p.files {
+		entries = append(entries, &git.TreeEntry{Path: name, Type: "blob"})
+	}
+
+	return entries, nil
+}
+
+func (p *testProvider) ListPullRequests(ctx context.Context, repoUrl string) ([]*git.PullRequest, error) {
+	return nil, nil
+}
+
+func (p *testProvider) ListCommits(ctx context.Context, repoURL, branch string, perPage, page int) ([]*git.CommitInfo, error) {
+	return nil, nil
+}
+
+func (p *testProvider) GetFileContents(ctx context.Context, repoURL, path, ref string) (string, error) {
+	return p.files[path], nil
+}
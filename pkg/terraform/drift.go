@@ -0,0 +1,158 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (s *server) GetTerraformDriftReport(ctx context.Context, msg *pb.GetTerraformDriftReportRequest) (*pb.GetTerraformDriftReportResponse, error) {
+	clusterSelector, err := s.selectClusters(ctx, msg.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &tfctrl.TerraformList{}
+	})
+
+	opts := []client.ListOption{}
+	if msg.Namespace != "" {
+		opts = append(opts, client.InNamespace(msg.Namespace))
+	}
+
+	listErrors := []*pb.TerraformListError{}
+
+	if err := c.ClusteredList(ctx, clist, true, opts...); err != nil {
+		var errs clustersmngr.ClusteredListError
+
+		if !errors.As(err, &errs) {
+			return nil, fmt.Errorf("converting to ClusteredListError: %w", err)
+		}
+
+		for _, e := range errs.Errors {
+			if apimeta.IsNoMatchError(e.Err) {
+				continue
+			}
+
+			if (msg.ClusterName != "" && e.Cluster != msg.ClusterName) || !clusterSelector.Matches(e.Cluster) {
+				continue
+			}
+
+			listErrors = append(listErrors, &pb.TerraformListError{
+				ClusterName: e.Cluster,
+				Message:     e.Err.Error(),
+			})
+		}
+	}
+
+	now := time.Now().UTC()
+	entries := []*pb.TerraformDriftReportEntry{}
+
+	for clusterName, lists := range clist.Lists() {
+		if (msg.ClusterName != "" && clusterName != msg.ClusterName) || !clusterSelector.Matches(clusterName) {
+			continue
+		}
+
+		for _, l := range lists {
+			list, ok := l.(*tfctrl.TerraformList)
+			if !ok {
+				continue
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				if !obj.HasDrift() {
+					continue
+				}
+
+				entry := &pb.TerraformDriftReportEntry{
+					ClusterName:         clusterName,
+					Namespace:           obj.Namespace,
+					Name:                obj.Name,
+					LastDriftDetectedAt: obj.Status.LastDriftDetectedAt.Format(time.RFC3339),
+				}
+
+				since := driftingSince(obj)
+				entry.DriftingSince = since.Format(time.RFC3339)
+				entry.DriftingForSeconds = int64(now.Sub(since).Seconds())
+
+				planID, resources, err := s.driftedResources(ctx, clusterName, obj)
+				if err != nil {
+					s.log.Error(err, "failed to read drifted resources", "name", obj.Name, "namespace", obj.Namespace, "cluster", clusterName)
+				}
+
+				entry.PlanId = planID
+				entry.DriftedResources = resources
+
+				entries = append(entries, entry)
+			}
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].DriftingForSeconds != entries[j].DriftingForSeconds {
+			return entries[i].DriftingForSeconds > entries[j].DriftingForSeconds
+		}
+
+		return entries[i].ClusterName+"/"+entries[i].Namespace+"/"+entries[i].Name < entries[j].ClusterName+"/"+entries[j].Namespace+"/"+entries[j].Name
+	})
+
+	return &pb.GetTerraformDriftReportResponse{
+		Entries:     entries,
+		Errors:      listErrors,
+		GeneratedAt: now.Format(time.RFC3339),
+	}, nil
+}
+
+// driftingSince returns when drift was first detected since the last apply.
+// The tf-controller marks objects not ready when it detects drift, which only
+// changes the transition time of the condition the first time.
+func driftingSince(obj *tfctrl.Terraform) time.Time {
+	ready := apimeta.FindStatusCondition(obj.Status.Conditions, meta.ReadyCondition)
+	if ready != nil && ready.Reason == tfctrl.DriftDetectedReason && !ready.LastTransitionTime.IsZero() {
+		return ready.LastTransitionTime.Time.UTC()
+	}
+
+	return obj.Status.LastDriftDetectedAt.Time.UTC()
+}
+
+// driftedResources returns the addresses of the resources changed by the
+// latest plan recorded for an object by the PlanRecorder.
+func (s *server) driftedResources(ctx context.Context, cluster string, obj *tfctrl.Terraform) (string, []string, error) {
+	data, err := s.getPlanHistory(ctx, cluster, obj)
+	if err != nil {
+		return "", nil, err
+	}
+
+	plans, err := decodePlans(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(plans) == 0 {
+		return "", nil, nil
+	}
+
+	resources := []string{}
+	for _, rc := range plans[0].ResourceChanges {
+		resources = append(resources, rc.Address)
+	}
+
+	return plans[0].ID, resources, nil
+}
@@ -0,0 +1,83 @@
+package terraform_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestGetTerraformDriftReport(t *testing.T) {
+	ctx := context.Background()
+	client, k8s := setup(t)
+
+	applied := time.Now().Add(-48 * time.Hour)
+	detected := time.Now().Add(-24 * time.Hour)
+
+	createTerraformWithConditions(ctx, t, k8s, "drifted", &metav1.Time{Time: time.Now()}, []metav1.Condition{
+		{Type: tfctrl.ConditionTypeApply, Status: metav1.ConditionTrue, Reason: "TerraformAppliedSucceed", LastTransitionTime: metav1.NewTime(applied)},
+		{Type: meta.ReadyCondition, Status: metav1.ConditionFalse, Reason: tfctrl.DriftDetectedReason, LastTransitionTime: metav1.NewTime(detected)},
+	})
+
+	createTerraformWithConditions(ctx, t, k8s, "in-sync", &metav1.Time{Time: applied.Add(-time.Hour)}, []metav1.Condition{
+		{Type: tfctrl.ConditionTypeApply, Status: metav1.ConditionTrue, Reason: "TerraformAppliedSucceed", LastTransitionTime: metav1.NewTime(applied)},
+		{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: "NoDrift", LastTransitionTime: metav1.NewTime(applied)},
+	})
+
+	// The drifted resources come from the plan recorded in the background, without viewing it
+	drifted := &tfctrl.Terraform{}
+	require.NoError(t, k8s.Get(ctx, types.NamespacedName{Name: "drifted", Namespace: "default"}, drifted))
+	drifted.Spec.StoreReadablePlan = "json"
+	require.NoError(t, k8s.Update(ctx, drifted))
+	drifted.Status.Plan.Pending = "plan-main-2"
+	require.NoError(t, k8s.Status().Update(ctx, drifted))
+
+	planSecret := &corev1.Secret{}
+	planSecret.Name = "tfplan-default-drifted.json"
+	planSecret.Namespace = "default"
+	planSecret.Data = map[string][]byte{
+		"tfplan": []byte(`{"resource_changes": [{"address": "aws_instance.web", "type": "aws_instance", "name": "web", "change": {"actions": ["update"], "before": {"size": "small"}, "after": {"size": "large"}}}]}`),
+	}
+	require.NoError(t, k8s.Create(ctx, planSecret))
+
+	recordPlans(ctx, t, k8s)
+
+	res, err := client.GetTerraformDriftReport(ctx, &pb.GetTerraformDriftReportRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, res.Errors)
+	require.Len(t, res.Entries, 1)
+
+	entry := res.Entries[0]
+	assert.Equal(t, "drifted", entry.Name)
+	assert.Equal(t, "Default", entry.ClusterName)
+	assert.Equal(t, detected.UTC().Format(time.RFC3339), entry.DriftingSince)
+	assert.InDelta(t, (24 * time.Hour).Seconds(), entry.DriftingForSeconds, 60)
+	assert.Equal(t, "plan-main-2", entry.PlanId)
+	assert.Equal(t, []string{"aws_instance.web"}, entry.DriftedResources)
+
+	res, err = client.GetTerraformDriftReport(ctx, &pb.GetTerraformDriftReportRequest{ClusterName: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, res.Entries)
+}
+
+func createTerraformWithConditions(ctx context.Context, t *testing.T, k client.Client, name string, driftDetectedAt *metav1.Time, conditions []metav1.Condition) {
+	t.Helper()
+
+	tfObj := &tfctrl.Terraform{}
+	tfObj.Name = name
+	tfObj.Namespace = "default"
+	require.NoError(t, k.Create(ctx, tfObj))
+
+	tfObj.Status.LastDriftDetectedAt = driftDetectedAt
+	tfObj.Status.Conditions = conditions
+	require.NoError(t, k.Status().Update(ctx, tfObj))
+}
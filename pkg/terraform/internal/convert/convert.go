@@ -2,9 +2,12 @@ package convert
 
 import (
 	"fmt"
+	"time"
 
 	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/tfplan"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/tfstate"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -82,3 +85,78 @@ func durationToInterval(duration metav1.Duration) *pb.Interval {
 		Seconds: int64(duration.Seconds()) % 60,
 	}
 }
+
+func ToPBTerraformPlan(plan tfplan.Plan) *pb.TerraformPlan {
+	changes := []*pb.TerraformPlanResourceChange{}
+
+	for _, rc := range plan.ResourceChanges {
+		attributes := []*pb.TerraformPlanAttributeChange{}
+
+		for _, a := range rc.Attributes {
+			attributes = append(attributes, &pb.TerraformPlanAttributeChange{
+				Path:              a.Path,
+				Before:            a.Before,
+				After:             a.After,
+				Sensitive:         a.Sensitive,
+				Unknown:           a.Unknown,
+				ForcesReplacement: a.ForcesReplacement,
+			})
+		}
+
+		changes = append(changes, &pb.TerraformPlanResourceChange{
+			Address:       rc.Address,
+			ModuleAddress: rc.ModuleAddress,
+			Type:          rc.Type,
+			Name:          rc.Name,
+			Action:        rc.Action,
+			Attributes:    attributes,
+		})
+	}
+
+	return &pb.TerraformPlan{
+		PlanId:     plan.ID,
+		Revision:   plan.Revision,
+		RecordedAt: plan.RecordedAt.Format(time.RFC3339),
+		Summary: &pb.TerraformPlanSummary{
+			Create:  int32(plan.Summary.Create),
+			Update:  int32(plan.Summary.Update),
+			Delete:  int32(plan.Summary.Delete),
+			Replace: int32(plan.Summary.Replace),
+		},
+		ResourceChanges: changes,
+	}
+}
+
+func ToPBTerraformPlanResourceDiffs(diffs []tfplan.ResourceDiff) []*pb.TerraformPlanResourceDiff {
+	result := []*pb.TerraformPlanResourceDiff{}
+
+	for _, d := range diffs {
+		result = append(result, &pb.TerraformPlanResourceDiff{
+			Address:           d.Address,
+			Status:            d.Status,
+			FromAction:        d.FromAction,
+			ToAction:          d.ToAction,
+			ChangedAttributes: d.ChangedAttributes,
+		})
+	}
+
+	return result
+}
+
+func ToPBTerraformStateResources(resources []tfstate.Resource) []*pb.TerraformStateResource {
+	result := []*pb.TerraformStateResource{}
+
+	for _, r := range resources {
+		result = append(result, &pb.TerraformStateResource{
+			Address:       r.Address,
+			ModuleAddress: r.ModuleAddress,
+			Mode:          r.Mode,
+			Type:          r.Type,
+			Name:          r.Name,
+			Provider:      r.Provider,
+			Attributes:    r.Attributes,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,435 @@
+// Package tfplan parses Terraform plans, in the JSON format produced by
+// `terraform show -json`, into the changes they make to each resource.
+package tfplan
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ActionCreate  = "create"
+	ActionUpdate  = "update"
+	ActionDelete  = "delete"
+	ActionReplace = "replace"
+	ActionRead    = "read"
+	ActionNoop    = "no-op"
+)
+
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
+// Plan is a parsed Terraform plan.
+type Plan struct {
+	ID              string           `json:"id"`
+	Revision        string           `json:"revision,omitempty"`
+	RecordedAt      time.Time        `json:"recordedAt"`
+	Summary         Summary          `json:"summary"`
+	ResourceChanges []ResourceChange `json:"resourceChanges,omitempty"`
+}
+
+// Summary counts the resources changed by a plan, by action.
+type Summary struct {
+	Create  int `json:"create"`
+	Update  int `json:"update"`
+	Delete  int `json:"delete"`
+	Replace int `json:"replace"`
+}
+
+// ResourceChange is the change a plan makes to a resource.
+type ResourceChange struct {
+	Address       string            `json:"address"`
+	ModuleAddress string            `json:"moduleAddress,omitempty"`
+	Type          string            `json:"type"`
+	Name          string            `json:"name"`
+	Action        string            `json:"action"`
+	Attributes    []AttributeChange `json:"attributes,omitempty"`
+}
+
+// AttributeChange is the change made to an attribute of a resource. Before
+// and After hold JSON encoded values, they are empty if the attribute is not
+// set, sensitive, or only known after apply.
+type AttributeChange struct {
+	Path              string `json:"path"`
+	Before            string `json:"before,omitempty"`
+	After             string `json:"after,omitempty"`
+	Sensitive         bool   `json:"sensitive,omitempty"`
+	Unknown           bool   `json:"unknown,omitempty"`
+	ForcesReplacement bool   `json:"forcesReplacement,omitempty"`
+}
+
+// ResourceDiff describes how the change to a resource differs between two
+// plans.
+type ResourceDiff struct {
+	Address           string
+	Status            string
+	FromAction        string
+	ToAction          string
+	ChangedAttributes []string
+}
+
+type jsonPlan struct {
+	ResourceChanges []jsonResourceChange `json:"resource_changes"`
+}
+
+type jsonResourceChange struct {
+	Address       string     `json:"address"`
+	ModuleAddress string     `json:"module_address"`
+	Type          string     `json:"type"`
+	Name          string     `json:"name"`
+	Change        jsonChange `json:"change"`
+}
+
+type jsonChange struct {
+	Actions         []string        `json:"actions"`
+	Before          interface{}     `json:"before"`
+	After           interface{}     `json:"after"`
+	AfterUnknown    interface{}     `json:"after_unknown"`
+	BeforeSensitive interface{}     `json:"before_sensitive"`
+	AfterSensitive  interface{}     `json:"after_sensitive"`
+	ReplacePaths    [][]interface{} `json:"replace_paths"`
+}
+
+// Parse parses a JSON plan, which may be gzipped. Resources left unchanged by
+// the plan are omitted.
+func Parse(data []byte) ([]ResourceChange, Summary, error) {
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, Summary{}, fmt.Errorf("decompressing plan: %w", err)
+		}
+
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return nil, Summary{}, fmt.Errorf("decompressing plan: %w", err)
+		}
+	}
+
+	plan := jsonPlan{}
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, Summary{}, fmt.Errorf("parsing plan: %w", err)
+	}
+
+	changes := []ResourceChange{}
+	summary := Summary{}
+
+	for _, rc := range plan.ResourceChanges {
+		action := planAction(rc.Change.Actions)
+
+		switch action {
+		case ActionNoop:
+			continue
+		case ActionCreate:
+			summary.Create++
+		case ActionUpdate:
+			summary.Update++
+		case ActionDelete:
+			summary.Delete++
+		case ActionReplace:
+			summary.Replace++
+		}
+
+		attributes, err := attributeChanges(rc.Change)
+		if err != nil {
+			return nil, Summary{}, fmt.Errorf("parsing changes of %s: %w", rc.Address, err)
+		}
+
+		changes = append(changes, ResourceChange{
+			Address:       rc.Address,
+			ModuleAddress: rc.ModuleAddress,
+			Type:          rc.Type,
+			Name:          rc.Name,
+			Action:        action,
+			Attributes:    attributes,
+		})
+	}
+
+	return changes, summary, nil
+}
+
+func planAction(actions []string) string {
+	switch {
+	case len(actions) == 2:
+		// ["delete", "create"] or ["create", "delete"]
+		return ActionReplace
+	case len(actions) == 1:
+		return actions[0]
+	default:
+		return ActionNoop
+	}
+}
+
+func attributeChanges(change jsonChange) ([]AttributeChange, error) {
+	before := map[string]interface{}{}
+	flatten("", change.Before, before)
+
+	after := map[string]interface{}{}
+	flatten("", change.After, after)
+
+	unknown := markedPaths(change.AfterUnknown)
+	sensitive := markedPaths(change.BeforeSensitive)
+	for k := range markedPaths(change.AfterSensitive) {
+		sensitive[k] = true
+	}
+
+	replace := map[string]bool{}
+	for _, p := range change.ReplacePaths {
+		replace[pathString(p)] = true
+	}
+
+	paths := map[string]bool{}
+	for k := range before {
+		paths[k] = true
+	}
+	for k := range after {
+		paths[k] = true
+	}
+	for k := range unknown {
+		paths[k] = true
+	}
+
+	attributes := []AttributeChange{}
+
+	for path := range paths {
+		beforeValue, inBefore := before[path]
+		afterValue, inAfter := after[path]
+		isUnknown := isMarked(unknown, path)
+
+		if !isUnknown && inBefore && inAfter && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+
+		attr := AttributeChange{
+			Path:              path,
+			Sensitive:         isMarked(sensitive, path),
+			Unknown:           isUnknown,
+			ForcesReplacement: isMarked(replace, path),
+		}
+
+		if !attr.Sensitive {
+			var err error
+
+			if inBefore && beforeValue != nil {
+				if attr.Before, err = encode(beforeValue); err != nil {
+					return nil, err
+				}
+			}
+
+			if inAfter && afterValue != nil && !isUnknown {
+				if attr.After, err = encode(afterValue); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		attributes = append(attributes, attr)
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Path < attributes[j].Path
+	})
+
+	return attributes, nil
+}
+
+// flatten stores the leaves of a JSON value by path, e.g. tags.env or
+// ingress[0].port.
+func flatten(prefix string, v interface{}, out map[string]interface{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 && prefix != "" {
+			out[prefix] = value
+		}
+
+		for k, nested := range value {
+			path := k
+			if prefix != "" {
+				path = prefix + "." + k
+			}
+
+			flatten(path, nested, out)
+		}
+	case []interface{}:
+		if len(value) == 0 && prefix != "" {
+			out[prefix] = value
+		}
+
+		for i, nested := range value {
+			flatten(fmt.Sprintf("%s[%d]", prefix, i), nested, out)
+		}
+	default:
+		if prefix != "" {
+			out[prefix] = value
+		}
+	}
+}
+
+// markedPaths returns the paths set to true in the after_unknown and
+// *_sensitive structures of a change. The empty path means the whole value is
+// marked.
+func markedPaths(v interface{}) map[string]bool {
+	if b, ok := v.(bool); ok {
+		return map[string]bool{"": b}
+	}
+
+	flat := map[string]interface{}{}
+	flatten("", v, flat)
+
+	marked := map[string]bool{}
+	for k, v := range flat {
+		if b, ok := v.(bool); ok && b {
+			marked[k] = true
+		}
+	}
+
+	return marked
+}
+
+// isMarked returns true if path, or any of its parents, is marked.
+func isMarked(marked map[string]bool, path string) bool {
+	if marked[""] {
+		return true
+	}
+
+	for p := path; p != ""; p = parentPath(p) {
+		if marked[p] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parentPath(path string) string {
+	i := strings.LastIndexAny(path, ".[")
+	if i < 0 {
+		return ""
+	}
+
+	return path[:i]
+}
+
+func pathString(steps []interface{}) string {
+	path := ""
+
+	for _, step := range steps {
+		switch s := step.(type) {
+		case float64:
+			path = fmt.Sprintf("%s[%d]", path, int(s))
+		default:
+			if path != "" {
+				path += "."
+			}
+			path += fmt.Sprint(s)
+		}
+	}
+
+	return path
+}
+
+func encode(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encoding value: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// Diff compares the resource changes of two plans.
+func Diff(from, to Plan) []ResourceDiff {
+	fromChanges := map[string]ResourceChange{}
+	for _, rc := range from.ResourceChanges {
+		fromChanges[rc.Address] = rc
+	}
+
+	toChanges := map[string]ResourceChange{}
+	for _, rc := range to.ResourceChanges {
+		toChanges[rc.Address] = rc
+	}
+
+	diffs := []ResourceDiff{}
+
+	for address, f := range fromChanges {
+		t, ok := toChanges[address]
+		if !ok {
+			diffs = append(diffs, ResourceDiff{
+				Address:    address,
+				Status:     DiffRemoved,
+				FromAction: f.Action,
+			})
+			continue
+		}
+
+		changed := changedAttributes(f.Attributes, t.Attributes)
+		if f.Action == t.Action && len(changed) == 0 {
+			continue
+		}
+
+		diffs = append(diffs, ResourceDiff{
+			Address:           address,
+			Status:            DiffChanged,
+			FromAction:        f.Action,
+			ToAction:          t.Action,
+			ChangedAttributes: changed,
+		})
+	}
+
+	for address, t := range toChanges {
+		if _, ok := fromChanges[address]; ok {
+			continue
+		}
+
+		diffs = append(diffs, ResourceDiff{
+			Address:  address,
+			Status:   DiffAdded,
+			ToAction: t.Action,
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Address < diffs[j].Address
+	})
+
+	return diffs
+}
+
+func changedAttributes(from, to []AttributeChange) []string {
+	fromAttrs := map[string]AttributeChange{}
+	for _, a := range from {
+		fromAttrs[a.Path] = a
+	}
+
+	toAttrs := map[string]AttributeChange{}
+	for _, a := range to {
+		toAttrs[a.Path] = a
+	}
+
+	changed := []string{}
+
+	for path, f := range fromAttrs {
+		if t, ok := toAttrs[path]; !ok || t != f {
+			changed = append(changed, path)
+		}
+	}
+
+	for path := range toAttrs {
+		if _, ok := fromAttrs[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
@@ -0,0 +1,115 @@
+package tfplan
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPlan = `{
+  "format_version": "1.2",
+  "resource_changes": [
+    {
+      "address": "aws_instance.web",
+      "type": "aws_instance",
+      "name": "web",
+      "change": {
+        "actions": ["delete", "create"],
+        "before": {"ami": "ami-1", "instance_type": "t2.micro", "tags": {"env": "dev"}, "password": "hunter2"},
+        "after": {"ami": "ami-2", "instance_type": "t2.micro", "tags": {"env": "dev"}, "password": "hunter3"},
+        "after_unknown": {"id": true},
+        "before_sensitive": {"password": true},
+        "after_sensitive": {"password": true},
+        "replace_paths": [["ami"]]
+      }
+    },
+    {
+      "address": "aws_s3_bucket.logs",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "change": {
+        "actions": ["no-op"],
+        "before": {"bucket": "logs"},
+        "after": {"bucket": "logs"}
+      }
+    },
+    {
+      "address": "module.net.aws_vpc.main",
+      "module_address": "module.net",
+      "type": "aws_vpc",
+      "name": "main",
+      "change": {
+        "actions": ["create"],
+        "before": null,
+        "after": {"cidr_block": "10.0.0.0/16", "subnets": ["a", "b"]},
+        "after_unknown": {"arn": true}
+      }
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	changes, summary, err := Parse([]byte(testPlan))
+	require.NoError(t, err)
+
+	assert.Equal(t, Summary{Create: 1, Replace: 1}, summary)
+	require.Len(t, changes, 2)
+
+	assert.Equal(t, "aws_instance.web", changes[0].Address)
+	assert.Equal(t, ActionReplace, changes[0].Action)
+	assert.Equal(t, []AttributeChange{
+		{Path: "ami", Before: `"ami-1"`, After: `"ami-2"`, ForcesReplacement: true},
+		{Path: "id", Unknown: true},
+		{Path: "password", Sensitive: true},
+	}, changes[0].Attributes)
+
+	assert.Equal(t, "module.net", changes[1].ModuleAddress)
+	assert.Equal(t, ActionCreate, changes[1].Action)
+	assert.Equal(t, []AttributeChange{
+		{Path: "arn", Unknown: true},
+		{Path: "cidr_block", After: `"10.0.0.0/16"`},
+		{Path: "subnets[0]", After: `"a"`},
+		{Path: "subnets[1]", After: `"b"`},
+	}, changes[1].Attributes)
+}
+
+func TestParse_gzipped(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(testPlan))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	changes, _, err := Parse(buf.Bytes())
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+}
+
+func TestDiff(t *testing.T) {
+	from := Plan{
+		ID: "plan-1",
+		ResourceChanges: []ResourceChange{
+			{Address: "a.one", Action: ActionUpdate, Attributes: []AttributeChange{{Path: "size", Before: "1", After: "2"}}},
+			{Address: "a.two", Action: ActionCreate},
+			{Address: "a.three", Action: ActionDelete},
+		},
+	}
+
+	to := Plan{
+		ID: "plan-2",
+		ResourceChanges: []ResourceChange{
+			{Address: "a.one", Action: ActionUpdate, Attributes: []AttributeChange{{Path: "size", Before: "1", After: "3"}, {Path: "name", Before: `"x"`, After: `"y"`}}},
+			{Address: "a.two", Action: ActionCreate},
+			{Address: "a.four", Action: ActionReplace},
+		},
+	}
+
+	assert.Equal(t, []ResourceDiff{
+		{Address: "a.four", Status: DiffAdded, ToAction: ActionReplace},
+		{Address: "a.one", Status: DiffChanged, FromAction: ActionUpdate, ToAction: ActionUpdate, ChangedAttributes: []string{"name", "size"}},
+		{Address: "a.three", Status: DiffRemoved, FromAction: ActionDelete},
+	}, Diff(from, to))
+}
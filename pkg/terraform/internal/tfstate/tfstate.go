@@ -0,0 +1,196 @@
+// Package tfstate reads Terraform state files, as stored by the kubernetes
+// backend, into the outputs and resources they hold.
+package tfstate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// keyAttributes are the attributes that identify a resource, for the
+// providers that use them.
+var keyAttributes = []string{"id", "arn", "name", "self_link", "region", "location", "zone"}
+
+// Output is an output of a Terraform root module, its value is left out as
+// it is read from the output secret.
+type Output struct {
+	Name      string
+	Sensitive bool
+}
+
+// Resource is a resource instance of a Terraform state.
+type Resource struct {
+	Address       string
+	ModuleAddress string
+	Mode          string
+	Type          string
+	Name          string
+	Provider      string
+	// Attributes holds the key attributes of the resource which are not
+	// sensitive, JSON encoded unless they are strings.
+	Attributes map[string]string
+}
+
+type jsonState struct {
+	Outputs   map[string]jsonOutput `json:"outputs"`
+	Resources []jsonResource        `json:"resources"`
+}
+
+type jsonOutput struct {
+	Sensitive bool `json:"sensitive"`
+}
+
+type jsonResource struct {
+	Module    string         `json:"module"`
+	Mode      string         `json:"mode"`
+	Type      string         `json:"type"`
+	Name      string         `json:"name"`
+	Provider  string         `json:"provider"`
+	Instances []jsonInstance `json:"instances"`
+}
+
+type jsonInstance struct {
+	IndexKey            interface{}            `json:"index_key"`
+	Attributes          map[string]interface{} `json:"attributes"`
+	SensitiveAttributes []interface{}          `json:"sensitive_attributes"`
+}
+
+// State is a parsed Terraform state.
+type State struct {
+	Outputs   []Output
+	Resources []Resource
+}
+
+// Parse parses a state file, which may be gzipped.
+func Parse(data []byte) (*State, error) {
+	if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing state: %w", err)
+		}
+
+		data, err = io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing state: %w", err)
+		}
+	}
+
+	raw := jsonState{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing state: %w", err)
+	}
+
+	state := &State{
+		Outputs:   []Output{},
+		Resources: []Resource{},
+	}
+
+	for name, o := range raw.Outputs {
+		state.Outputs = append(state.Outputs, Output{
+			Name:      name,
+			Sensitive: o.Sensitive,
+		})
+	}
+
+	sort.Slice(state.Outputs, func(i, j int) bool {
+		return state.Outputs[i].Name < state.Outputs[j].Name
+	})
+
+	for _, r := range raw.Resources {
+		for _, instance := range r.Instances {
+			resource := Resource{
+				Address:       resourceAddress(r, instance.IndexKey),
+				ModuleAddress: r.Module,
+				Mode:          r.Mode,
+				Type:          r.Type,
+				Name:          r.Name,
+				Provider:      r.Provider,
+				Attributes:    map[string]string{},
+			}
+
+			sensitive := sensitiveAttributes(instance.SensitiveAttributes)
+
+			for _, key := range keyAttributes {
+				v, ok := instance.Attributes[key]
+				if !ok || v == nil || sensitive[key] {
+					continue
+				}
+
+				value, err := encode(v)
+				if err != nil {
+					return nil, fmt.Errorf("encoding %s of %s: %w", key, resource.Address, err)
+				}
+
+				resource.Attributes[key] = value
+			}
+
+			state.Resources = append(state.Resources, resource)
+		}
+	}
+
+	return state, nil
+}
+
+// resourceAddress builds the address of a resource instance, as shown by
+// `terraform state list`.
+func resourceAddress(r jsonResource, indexKey interface{}) string {
+	address := r.Type + "." + r.Name
+	if r.Mode == "data" {
+		address = "data." + address
+	}
+
+	if r.Module != "" {
+		address = r.Module + "." + address
+	}
+
+	switch key := indexKey.(type) {
+	case float64:
+		address = fmt.Sprintf("%s[%d]", address, int(key))
+	case string:
+		address = fmt.Sprintf("%s[%q]", address, key)
+	}
+
+	return address
+}
+
+// sensitiveAttributes returns the top level attributes marked as sensitive,
+// sensitive_attributes holds paths such as [{"type": "get_attr", "value": "password"}].
+func sensitiveAttributes(paths []interface{}) map[string]bool {
+	sensitive := map[string]bool{}
+
+	for _, p := range paths {
+		steps, ok := p.([]interface{})
+		if !ok || len(steps) == 0 {
+			continue
+		}
+
+		step, ok := steps[0].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := step["value"].(string); ok {
+			sensitive[name] = true
+		}
+	}
+
+	return sensitive
+}
+
+// encode JSON encodes a value, strings are left as is.
+func encode(v interface{}) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encoding value: %w", err)
+	}
+
+	return string(b), nil
+}
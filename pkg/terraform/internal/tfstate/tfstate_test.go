@@ -0,0 +1,76 @@
+package tfstate
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testState = `{
+  "version": 4,
+  "outputs": {
+    "vpc_id": {"value": "vpc-123", "type": "string"},
+    "db_password": {"value": "hunter2", "type": "string", "sensitive": true}
+  },
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_db_instance",
+      "name": "main",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+      "instances": [
+        {
+          "attributes": {"id": "db-1", "arn": "arn:aws:rds:db-1", "name": "main", "password": "hunter2"},
+          "sensitive_attributes": [[{"type": "get_attr", "value": "password"}], [{"type": "get_attr", "value": "name"}]]
+        }
+      ]
+    },
+    {
+      "module": "module.net",
+      "mode": "data",
+      "type": "aws_subnet",
+      "name": "private",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "subnet-a", "tags": {"a": "b"}}},
+        {"index_key": "b", "attributes": {"id": "subnet-b"}}
+      ]
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	state, err := Parse([]byte(testState))
+	require.NoError(t, err)
+
+	assert.Equal(t, []Output{
+		{Name: "db_password", Sensitive: true},
+		{Name: "vpc_id"},
+	}, state.Outputs)
+
+	require.Len(t, state.Resources, 3)
+
+	assert.Equal(t, "aws_db_instance.main", state.Resources[0].Address)
+	assert.Equal(t, "managed", state.Resources[0].Mode)
+	assert.Equal(t, map[string]string{"id": "db-1", "arn": "arn:aws:rds:db-1"}, state.Resources[0].Attributes)
+
+	assert.Equal(t, "module.net.data.aws_subnet.private[0]", state.Resources[1].Address)
+	assert.Equal(t, "module.net", state.Resources[1].ModuleAddress)
+	assert.Equal(t, map[string]string{"id": "subnet-a"}, state.Resources[1].Attributes)
+	assert.Equal(t, `module.net.data.aws_subnet.private["b"]`, state.Resources[2].Address)
+}
+
+func TestParse_gzipped(t *testing.T) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(testState))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	state, err := Parse(buf.Bytes())
+	require.NoError(t, err)
+	assert.Len(t, state.Resources, 3)
+}
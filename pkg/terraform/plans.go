@@ -0,0 +1,292 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/convert"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/tfplan"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// PlanHistoryLabel is set on the ConfigMaps holding the plan history of
+	// Terraform objects, its value is the name of the object.
+	PlanHistoryLabel = "terraform.weave.works/plan-history"
+
+	planHistoryKey = "plans"
+	// maxPlanHistory is the number of plans kept per object.
+	maxPlanHistory = 10
+)
+
+func (s *server) ListTerraformObjectPlans(ctx context.Context, msg *pb.ListTerraformObjectPlansRequest) (*pb.ListTerraformObjectPlansResponse, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	n := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+
+	obj := &tfctrl.Terraform{}
+	if err := c.Get(ctx, msg.ClusterName, n, obj); err != nil {
+		return nil, fmt.Errorf("getting object with name %s in namespace %s: %w", msg.Name, msg.Namespace, err)
+	}
+
+	result := &pb.ListTerraformObjectPlansResponse{}
+
+	data, err := s.getPlanHistory(ctx, msg.ClusterName, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := decodePlans(data)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := decodePlanDecisions(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Spec.StoreReadablePlan != "json" && len(plans) == 0 {
+		result.Error = "plans are only recorded for objects storing their plans as json"
+	}
+
+	for _, p := range plans {
+		plan := convert.ToPBTerraformPlan(p)
+		plan.Decision = decisions[p.ID].toPB()
+		result.Plans = append(result.Plans, plan)
+	}
+
+	return result, nil
+}
+
+func (s *server) DiffTerraformObjectPlans(ctx context.Context, msg *pb.DiffTerraformObjectPlansRequest) (*pb.DiffTerraformObjectPlansResponse, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	n := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+
+	obj := &tfctrl.Terraform{}
+	if err := c.Get(ctx, msg.ClusterName, n, obj); err != nil {
+		return nil, fmt.Errorf("getting object with name %s in namespace %s: %w", msg.Name, msg.Namespace, err)
+	}
+
+	data, err := s.getPlanHistory(ctx, msg.ClusterName, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	plans, err := decodePlans(data)
+	if err != nil {
+		return nil, err
+	}
+
+	to := 0
+	if msg.ToPlanId != "" {
+		if to = findPlan(plans, msg.ToPlanId); to < 0 {
+			return nil, grpcStatus.Errorf(codes.NotFound, "plan %s not found in the history of %s in namespace %s", msg.ToPlanId, msg.Name, msg.Namespace)
+		}
+	}
+
+	from := to + 1
+	if msg.FromPlanId != "" {
+		if from = findPlan(plans, msg.FromPlanId); from < 0 {
+			return nil, grpcStatus.Errorf(codes.NotFound, "plan %s not found in the history of %s in namespace %s", msg.FromPlanId, msg.Name, msg.Namespace)
+		}
+	}
+
+	if from >= len(plans) || to >= len(plans) {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "not enough plans recorded for %s in namespace %s", msg.Name, msg.Namespace)
+	}
+
+	return &pb.DiffTerraformObjectPlansResponse{
+		FromPlanId: plans[from].ID,
+		ToPlanId:   plans[to].ID,
+		Resources:  convert.ToPBTerraformPlanResourceDiffs(tfplan.Diff(plans[from], plans[to])),
+	}, nil
+}
+
+func findPlan(plans []tfplan.Plan, id string) int {
+	for i, p := range plans {
+		if p.ID == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// getJSONPlan reads the plan stored by the tf-controller when
+// spec.storeReadablePlan is json.
+func getJSONPlan(ctx context.Context, c clustersmngr.Client, cluster string, obj *tfctrl.Terraform) (*tfplan.Plan, error) {
+	key := types.NamespacedName{
+		Name:      fmt.Sprintf("tfplan-%s-%s.json", obj.WorkspaceName(), obj.Name),
+		Namespace: obj.Namespace,
+	}
+
+	var secret corev1.Secret
+	if err := c.Get(ctx, cluster, key, &secret); err != nil {
+		return nil, err
+	}
+
+	changes, summary, err := tfplan.Parse(secret.Data["tfplan"])
+	if err != nil {
+		return nil, err
+	}
+
+	id := obj.Status.Plan.Pending
+	if id == "" {
+		id = obj.Status.Plan.LastApplied
+	}
+
+	return &tfplan.Plan{
+		ID:              id,
+		Revision:        obj.Status.LastPlannedRevision,
+		RecordedAt:      time.Now().UTC(),
+		Summary:         summary,
+		ResourceChanges: changes,
+	}, nil
+}
+
+func planHistoryName(name string) string {
+	return fmt.Sprintf("tfplan-history-%s", name)
+}
+
+// getPlanHistory returns the data of the plan history ConfigMap of an object,
+// which is empty if nothing was recorded yet.
+func (s *server) getPlanHistory(ctx context.Context, cluster string, obj *tfctrl.Terraform) (map[string]string, error) {
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting server client: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := sc.Get(ctx, cluster, types.NamespacedName{Name: planHistoryName(obj.Name), Namespace: obj.Namespace}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]string{}, nil
+		}
+
+		return nil, fmt.Errorf("getting plan history: %w", err)
+	}
+
+	return cm.Data, nil
+}
+
+// recordPlan adds a plan to the history of an object unless it is the latest
+// one recorded, and returns the history.
+func (s *server) recordPlan(ctx context.Context, cluster string, obj *tfctrl.Terraform, plan tfplan.Plan) ([]tfplan.Plan, error) {
+	var plans []tfplan.Plan
+
+	err := s.updatePlanHistory(ctx, cluster, obj, func(cm *corev1.ConfigMap) error {
+		var err error
+		if plans, err = decodePlans(cm.Data); err != nil {
+			return err
+		}
+
+		if plan.ID == "" || (len(plans) > 0 && plans[0].ID == plan.ID) {
+			return nil
+		}
+
+		plans = append([]tfplan.Plan{plan}, plans...)
+		if len(plans) > maxPlanHistory {
+			plans = plans[:maxPlanHistory]
+		}
+
+		b, err := json.Marshal(plans)
+		if err != nil {
+			return fmt.Errorf("encoding plan history: %w", err)
+		}
+
+		cm.Data[planHistoryKey] = string(b)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("recording plan %s: %w", plan.ID, err)
+	}
+
+	return plans, nil
+}
+
+// updatePlanHistory applies fn to the plan history ConfigMap of an object,
+// creating it if needed.
+func (s *server) updatePlanHistory(ctx context.Context, cluster string, obj *tfctrl.Terraform, fn func(cm *corev1.ConfigMap) error) error {
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return fmt.Errorf("getting server client: %w", err)
+	}
+
+	key := types.NamespacedName{Name: planHistoryName(obj.Name), Namespace: obj.Namespace}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+
+		err := sc.Get(ctx, cluster, key, cm)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("getting plan history: %w", err)
+		}
+
+		exists := err == nil
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+
+		if err := fn(cm); err != nil {
+			return err
+		}
+
+		if exists {
+			return sc.Update(ctx, cluster, cm)
+		}
+
+		cm.ObjectMeta = metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				PlanHistoryLabel: obj.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: tfctrl.GroupVersion.String(),
+					Kind:       tfctrl.TerraformKind,
+					Name:       obj.Name,
+					UID:        obj.UID,
+				},
+			},
+		}
+
+		return sc.Create(ctx, cluster, cm)
+	})
+}
+
+// decodePlans returns the plans of a plan history, newest first.
+func decodePlans(data map[string]string) ([]tfplan.Plan, error) {
+	plans := []tfplan.Plan{}
+
+	if data[planHistoryKey] == "" {
+		return plans, nil
+	}
+
+	if err := json.Unmarshal([]byte(data[planHistoryKey]), &plans); err != nil {
+		return nil, fmt.Errorf("decoding plan history: %w", err)
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,171 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultPlanRecordingInterval is how often the plans of the Terraform objects are recorded.
+const DefaultPlanRecordingInterval = time.Minute
+
+// planRecordingLeaseName is the Lease electing the replica of the management cluster that records the plans
+const planRecordingLeaseName = "weave-gitops-terraform-plan-recording"
+
+// PlanRecorder records the plans of the Terraform objects storing their plans
+// as json in their plan history, so the history doesn't depend on the plans
+// being viewed.
+type PlanRecorder struct {
+	s        *server
+	interval time.Duration
+}
+
+// NewPlanRecorder creates a PlanRecorder using the server client of the ClientsFactory of opts.
+func NewPlanRecorder(opts ServerOpts, interval time.Duration) *PlanRecorder {
+	return &PlanRecorder{
+		s: &server{
+			log:     opts.Logger.WithName("plan-recorder"),
+			clients: opts.ClientsFactory,
+			scheme:  opts.Scheme,
+		},
+		interval: interval,
+	}
+}
+
+// Start records the plans every interval until the context is done.
+func (r *PlanRecorder) Start(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.RecordPlans(ctx); err != nil {
+			r.s.log.Error(err, "failed to record terraform plans")
+		}
+	}, r.interval)
+}
+
+// StartWithLeaderElection records the plans every interval while holding the plan recording Lease of the
+// namespace, so only one replica of the management cluster records them.
+func (r *PlanRecorder) StartWithLeaderElection(ctx context.Context, leases coordinationv1.CoordinationV1Interface, namespace, identity string) error {
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      planRecordingLeaseName,
+				Namespace: namespace,
+			},
+			Client:     leases,
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: r.Start,
+			OnStoppedLeading: func() {
+				r.s.log.Info("stopped leading terraform plan recording", "identity", identity)
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create terraform plan recording leader election: %w", err)
+	}
+
+	// the elector returns when the lease is lost, so it runs again to wait for the lease
+	wait.UntilWithContext(ctx, elector.Run, r.interval)
+	return nil
+}
+
+// RecordPlans records the pending or last applied plan of every Terraform
+// object storing its plans as json, unless it is already the latest plan of
+// its history. A failure to record the plan of an object doesn't stop the
+// recording of the others.
+func (r *PlanRecorder) RecordPlans(ctx context.Context) error {
+	sc, err := r.s.clients.GetServerClient(ctx)
+	if err != nil {
+		return fmt.Errorf("getting server client: %w", err)
+	}
+
+	clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &tfctrl.TerraformList{}
+	})
+
+	if err := sc.ClusteredList(ctx, clist, true); err != nil {
+		var errs clustersmngr.ClusteredListError
+
+		if !errors.As(err, &errs) {
+			return fmt.Errorf("listing terraform objects: %w", err)
+		}
+
+		for _, e := range errs.Errors {
+			if apimeta.IsNoMatchError(e.Err) {
+				continue
+			}
+
+			r.s.log.Error(e.Err, "failed to list terraform objects", "cluster", e.Cluster)
+		}
+	}
+
+	for clusterName, lists := range clist.Lists() {
+		for _, l := range lists {
+			list, ok := l.(*tfctrl.TerraformList)
+			if !ok {
+				continue
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				if err := r.recordPlan(ctx, sc, clusterName, obj); err != nil {
+					r.s.log.Error(err, "failed to record terraform plan", "name", obj.Name, "namespace", obj.Namespace, "cluster", clusterName)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *PlanRecorder) recordPlan(ctx context.Context, sc clustersmngr.Client, cluster string, obj *tfctrl.Terraform) error {
+	if obj.Spec.StoreReadablePlan != "json" {
+		return nil
+	}
+
+	id := obj.Status.Plan.Pending
+	if id == "" {
+		id = obj.Status.Plan.LastApplied
+	}
+	if id == "" {
+		return nil
+	}
+
+	data, err := r.s.getPlanHistory(ctx, cluster, obj)
+	if err != nil {
+		return err
+	}
+
+	plans, err := decodePlans(data)
+	if err != nil {
+		return err
+	}
+
+	if len(plans) > 0 && plans[0].ID == id {
+		return nil
+	}
+
+	plan, err := getJSONPlan(ctx, sc, cluster, obj)
+	if err != nil {
+		return fmt.Errorf("getting terraform plan: %w", err)
+	}
+
+	_, err = r.s.recordPlan(ctx, cluster, obj, *plan)
+
+	return err
+}
@@ -11,12 +11,17 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/go-multierror"
 	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/cmd/clusters-service/pkg/mgmtfetcher"
 	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/cluster/fetcher"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/git"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/adapter"
 	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/convert"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,6 +36,12 @@ type ServerOpts struct {
 	logr.Logger
 	ClientsFactory clustersmngr.ClustersManager
 	Scheme         *k8sruntime.Scheme
+	// ProviderCreator is used to open pull requests approving plans.
+	ProviderCreator git.ProviderCreator
+	// ManagementFetcher lists the GitopsClusters to resolve cluster selectors.
+	ManagementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher
+	// Cluster is the name of the management cluster.
+	Cluster string
 }
 
 type server struct {
@@ -39,6 +50,10 @@ type server struct {
 	log     logr.Logger
 	clients clustersmngr.ClustersManager
 	scheme  *k8sruntime.Scheme
+
+	providerCreator   git.ProviderCreator
+	managementFetcher *mgmtfetcher.ManagementCrossNamespacesFetcher
+	cluster           string
 }
 
 func Hydrate(ctx context.Context, mux *runtime.ServeMux, opts ServerOpts) error {
@@ -52,10 +67,19 @@ func NewTerraformServer(opts ServerOpts) pb.TerraformServer {
 		log:     opts.Logger,
 		clients: opts.ClientsFactory,
 		scheme:  opts.Scheme,
+
+		providerCreator:   opts.ProviderCreator,
+		managementFetcher: opts.ManagementFetcher,
+		cluster:           opts.Cluster,
 	}
 }
 
 func (s *server) ListTerraformObjects(ctx context.Context, msg *pb.ListTerraformObjectsRequest) (*pb.ListTerraformObjectsResponse, error) {
+	clusterSelector, err := s.selectClusters(ctx, msg.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
 
 	if err != nil {
@@ -89,6 +113,10 @@ func (s *server) ListTerraformObjects(ctx context.Context, msg *pb.ListTerraform
 		}
 
 		for _, e := range errs.Errors {
+			if !clusterSelector.Matches(e.Cluster) {
+				continue
+			}
+
 			if apimeta.IsNoMatchError(e.Err) {
 				// Skip reporting an error if a leaf cluster does not have the tf-controller CRD installed.
 				// It is valid for leaf clusters to not have tf installed.
@@ -108,6 +136,10 @@ func (s *server) ListTerraformObjects(ctx context.Context, msg *pb.ListTerraform
 	results := []*pb.TerraformObject{}
 
 	for clusterName, lists := range clist.Lists() {
+		if !clusterSelector.Matches(clusterName) {
+			continue
+		}
+
 		for _, l := range lists {
 			list, ok := l.(*tfctrl.TerraformList)
 			if !ok {
@@ -171,6 +203,18 @@ func (s *server) GetTerraformObjectPlan(ctx context.Context, msg *pb.GetTerrafor
 		EnablePlanViewing: obj.Spec.StoreReadablePlan == "human",
 	}
 
+	if obj.Spec.StoreReadablePlan == "json" {
+		plan, err := getJSONPlan(ctx, c, msg.ClusterName, obj)
+		if err != nil {
+			result.Error = fmt.Sprintf("getting terraform plan: %s", err.Error())
+			return result, nil
+		}
+
+		result.StructuredPlan = convert.ToPBTerraformPlan(*plan)
+
+		return result, nil
+	}
+
 	if obj.Spec.StoreReadablePlan != "human" {
 		result.Error = "no human-readable plan found"
 		return result, nil
@@ -198,9 +242,18 @@ func (s *server) SyncTerraformObjects(ctx context.Context, msg *pb.SyncTerraform
 		return nil, fmt.Errorf("getting impersonated client: %w", err)
 	}
 
+	objects := msg.Objects
+
+	if msg.ClusterSelector != "" {
+		objects, err = s.selectTerraformObjects(ctx, clustersClient, msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	respErrors := multierror.Error{}
 
-	for _, sync := range msg.Objects {
+	for _, sync := range objects {
 		c, err := clustersClient.Scoped(sync.ClusterName)
 		if err != nil {
 			respErrors = *multierror.Append(fmt.Errorf("getting scoped client: %w", err), respErrors.Errors...)
@@ -310,6 +363,91 @@ func (s *server) ReplanTerraformObject(ctx context.Context, msg *pb.ReplanTerraf
 	return &pb.ReplanTerraformObjectResponse{ReplanRequested: true}, nil
 }
 
+// selectTerraformObjects returns the objects to sync of the clusters matching the cluster selector of
+// the request: the objects of the request, which must be in matching clusters, or all the objects of
+// the matching clusters in the namespace of the request, all namespaces if empty.
+func (s *server) selectTerraformObjects(ctx context.Context, c clustersmngr.Client, msg *pb.SyncTerraformObjectsRequest) ([]*pb.ObjectRef, error) {
+	clusterSelector, err := s.selectClusters(ctx, msg.ClusterSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Objects) > 0 {
+		for _, obj := range msg.Objects {
+			if !clusterSelector.Matches(obj.ClusterName) {
+				return nil, grpcStatus.Errorf(codes.InvalidArgument, "cluster %s of object %s does not match the cluster selector", obj.ClusterName, obj.Name)
+			}
+		}
+
+		return msg.Objects, nil
+	}
+
+	clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+		return &tfctrl.TerraformList{}
+	})
+
+	if err := c.ClusteredList(ctx, clist, true); err != nil {
+		var errs clustersmngr.ClusteredListError
+
+		if !errors.As(err, &errs) {
+			return nil, fmt.Errorf("listing terraform objects: %w", err)
+		}
+
+		for _, e := range errs.Errors {
+			if clusterSelector.Matches(e.Cluster) && !apimeta.IsNoMatchError(e.Err) {
+				return nil, fmt.Errorf("listing terraform objects on cluster %s: %w", e.Cluster, e.Err)
+			}
+		}
+	}
+
+	objects := []*pb.ObjectRef{}
+
+	for clusterName, lists := range clist.Lists() {
+		if !clusterSelector.Matches(clusterName) {
+			continue
+		}
+
+		for _, l := range lists {
+			list, ok := l.(*tfctrl.TerraformList)
+			if !ok {
+				continue
+			}
+
+			for _, t := range list.Items {
+				if msg.Namespace != "" && t.Namespace != msg.Namespace {
+					continue
+				}
+
+				objects = append(objects, &pb.ObjectRef{ClusterName: clusterName, Name: t.Name, Namespace: t.Namespace})
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// selectClusters returns the clusters matching a cluster selector, all the clusters if empty.
+func (s *server) selectClusters(ctx context.Context, selector string) (*fetcher.ClusterSelector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+
+	if s.managementFetcher == nil {
+		return nil, grpcStatus.Error(codes.Unimplemented, "cluster selectors are not enabled")
+	}
+
+	clusterSelector, err := fetcher.SelectClusters(ctx, s.managementFetcher, s.cluster, selector)
+	if err != nil {
+		if errors.Is(err, fetcher.ErrInvalidClusterSelector) {
+			return nil, grpcStatus.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return clusterSelector, nil
+}
+
 func serializeObj(scheme *k8sruntime.Scheme, obj client.Object) ([]byte, error) {
 	obj.GetObjectKind().SetGroupVersionKind(tfctrl.GroupVersion.WithKind(tfctrl.TerraformKind))
 
@@ -17,11 +17,14 @@ import (
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
 	"google.golang.org/grpc"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -150,6 +153,93 @@ func TestGetTerraformObjectPlan(t *testing.T) {
 	assert.Equal(t, res.Plan, expectedPlan)
 }
 
+func TestTerraformObjectPlans(t *testing.T) {
+	ctx := context.Background()
+	client, k8s := setup(t)
+
+	tfObj := &tfctrl.Terraform{}
+	tfObj.Name = "my-obj"
+	tfObj.Namespace = "default"
+	tfObj.Spec.StoreReadablePlan = "json"
+
+	assert.NoError(t, k8s.Create(ctx, tfObj))
+
+	planSecret := &corev1.Secret{}
+	planSecret.Name = "tfplan-default-my-obj.json"
+	planSecret.Namespace = "default"
+
+	setPlan := func(id, size string) {
+		planSecret.Data = map[string][]byte{
+			"tfplan": []byte(`{"resource_changes": [{"address": "aws_instance.web", "type": "aws_instance", "name": "web", "change": {"actions": ["update"], "before": {"size": "small"}, "after": {"size": "` + size + `"}}}]}`),
+		}
+		if planSecret.ResourceVersion == "" {
+			assert.NoError(t, k8s.Create(ctx, planSecret))
+		} else {
+			assert.NoError(t, k8s.Update(ctx, planSecret))
+		}
+
+		tfObj.Status.Plan.Pending = id
+		assert.NoError(t, k8s.Status().Update(ctx, tfObj))
+	}
+
+	setPlan("plan-main-1", "medium")
+
+	res, err := client.GetTerraformObjectPlan(ctx, &pb.GetTerraformObjectPlanRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Error)
+	assert.Equal(t, "plan-main-1", res.StructuredPlan.PlanId)
+	assert.Equal(t, int32(1), res.StructuredPlan.Summary.Update)
+	assert.Equal(t, "update", res.StructuredPlan.ResourceChanges[0].Action)
+	assert.Equal(t, `"medium"`, res.StructuredPlan.ResourceChanges[0].Attributes[0].After)
+
+	// Viewing a plan doesn't record it
+	history := &corev1.ConfigMap{}
+	err = k8s.Get(ctx, types.NamespacedName{Name: "tfplan-history-my-obj", Namespace: "default"}, history)
+	assert.True(t, apierrors.IsNotFound(err), "expected no plan history, got %v", err)
+
+	recordPlans(ctx, t, k8s)
+	// Recording the same plan again doesn't add it to the history twice
+	recordPlans(ctx, t, k8s)
+
+	setPlan("plan-main-2", "large")
+	recordPlans(ctx, t, k8s)
+
+	plans, err := client.ListTerraformObjectPlans(ctx, &pb.ListTerraformObjectPlansRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, plans.Error)
+	assert.Len(t, plans.Plans, 2)
+	assert.Equal(t, "plan-main-2", plans.Plans[0].PlanId)
+	assert.Equal(t, "plan-main-1", plans.Plans[1].PlanId)
+
+	diff, err := client.DiffTerraformObjectPlans(ctx, &pb.DiffTerraformObjectPlansRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "plan-main-1", diff.FromPlanId)
+	assert.Equal(t, "plan-main-2", diff.ToPlanId)
+	assert.Len(t, diff.Resources, 1)
+	assert.Equal(t, "changed", diff.Resources[0].Status)
+	assert.Equal(t, []string{"size"}, diff.Resources[0].ChangedAttributes)
+
+	_, err = client.DiffTerraformObjectPlans(ctx, &pb.DiffTerraformObjectPlansRequest{
+		ClusterName: "Default",
+		Name:        tfObj.Name,
+		Namespace:   tfObj.Namespace,
+		FromPlanId:  "plan-main-0",
+	})
+	assert.Error(t, err)
+}
+
 func TestSyncTerraformObject(t *testing.T) {
 	ctx := context.Background()
 	client, k8s := setup(t)
@@ -270,6 +360,88 @@ func TestReplanTerraformObject(t *testing.T) {
 	}
 }
 
+func TestPlanRecorder_StartWithLeaderElection(t *testing.T) {
+	newTerraformObject := func(t *testing.T, ctx context.Context, k8s client.Client) {
+		tfObj := &tfctrl.Terraform{}
+		tfObj.Name = "my-obj"
+		tfObj.Namespace = "default"
+		tfObj.Spec.StoreReadablePlan = "json"
+		assert.NoError(t, k8s.Create(ctx, tfObj))
+
+		planSecret := &corev1.Secret{}
+		planSecret.Name = "tfplan-default-my-obj.json"
+		planSecret.Namespace = "default"
+		planSecret.Data = map[string][]byte{
+			"tfplan": []byte(`{"resource_changes": []}`),
+		}
+		assert.NoError(t, k8s.Create(ctx, planSecret))
+
+		tfObj.Status.Plan.Pending = "plan-main-1"
+		assert.NoError(t, k8s.Status().Update(ctx, tfObj))
+	}
+
+	newRecorder := func(k8s client.Client) *terraform.PlanRecorder {
+		namespaces := map[string][]corev1.Namespace{
+			"Default": {corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}},
+		}
+
+		return terraform.NewPlanRecorder(terraform.ServerOpts{
+			Logger:         logr.Discard(),
+			ClientsFactory: grpctesting.MakeClustersManager(k8s, namespaces),
+		}, 10*time.Millisecond)
+	}
+
+	planRecorded := func(ctx context.Context, k8s client.Client) bool {
+		history := &corev1.ConfigMap{}
+		return k8s.Get(ctx, types.NamespacedName{Name: "tfplan-history-my-obj", Namespace: "default"}, history) == nil
+	}
+
+	t.Run("records the plans while leading", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, k8s := setup(t)
+		newTerraformObject(t, ctx, k8s)
+
+		go func() {
+			assert.NoError(t, newRecorder(k8s).StartWithLeaderElection(ctx, kubefake.NewSimpleClientset().CoordinationV1(), "flux-system", "replica-1"))
+		}()
+
+		assert.Eventually(t, func() bool {
+			return planRecorded(ctx, k8s)
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("doesn't record the plans while another replica leads", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		_, k8s := setup(t)
+		newTerraformObject(t, ctx, k8s)
+
+		holder := "replica-1"
+		leaseDuration := int32(60)
+		renewTime := metav1.NewMicroTime(time.Now())
+		leases := kubefake.NewSimpleClientset(&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: "weave-gitops-terraform-plan-recording", Namespace: "flux-system"},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &holder,
+				LeaseDurationSeconds: &leaseDuration,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+			},
+		}).CoordinationV1()
+
+		go func() {
+			assert.NoError(t, newRecorder(k8s).StartWithLeaderElection(ctx, leases, "flux-system", "replica-2"))
+		}()
+
+		assert.Never(t, func() bool {
+			return planRecorded(ctx, k8s)
+		}, 500*time.Millisecond, 10*time.Millisecond)
+	})
+}
+
 func setup(t *testing.T) (pb.TerraformClient, client.Client) {
 	k8s := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).WithStatusSubresource(&tfctrl.Terraform{}).Build()
 
@@ -291,6 +463,22 @@ func setup(t *testing.T) (pb.TerraformClient, client.Client) {
 	return pb.NewTerraformClient(conn), k8s
 }
 
+// recordPlans records the plans of the Terraform objects in the default namespace of the Default cluster.
+func recordPlans(ctx context.Context, t *testing.T, k8s client.Client) {
+	t.Helper()
+
+	namespaces := map[string][]corev1.Namespace{
+		"Default": {corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}},
+	}
+
+	recorder := terraform.NewPlanRecorder(terraform.ServerOpts{
+		Logger:         logr.Discard(),
+		ClientsFactory: grpctesting.MakeClustersManager(k8s, namespaces),
+	}, time.Minute)
+
+	assert.NoError(t, recorder.RecordPlans(ctx))
+}
+
 // Use this function when you want to override the behavior of clustersmngr.Client.
 // You must provide a stub or return for the FakeClient to see objects.
 func setupWithFakes(t *testing.T) (pb.TerraformClient, client.Client, *fc.FakeClient) {
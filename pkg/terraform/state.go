@@ -0,0 +1,183 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/convert"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform/internal/tfstate"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var errStateNotInCluster = errors.New("the state is not stored in the cluster")
+
+func (s *server) ListTerraformObjectOutputs(ctx context.Context, msg *pb.ListTerraformObjectOutputsRequest) (*pb.ListTerraformObjectOutputsResponse, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	n := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+
+	obj := &tfctrl.Terraform{}
+	if err := c.Get(ctx, msg.ClusterName, n, obj); err != nil {
+		return nil, fmt.Errorf("getting object with name %s in namespace %s: %w", msg.Name, msg.Namespace, err)
+	}
+
+	result := &pb.ListTerraformObjectOutputsResponse{}
+
+	if obj.Spec.WriteOutputsToSecret == nil || obj.Spec.WriteOutputsToSecret.Name == "" {
+		for _, name := range obj.Status.AvailableOutputs {
+			result.Outputs = append(result.Outputs, &pb.TerraformOutput{Name: name})
+		}
+
+		result.Error = "output values are only available for objects writing their outputs to a secret"
+
+		return result, nil
+	}
+
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting server client: %w", err)
+	}
+
+	key := types.NamespacedName{Name: obj.Spec.WriteOutputsToSecret.Name, Namespace: obj.Namespace}
+
+	secret := &corev1.Secret{}
+	if err := sc.Get(ctx, msg.ClusterName, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			result.Error = fmt.Sprintf("output secret %s not found", key.Name)
+			return result, nil
+		}
+
+		return nil, fmt.Errorf("getting output secret %s: %w", key.Name, err)
+	}
+
+	// Users allowed to read the output secret see every value.
+	canRead := true
+	if err := c.Get(ctx, msg.ClusterName, key, &corev1.Secret{}); err != nil {
+		if !apierrors.IsForbidden(err) {
+			return nil, fmt.Errorf("getting output secret %s: %w", key.Name, err)
+		}
+
+		canRead = false
+	}
+
+	// Outputs are considered sensitive unless the state says otherwise.
+	sensitive := map[string]bool{}
+	known := false
+
+	if state, err := getState(ctx, sc, msg.ClusterName, obj); err == nil {
+		known = true
+		for _, o := range state.Outputs {
+			sensitive[o.Name] = o.Sensitive
+		}
+	} else {
+		s.log.V(1).Info("reading terraform state", "name", msg.Name, "namespace", msg.Namespace, "cluster", msg.ClusterName, "error", err.Error())
+	}
+
+	names := []string{}
+	for name := range secret.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		isSensitive, ok := sensitive[name]
+		if !known || !ok {
+			isSensitive = true
+		}
+
+		output := &pb.TerraformOutput{
+			Name:      name,
+			Sensitive: isSensitive,
+		}
+
+		if isSensitive && !canRead {
+			output.Masked = true
+		} else {
+			output.Value = string(secret.Data[name])
+		}
+
+		result.Outputs = append(result.Outputs, output)
+	}
+
+	return result, nil
+}
+
+func (s *server) ListTerraformObjectStateResources(ctx context.Context, msg *pb.ListTerraformObjectStateResourcesRequest) (*pb.ListTerraformObjectStateResourcesResponse, error) {
+	c, err := s.clients.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("getting impersonated client: %w", err)
+	}
+
+	n := types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace}
+
+	obj := &tfctrl.Terraform{}
+	if err := c.Get(ctx, msg.ClusterName, n, obj); err != nil {
+		return nil, fmt.Errorf("getting object with name %s in namespace %s: %w", msg.Name, msg.Namespace, err)
+	}
+
+	sc, err := s.clients.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting server client: %w", err)
+	}
+
+	result := &pb.ListTerraformObjectStateResourcesResponse{}
+
+	// Only the key attributes of resources are returned, which is why the
+	// state is read with the server client.
+	state, err := getState(ctx, sc, msg.ClusterName, obj)
+	if err != nil {
+		if errors.Is(err, errStateNotInCluster) || apierrors.IsNotFound(err) {
+			result.Error = fmt.Sprintf("reading terraform state: %s", err.Error())
+			return result, nil
+		}
+
+		return nil, fmt.Errorf("reading terraform state: %w", err)
+	}
+
+	result.Resources = convert.ToPBTerraformStateResources(state.Resources)
+
+	return result, nil
+}
+
+// getState reads the state of an object from the secret written by the
+// kubernetes backend the tf-controller configures by default.
+func getState(ctx context.Context, c clustersmngr.Client, cluster string, obj *tfctrl.Terraform) (*tfstate.State, error) {
+	suffix := obj.Name
+
+	if obj.Spec.Cloud != nil {
+		return nil, errStateNotInCluster
+	}
+
+	if backend := obj.Spec.BackendConfig; backend != nil {
+		if backend.Disable || backend.CustomConfiguration != "" {
+			return nil, errStateNotInCluster
+		}
+
+		if backend.SecretSuffix != "" {
+			suffix = backend.SecretSuffix
+		}
+	}
+
+	key := types.NamespacedName{
+		Name:      fmt.Sprintf("tfstate-%s-%s", obj.WorkspaceName(), suffix),
+		Namespace: obj.Namespace,
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, cluster, key, secret); err != nil {
+		return nil, err
+	}
+
+	return tfstate.Parse(secret.Data["tfstate"])
+}
@@ -0,0 +1,151 @@
+package terraform_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tfctrl "github.com/weaveworks/tf-controller/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops-enterprise/internal/grpctesting"
+	pb "github.com/weaveworks/weave-gitops-enterprise/pkg/api/terraform"
+	"github.com/weaveworks/weave-gitops-enterprise/pkg/terraform"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const testState = `{
+  "version": 4,
+  "outputs": {
+    "vpc_id": {"value": "vpc-123", "type": "string"},
+    "db_password": {"value": "hunter2", "type": "string", "sensitive": true}
+  },
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_vpc",
+      "name": "main",
+      "provider": "provider[\"registry.terraform.io/hashicorp/aws\"]",
+      "instances": [{"attributes": {"id": "vpc-123", "cidr_block": "10.0.0.0/16"}}]
+    }
+  ]
+}`
+
+func TestListTerraformObjectOutputs(t *testing.T) {
+	ctx := context.Background()
+	client, k8s := setup(t)
+
+	createStateObject(ctx, t, k8s)
+
+	res, err := client.ListTerraformObjectOutputs(ctx, &pb.ListTerraformObjectOutputsRequest{
+		ClusterName: "Default",
+		Name:        "my-obj",
+		Namespace:   "default",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Error)
+	assert.Equal(t, []*pb.TerraformOutput{
+		{Name: "db_password", Value: "hunter2", Sensitive: true},
+		{Name: "vpc_id", Value: "vpc-123"},
+	}, res.Outputs)
+}
+
+func TestListTerraformObjectOutputs_Masked(t *testing.T) {
+	ctx := context.Background()
+	k8s := fake.NewClientBuilder().WithScheme(grpctesting.BuildScheme()).Build()
+
+	namespaces := map[string][]corev1.Namespace{
+		"Default": {corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}},
+	}
+
+	// The user may read the Terraform object but not secrets.
+	userK8s := interceptor.NewClient(k8s.(client.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if _, ok := obj.(*corev1.Secret); ok {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, key.Name, nil)
+			}
+
+			return c.Get(ctx, key, obj, opts...)
+		},
+	})
+
+	userClient, err := grpctesting.MakeClustersManager(userK8s, namespaces).GetImpersonatedClient(ctx, nil)
+	require.NoError(t, err)
+
+	factory := grpctesting.MakeClustersManager(k8s, namespaces)
+	factory.GetImpersonatedClientReturns(userClient, nil)
+
+	srv := terraform.NewTerraformServer(terraform.ServerOpts{
+		Logger:         logr.Discard(),
+		ClientsFactory: factory,
+	})
+	conn := grpctesting.Setup(t, func(s *grpc.Server) {
+		pb.RegisterTerraformServer(s, srv)
+	})
+
+	createStateObject(ctx, t, k8s)
+
+	res, err := pb.NewTerraformClient(conn).ListTerraformObjectOutputs(ctx, &pb.ListTerraformObjectOutputsRequest{
+		ClusterName: "Default",
+		Name:        "my-obj",
+		Namespace:   "default",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.TerraformOutput{
+		{Name: "db_password", Sensitive: true, Masked: true},
+		{Name: "vpc_id", Value: "vpc-123"},
+	}, res.Outputs)
+}
+
+func TestListTerraformObjectStateResources(t *testing.T) {
+	ctx := context.Background()
+	client, k8s := setup(t)
+
+	createStateObject(ctx, t, k8s)
+
+	res, err := client.ListTerraformObjectStateResources(ctx, &pb.ListTerraformObjectStateResourcesRequest{
+		ClusterName: "Default",
+		Name:        "my-obj",
+		Namespace:   "default",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Error)
+	require.Len(t, res.Resources, 1)
+	assert.Equal(t, "aws_vpc.main", res.Resources[0].Address)
+	assert.Equal(t, "managed", res.Resources[0].Mode)
+	assert.Equal(t, map[string]string{"id": "vpc-123"}, res.Resources[0].Attributes)
+}
+
+func createStateObject(ctx context.Context, t *testing.T, k client.Client) {
+	t.Helper()
+
+	tfObj := &tfctrl.Terraform{}
+	tfObj.Name = "my-obj"
+	tfObj.Namespace = "default"
+	tfObj.Spec.WriteOutputsToSecret = &tfctrl.WriteOutputsToSecretSpec{Name: "my-obj-outputs"}
+	require.NoError(t, k.Create(ctx, tfObj))
+
+	outputs := &corev1.Secret{}
+	outputs.Name = "my-obj-outputs"
+	outputs.Namespace = "default"
+	outputs.Data = map[string][]byte{
+		"vpc_id":      []byte("vpc-123"),
+		"db_password": []byte("hunter2"),
+	}
+	require.NoError(t, k.Create(ctx, outputs))
+
+	state := &corev1.Secret{}
+	state.Name = "tfstate-default-my-obj"
+	state.Namespace = "default"
+	state.Data = map[string][]byte{
+		"tfstate": []byte(testState),
+	}
+	require.NoError(t, k.Create(ctx, state))
+}
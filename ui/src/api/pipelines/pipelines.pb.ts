@@ -34,6 +34,9 @@ export type ApprovePromotionRequest = {
 
 export type ApprovePromotionResponse = {
   pullRequestUrl?: string
+  approvals?: number
+  requiredApprovals?: number
+  promoted?: boolean
 }
 
 export type ListError = {
@@ -50,6 +53,52 @@ export type ListPullRequestsResponse = {
   pullRequests?: {[key: string]: string}
 }
 
+export type ListPromotionHistoryRequest = {
+  name?: string
+  namespace?: string
+  env?: string
+}
+
+export type ListPromotionHistoryResponse = {
+  environments?: PipelinesV1Types.EnvironmentHistory[]
+  errors?: string[]
+}
+
+export type ListPendingApprovalsRequest = {
+  name?: string
+  namespace?: string
+}
+
+export type ListPendingApprovalsResponse = {
+  pendingApprovals?: PipelinesV1Types.PendingApproval[]
+}
+
+export type DiffPipelineEnvironmentsRequest = {
+  name?: string
+  namespace?: string
+  fromEnv?: string
+  toEnv?: string
+}
+
+export type DiffPipelineEnvironmentsResponse = {
+  diff?: PipelinesV1Types.EnvironmentDiff
+  errors?: string[]
+}
+
+export type PreviewPromotionRequest = {
+  namespace?: string
+  name?: string
+  env?: string
+  revision?: string
+}
+
+export type PreviewPromotionResponse = {
+  repositoryUrl?: string
+  baseBranch?: string
+  files?: PipelinesV1Types.PromotionFileChange[]
+  errors?: string[]
+}
+
 export class Pipelines {
   static ListPipelines(req: ListPipelinesRequest, initReq?: fm.InitReq): Promise<ListPipelinesResponse> {
     return fm.fetchReq<ListPipelinesRequest, ListPipelinesResponse>(`/v1/pipelines?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
@@ -63,4 +112,16 @@ export class Pipelines {
   static ListPullRequests(req: ListPullRequestsRequest, initReq?: fm.InitReq): Promise<ListPullRequestsResponse> {
     return fm.fetchReq<ListPullRequestsRequest, ListPullRequestsResponse>(`/v1/pipelines/list_prs/${req["name"]}`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static ListPromotionHistory(req: ListPromotionHistoryRequest, initReq?: fm.InitReq): Promise<ListPromotionHistoryResponse> {
+    return fm.fetchReq<ListPromotionHistoryRequest, ListPromotionHistoryResponse>(`/v1/pipelines/history/${req["name"]}?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static ListPendingApprovals(req: ListPendingApprovalsRequest, initReq?: fm.InitReq): Promise<ListPendingApprovalsResponse> {
+    return fm.fetchReq<ListPendingApprovalsRequest, ListPendingApprovalsResponse>(`/v1/pipelines/pending_approvals/${req["name"]}?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static DiffPipelineEnvironments(req: DiffPipelineEnvironmentsRequest, initReq?: fm.InitReq): Promise<DiffPipelineEnvironmentsResponse> {
+    return fm.fetchReq<DiffPipelineEnvironmentsRequest, DiffPipelineEnvironmentsResponse>(`/v1/pipelines/diff/${req["name"]}?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static PreviewPromotion(req: PreviewPromotionRequest, initReq?: fm.InitReq): Promise<PreviewPromotionResponse> {
+    return fm.fetchReq<PreviewPromotionRequest, PreviewPromotionResponse>(`/v1/pipelines/preview_promotion/${req["name"]}`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
 }
\ No newline at end of file
@@ -111,4 +111,64 @@ export type Notification = {
 
 export type LocalObjectReference = {
   name?: string
+}
+
+export type PromotionHistoryEntry = {
+  type?: string
+  revision?: string
+  principal?: string
+  timestamp?: string
+  reason?: string
+  message?: string
+  pullRequestUrl?: string
+}
+
+export type EnvironmentHistory = {
+  environment?: string
+  entries?: PromotionHistoryEntry[]
+}
+
+export type Approval = {
+  principal?: string
+  timestamp?: string
+  expiresAt?: string
+}
+
+export type PendingApproval = {
+  environment?: string
+  revision?: string
+  approvals?: Approval[]
+  requiredApprovals?: number
+  approverGroups?: string[]
+}
+
+export type ValueDiff = {
+  path?: string
+  from?: string
+  to?: string
+}
+
+export type Commit = {
+  sha?: string
+  author?: string
+  message?: string
+  timestamp?: string
+  url?: string
+}
+
+export type EnvironmentDiff = {
+  kind?: string
+  fromRevision?: string
+  toRevision?: string
+  fromChartVersion?: string
+  toChartVersion?: string
+  values?: ValueDiff[]
+  commits?: Commit[]
+  toAhead?: boolean
+}
+
+export type PromotionFileChange = {
+  path?: string
+  diff?: string
+  changes?: number
 }
\ No newline at end of file
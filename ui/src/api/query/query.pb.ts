@@ -22,6 +22,7 @@ export type DoQueryRequest = {
   limit?: number
   orderBy?: string
   descending?: boolean
+  clusterSelector?: string
 }
 
 export type DoQueryResponse = {
@@ -10,6 +10,7 @@ export type ListTerraformObjectsRequest = {
   clusterName?: string
   namespace?: string
   pagination?: TerraformV1Types.Pagination
+  clusterSelector?: string
 }
 
 export type ListTerraformObjectsResponse = {
@@ -32,6 +33,8 @@ export type GetTerraformObjectResponse = {
 
 export type SyncTerraformObjectsRequest = {
   objects?: TerraformV1Types.ObjectRef[]
+  clusterSelector?: string
+  namespace?: string
 }
 
 export type SyncTerraformObjectsResponse = {
@@ -56,6 +59,7 @@ export type GetTerraformObjectPlanResponse = {
   plan?: string
   enablePlanViewing?: boolean
   error?: string
+  structuredPlan?: TerraformV1Types.TerraformPlan
 }
 
 export type ReplanTerraformObjectRequest = {
@@ -68,6 +72,91 @@ export type ReplanTerraformObjectResponse = {
   replanRequested?: boolean
 }
 
+export type ListTerraformObjectPlansRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+}
+
+export type ListTerraformObjectPlansResponse = {
+  plans?: TerraformV1Types.TerraformPlan[]
+  error?: string
+}
+
+export type DiffTerraformObjectPlansRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+  fromPlanId?: string
+  toPlanId?: string
+}
+
+export type DiffTerraformObjectPlansResponse = {
+  fromPlanId?: string
+  toPlanId?: string
+  resources?: TerraformV1Types.TerraformPlanResourceDiff[]
+}
+
+export type ApproveTerraformPlanRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+  planId?: string
+  createPullRequest?: boolean
+  path?: string
+}
+
+export type ApproveTerraformPlanResponse = {
+  planId?: string
+  pullRequestUrl?: string
+}
+
+export type RejectTerraformPlanRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+  planId?: string
+  reason?: string
+}
+
+export type RejectTerraformPlanResponse = {
+  planId?: string
+}
+
+export type ListTerraformObjectOutputsRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+}
+
+export type ListTerraformObjectOutputsResponse = {
+  outputs?: TerraformV1Types.TerraformOutput[]
+  error?: string
+}
+
+export type ListTerraformObjectStateResourcesRequest = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+}
+
+export type ListTerraformObjectStateResourcesResponse = {
+  resources?: TerraformV1Types.TerraformStateResource[]
+  error?: string
+}
+
+export type GetTerraformDriftReportRequest = {
+  clusterName?: string
+  namespace?: string
+  clusterSelector?: string
+}
+
+export type GetTerraformDriftReportResponse = {
+  entries?: TerraformV1Types.TerraformDriftReportEntry[]
+  errors?: TerraformV1Types.TerraformListError[]
+  generatedAt?: string
+}
+
 export class Terraform {
   static ListTerraformObjects(req: ListTerraformObjectsRequest, initReq?: fm.InitReq): Promise<ListTerraformObjectsResponse> {
     return fm.fetchReq<ListTerraformObjectsRequest, ListTerraformObjectsResponse>(`/v1/terraform-objects?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
@@ -87,4 +176,25 @@ export class Terraform {
   static ReplanTerraformObject(req: ReplanTerraformObjectRequest, initReq?: fm.InitReq): Promise<ReplanTerraformObjectResponse> {
     return fm.fetchReq<ReplanTerraformObjectRequest, ReplanTerraformObjectResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/replan`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
   }
+  static ListTerraformObjectPlans(req: ListTerraformObjectPlansRequest, initReq?: fm.InitReq): Promise<ListTerraformObjectPlansResponse> {
+    return fm.fetchReq<ListTerraformObjectPlansRequest, ListTerraformObjectPlansResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/plans?${fm.renderURLSearchParams(req, ["namespace", "name"])}`, {...initReq, method: "GET"})
+  }
+  static DiffTerraformObjectPlans(req: DiffTerraformObjectPlansRequest, initReq?: fm.InitReq): Promise<DiffTerraformObjectPlansResponse> {
+    return fm.fetchReq<DiffTerraformObjectPlansRequest, DiffTerraformObjectPlansResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/plans/diff?${fm.renderURLSearchParams(req, ["namespace", "name"])}`, {...initReq, method: "GET"})
+  }
+  static ApproveTerraformPlan(req: ApproveTerraformPlanRequest, initReq?: fm.InitReq): Promise<ApproveTerraformPlanResponse> {
+    return fm.fetchReq<ApproveTerraformPlanRequest, ApproveTerraformPlanResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/approve`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static RejectTerraformPlan(req: RejectTerraformPlanRequest, initReq?: fm.InitReq): Promise<RejectTerraformPlanResponse> {
+    return fm.fetchReq<RejectTerraformPlanRequest, RejectTerraformPlanResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/reject`, {...initReq, method: "POST", body: JSON.stringify(req, fm.replacer)})
+  }
+  static ListTerraformObjectOutputs(req: ListTerraformObjectOutputsRequest, initReq?: fm.InitReq): Promise<ListTerraformObjectOutputsResponse> {
+    return fm.fetchReq<ListTerraformObjectOutputsRequest, ListTerraformObjectOutputsResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/outputs?${fm.renderURLSearchParams(req, ["namespace", "name"])}`, {...initReq, method: "GET"})
+  }
+  static ListTerraformObjectStateResources(req: ListTerraformObjectStateResourcesRequest, initReq?: fm.InitReq): Promise<ListTerraformObjectStateResourcesResponse> {
+    return fm.fetchReq<ListTerraformObjectStateResourcesRequest, ListTerraformObjectStateResourcesResponse>(`/v1/namespaces/${req["namespace"]}/terraform-objects/${req["name"]}/state/resources?${fm.renderURLSearchParams(req, ["namespace", "name"])}`, {...initReq, method: "GET"})
+  }
+  static GetTerraformDriftReport(req: GetTerraformDriftReportRequest, initReq?: fm.InitReq): Promise<GetTerraformDriftReportResponse> {
+    return fm.fetchReq<GetTerraformDriftReportRequest, GetTerraformDriftReportResponse>(`/v1/terraform-objects/drift-report?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
 }
\ No newline at end of file
@@ -71,4 +71,82 @@ export type Condition = {
   reason?: string
   message?: string
   timestamp?: string
+}
+
+export type TerraformPlanAttributeChange = {
+  path?: string
+  before?: string
+  after?: string
+  sensitive?: boolean
+  unknown?: boolean
+  forcesReplacement?: boolean
+}
+
+export type TerraformPlanResourceChange = {
+  address?: string
+  moduleAddress?: string
+  type?: string
+  name?: string
+  action?: string
+  attributes?: TerraformPlanAttributeChange[]
+}
+
+export type TerraformPlanSummary = {
+  create?: number
+  update?: number
+  delete?: number
+  replace?: number
+}
+
+export type TerraformPlan = {
+  planId?: string
+  revision?: string
+  recordedAt?: string
+  summary?: TerraformPlanSummary
+  resourceChanges?: TerraformPlanResourceChange[]
+  decision?: TerraformPlanDecision
+}
+
+export type TerraformPlanResourceDiff = {
+  address?: string
+  status?: string
+  fromAction?: string
+  toAction?: string
+  changedAttributes?: string[]
+}
+
+export type TerraformPlanDecision = {
+  decision?: string
+  principal?: string
+  timestamp?: string
+  reason?: string
+  pullRequestUrl?: string
+}
+
+export type TerraformOutput = {
+  name?: string
+  value?: string
+  sensitive?: boolean
+  masked?: boolean
+}
+
+export type TerraformStateResource = {
+  address?: string
+  moduleAddress?: string
+  mode?: string
+  type?: string
+  name?: string
+  provider?: string
+  attributes?: {[key: string]: string}
+}
+
+export type TerraformDriftReportEntry = {
+  clusterName?: string
+  namespace?: string
+  name?: string
+  driftingSince?: string
+  driftingForSeconds?: string
+  lastDriftDetectedAt?: string
+  planId?: string
+  driftedResources?: string[]
 }
\ No newline at end of file
@@ -116,6 +116,7 @@ export type ListGitopsClustersRequest = {
   pageSize?: string
   pageToken?: string
   refType?: string
+  clusterSelector?: string
 }
 
 export type ListGitopsClustersResponse = {
@@ -208,9 +209,124 @@ export type ListCredentialsResponse = {
   total?: number
 }
 
+export type ListClusterCapabilitiesRequest = {
+  refresh?: boolean
+}
+
+export type ListClusterCapabilitiesResponse = {
+  clusters?: ClusterCapabilities[]
+  errors?: ListError[]
+}
+
+export type ProbeClusterRequest = {
+  name?: string
+  namespace?: string
+}
+
+export type ProbeClusterResponse = {
+  cluster?: ClusterCapabilities
+}
+
+export type DecommissionClusterRequest = {
+  name?: string
+  namespace?: string
+  repositoryUrl?: string
+  headBranch?: string
+  baseBranch?: string
+  title?: string
+  description?: string
+  commitMessage?: string
+  force?: boolean
+}
+
+export type DecommissionClusterResponse = {
+  jobId?: string
+}
+
+export type GetDecommissionClusterJobRequest = {
+  jobId?: string
+}
+
+export type GetDecommissionClusterJobResponse = {
+  steps?: DecommissionStep[]
+  done?: boolean
+  webUrl?: string
+  error?: string
+}
+
+export type DecommissionStep = {
+  name?: string
+  status?: string
+  message?: string
+}
+
+export type ExportClusterInventoryRequest = {
+  clusterSelector?: string
+}
+
+export type ExportClusterInventoryResponse = {
+  clusters?: ClusterInventory[]
+  errors?: ListError[]
+  exportedAt?: string
+}
+
+export type ClusterInventory = {
+  clusterName?: string
+  name?: string
+  namespace?: string
+  labels?: {[key: string]: string}
+  provider?: string
+  kubernetesVersion?: string
+  nodePools?: ClusterNodePool[]
+  profiles?: InstalledProfile[]
+  owners?: string[]
+  template?: ClusterTemplateRef
+  controlPlane?: boolean
+}
+
+export type ClusterNodePool = {
+  name?: string
+  replicas?: number
+  readyReplicas?: number
+  kubernetesVersion?: string
+  infrastructureKind?: string
+}
+
+export type InstalledProfile = {
+  name?: string
+  namespace?: string
+  chart?: string
+  version?: string
+  status?: string
+}
+
+export type ClusterTemplateRef = {
+  name?: string
+  namespace?: string
+  templateKind?: string
+  provider?: string
+  description?: string
+}
+
+export type ClusterCapabilities = {
+  clusterName?: string
+  reachable?: boolean
+  error?: string
+  kubernetesVersion?: string
+  capabilities?: ClusterCapability[]
+  probedAt?: string
+}
+
+export type ClusterCapability = {
+  name?: string
+  installed?: boolean
+  apiVersions?: string[]
+}
+
 export type GetKubeconfigRequest = {
   name?: string
   namespace?: string
+  mode?: string
 }
 
 export type GetKubeconfigResponse = {
@@ -673,6 +789,7 @@ export type ExternalSecretItem = {
 }
 
 export type ListExternalSecretsRequest = {
+  clusterSelector?: string
 }
 
 export type ListExternalSecretsResponse = {
@@ -722,6 +839,7 @@ export type SyncExternalSecretsRequest = {
   clusterName?: string
   namespace?: string
   externalSecretName?: string
+  clusterSelector?: string
 }
 
 export type SyncExternalSecretsResponse = {
@@ -737,6 +855,7 @@ export type PolicyConfigListItem = {
 }
 
 export type ListPolicyConfigsRequest = {
+  clusterSelector?: string
 }
 
 export type ListPolicyConfigsResponse = {
@@ -891,6 +1010,21 @@ export class ClustersService {
   static GetKubeconfig(req: GetKubeconfigRequest, initReq?: fm.InitReq): Promise<GoogleApiHttpbody.HttpBody> {
     return fm.fetchReq<GetKubeconfigRequest, GoogleApiHttpbody.HttpBody>(`/v1/namespaces/${req["namespace"]}/clusters/${req["name"]}/kubeconfig?${fm.renderURLSearchParams(req, ["namespace", "name"])}`, {...initReq, method: "GET"})
   }
+  static ListClusterCapabilities(req: ListClusterCapabilitiesRequest, initReq?: fm.InitReq): Promise<ListClusterCapabilitiesResponse> {
+    return fm.fetchReq<ListClusterCapabilitiesRequest, ListClusterCapabilitiesResponse>(`/v1/cluster-capabilities?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static ProbeCluster(req: ProbeClusterRequest, initReq?: fm.InitReq): Promise<ProbeClusterResponse> {
+    return fm.fetchReq<ProbeClusterRequest, ProbeClusterResponse>(`/v1/cluster-capabilities/probe`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static DecommissionCluster(req: DecommissionClusterRequest, initReq?: fm.InitReq): Promise<DecommissionClusterResponse> {
+    return fm.fetchReq<DecommissionClusterRequest, DecommissionClusterResponse>(`/v1/namespaces/${req["namespace"]}/clusters/${req["name"]}/decommission`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static GetDecommissionClusterJob(req: GetDecommissionClusterJobRequest, initReq?: fm.InitReq): Promise<GetDecommissionClusterJobResponse> {
+    return fm.fetchReq<GetDecommissionClusterJobRequest, GetDecommissionClusterJobResponse>(`/v1/clusters/decommission/jobs/${req["jobId"]}?${fm.renderURLSearchParams(req, ["jobId"])}`, {...initReq, method: "GET"})
+  }
+  static ExportClusterInventory(req: ExportClusterInventoryRequest, initReq?: fm.InitReq): Promise<ExportClusterInventoryResponse> {
+    return fm.fetchReq<ExportClusterInventoryRequest, ExportClusterInventoryResponse>(`/v1/cluster-inventory?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
   static GetEnterpriseVersion(req: GetEnterpriseVersionRequest, initReq?: fm.InitReq): Promise<GetEnterpriseVersionResponse> {
     return fm.fetchReq<GetEnterpriseVersionRequest, GetEnterpriseVersionResponse>(`/v1/enterprise/version?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }